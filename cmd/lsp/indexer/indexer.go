@@ -17,26 +17,27 @@ type Indexer struct {
 	analyzer analyzer.Analyzer
 }
 
+// FullScan parses and analyzes the workspace, returns analyzed build and warnings found by analyzer.
 func (i Indexer) FullScan(
 	ctx context.Context,
 	workspacePath string,
-) (src.Build, bool, *compiler.Error) {
+) (src.Build, []compiler.Warning, bool, *compiler.Error) {
 	feResult, err := i.fe.Process(ctx, workspacePath)
 	if err != nil {
-		return src.Build{}, false, err
+		return src.Build{}, nil, false, err
 	}
 
 	// if nevalang module is found, but it's not part of the workspace
 	if isParentPath(workspacePath, feResult.Path) {
-		return src.Build{}, false, nil
+		return src.Build{}, nil, false, nil
 	}
 
-	aBuild, err := i.analyzer.AnalyzeBuild(feResult.ParsedBuild)
+	aBuild, warnings, err := i.analyzer.AnalyzeBuild(feResult.ParsedBuild)
 	if err != nil {
-		return src.Build{}, false, err
+		return src.Build{}, nil, false, err
 	}
 
-	return aBuild, true, nil
+	return aBuild, warnings, true, nil
 }
 
 func isParentPath(parent, child string) bool {
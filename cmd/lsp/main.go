@@ -13,6 +13,7 @@ import (
 	"github.com/nevalang/neva/internal/compiler/analyzer"
 	"github.com/nevalang/neva/internal/compiler/parser"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+	"github.com/nevalang/neva/pkg/runtime/funcs"
 )
 
 func main() {
@@ -31,7 +32,7 @@ func main() {
 	resolver := typesystem.MustNewResolver(typesystem.Validator{}, checker, terminator)
 	builder := builder.MustNew(p)
 
-	indexer := indexer.New(builder, p, analyzer.MustNew(resolver))
+	indexer := indexer.New(builder, p, analyzer.MustNew(resolver, funcs.NewManifest()))
 
 	handler := lspServer.BuildHandler(logger, serverName, indexer)
 
@@ -11,8 +11,8 @@ import (
 	protocol "github.com/tliron/glsp/protocol_3_16"
 
 	"github.com/nevalang/neva/cmd/lsp/indexer"
-	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 )
 
 type Server struct {
@@ -34,9 +34,9 @@ type Server struct {
 }
 
 // indexAndNotifyProblems does full scan of the workspace
-// and sends diagnostics if there are any problems
+// and sends diagnostics if there are any problems or warnings
 func (s *Server) indexAndNotifyProblems(notify glsp.NotifyFunc) error {
-	build, found, proplems := s.indexer.FullScan(
+	build, warnings, found, proplems := s.indexer.FullScan(
 		context.Background(),
 		s.workspacePath,
 	)
@@ -49,55 +49,85 @@ func (s *Server) indexAndNotifyProblems(notify glsp.NotifyFunc) error {
 	s.index = &build
 	s.indexMutex.Unlock()
 
-	if proplems == nil {
-		// clear problems
-		s.problemsMutex.Lock()
-		for uri := range s.problemFiles {
-			notify(
-				protocol.ServerTextDocumentPublishDiagnostics,
-				protocol.PublishDiagnosticsParams{
-					URI:         uri,
-					Diagnostics: []protocol.Diagnostic{},
-				},
-			)
+	// group diagnostics by files
+	diagnostics := make(map[string][]protocol.Diagnostic)
+	for _, warning := range warnings {
+		uri := filepath.Join(s.workspacePath, warning.Meta.Location.String())
+		diagnostics[uri] = append(
+			diagnostics[uri],
+			s.createDiagnostic(warning.Message, warning.Meta, protocol.DiagnosticSeverityWarning),
+		)
+	}
+	if proplems != nil {
+		uri := filepath.Join(s.workspacePath, proplems.Meta.Location.String())
+		diagnostics[uri] = append(
+			diagnostics[uri],
+			s.createDiagnostic(proplems.Error(), proplems.Meta, protocol.DiagnosticSeverityError),
+		)
+	}
+
+	s.problemsMutex.Lock()
+	defer s.problemsMutex.Unlock()
+
+	// clear problems of files that don't have them anymore
+	for uri := range s.problemFiles {
+		if _, ok := diagnostics[uri]; ok {
+			continue
 		}
-		s.problemFiles = make(map[string]struct{})
+		notify(
+			protocol.ServerTextDocumentPublishDiagnostics,
+			protocol.PublishDiagnosticsParams{
+				URI:         uri,
+				Diagnostics: []protocol.Diagnostic{},
+			},
+		)
+	}
+	s.problemFiles = make(map[string]struct{}, len(diagnostics))
+
+	if len(diagnostics) == 0 {
 		s.logger.Info("full index without problems, sent empty diagnostics")
-		s.problemsMutex.Unlock()
 		return nil
 	}
 
-	// remember problem and send diagnostic
-	s.problemsMutex.Lock()
-	uri := filepath.Join(s.workspacePath, proplems.Meta.Location.String())
-	s.problemFiles[uri] = struct{}{}
-	notify(
-		protocol.ServerTextDocumentPublishDiagnostics,
-		s.createDiagnostics(*proplems, uri),
-	)
-	s.logger.Info("diagnostic sent:", "err", proplems)
-	s.problemsMutex.Unlock()
+	// remember problems and send diagnostics
+	for uri, fileDiagnostics := range diagnostics {
+		s.problemFiles[uri] = struct{}{}
+		notify(
+			protocol.ServerTextDocumentPublishDiagnostics,
+			protocol.PublishDiagnosticsParams{
+				URI:         uri,
+				Diagnostics: fileDiagnostics,
+			},
+		)
+	}
+	s.logger.Info("diagnostics sent:", "err", proplems, "warnings", len(warnings))
 
 	return nil
 }
 
-func (s *Server) createDiagnostics(compilerErr compiler.Error, uri string) protocol.PublishDiagnosticsParams {
+func (s *Server) createDiagnostic(
+	message string,
+	meta *core.Meta,
+	severity protocol.DiagnosticSeverity,
+) protocol.Diagnostic {
 	var startStopRange protocol.Range
-	if compilerErr.Meta != nil {
+	if meta != nil {
+		start, stop := meta.Start, meta.Stop
+
 		// If stop is 0 0, set it to the same as start but with character incremented by 1
-		if compilerErr.Meta.Stop.Line == 0 && compilerErr.Meta.Stop.Column == 0 {
-			compilerErr.Meta.Stop = compilerErr.Meta.Start
-			compilerErr.Meta.Stop.Column++
+		if stop.Line == 0 && stop.Column == 0 {
+			stop = start
+			stop.Column++
 		}
 
 		startStopRange = protocol.Range{
 			Start: protocol.Position{
-				Line:      uint32(compilerErr.Meta.Start.Line),
-				Character: uint32(compilerErr.Meta.Start.Column),
+				Line:      uint32(start.Line),
+				Character: uint32(start.Column),
 			},
 			End: protocol.Position{
-				Line:      uint32(compilerErr.Meta.Stop.Line),
-				Character: uint32(compilerErr.Meta.Stop.Column),
+				Line:      uint32(stop.Line),
+				Character: uint32(stop.Column),
 			},
 		}
 
@@ -107,18 +137,12 @@ func (s *Server) createDiagnostics(compilerErr compiler.Error, uri string) proto
 	}
 
 	source := "neva"
-	severity := protocol.DiagnosticSeverityError
-
-	return protocol.PublishDiagnosticsParams{
-		URI: uri,
-		Diagnostics: []protocol.Diagnostic{
-			{
-				Range:    startStopRange,
-				Severity: &severity,
-				Source:   &source,
-				Message:  compilerErr.Error(),
-				Data:     time.Now(),
-			},
-		},
+
+	return protocol.Diagnostic{
+		Range:    startStopRange,
+		Severity: &severity,
+		Source:   &source,
+		Message:  message,
+		Data:     time.Now(),
 	}
 }
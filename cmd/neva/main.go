@@ -17,6 +17,7 @@ import (
 	"github.com/nevalang/neva/internal/compiler/irgen"
 	"github.com/nevalang/neva/internal/compiler/parser"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+	"github.com/nevalang/neva/pkg/runtime/funcs"
 )
 
 func main() {
@@ -33,7 +34,7 @@ func main() {
 	bldr := builder.MustNew(prsr)
 
 	desugarer := desugarer.New()
-	analyzer := analyzer.MustNew(resolver)
+	analyzer := analyzer.MustNew(resolver, funcs.NewManifest())
 	irgen := irgen.New()
 
 	golangBackend := golang.NewBackend()
@@ -47,6 +48,15 @@ func main() {
 		golang.NewBackend(),
 	)
 
+	goLibCompiler := compiler.New(
+		bldr,
+		prsr,
+		&desugarer,
+		analyzer,
+		irgen,
+		golang.NewLibBackend(golangBackend),
+	)
+
 	nativeCompiler := compiler.New(
 		bldr,
 		prsr,
@@ -88,6 +98,7 @@ func main() {
 		workdir,
 		bldr,
 		goCompiler,
+		goLibCompiler,
 		nativeCompiler,
 		wasmCompiler,
 		jsonCompiler,
@@ -8,7 +8,6 @@ import (
 )
 
 func Test(t *testing.T) {
-	t.Skip("// TODO: parser doesn't support >> and << yet")
 	cmd := exec.Command("neva", "run", "main")
 	out, err := cmd.CombinedOutput()
 	require.NoError(t, err)
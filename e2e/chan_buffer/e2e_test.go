@@ -0,0 +1,40 @@
+package test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "--chan-buffer", "4", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!\n", string(out))
+
+	output := t.TempDir()
+	cmd = exec.Command("neva", "build", "--target", "go", "--chan-buffer", "4", "--output", output, "main")
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "", string(out))
+
+	mainGo, err := os.ReadFile(filepath.Join(output, "main.go"))
+	require.NoError(t, err)
+
+	// #buffer directive overrides default buffer size
+	require.Contains(t, string(mainGo), "_to_println_in_data = make(chan runtime.OrderedMsg, 16)\n")
+	require.Contains(t, string(mainGo), "println_out_res_to_out_stop = make(chan runtime.OrderedMsg, 4)\n")
+
+	// #buffer directive also takes int literal
+	cmd = exec.Command("neva", "build", "--target", "go", "--output", output, "literal")
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "", string(out))
+
+	mainGo, err = os.ReadFile(filepath.Join(output, "main.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(mainGo), "_to_println_in_data = make(chan runtime.OrderedMsg, 8)\n")
+}
@@ -0,0 +1,24 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+
+	// cycle is reported from the constant that is analyzed first
+	require.Regexp(
+		t,
+		`^main/main.neva:(3:14: Cyclic constant definition: b -> a -> b|4:14: Cyclic constant definition: a -> b -> a)\n$`,
+		string(out),
+	)
+
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+}
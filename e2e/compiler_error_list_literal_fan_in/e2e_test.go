@@ -0,0 +1,26 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		"main/main.neva:6:23: Incompatible types: [1, 2] -> println: "+
+			"Subtype inst must have same ref as supertype: got list, want int. "+
+			"List literal is sent as a single list message, not as a fan-in of its items. "+
+			"Use constant references for fan-in, e.g. `[$a, $b] -> ...`\n",
+		string(out),
+	)
+
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+}
@@ -0,0 +1,30 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const warnings = "main/main.neva:1:14: warning: Unused import: strings\n" +
+	"main/main.neva:3:6: warning: Unused constant: greeting\n" +
+	"main/main.neva:6:1: warning: Unused outport is discarded: fork:b\n" +
+	"main/main.neva:6:33: warning: Unused node: extra\n" +
+	"main/main.neva:16:4: warning: Wait shadows builtin entity with the same name\n"
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, warnings+"{}\n", string(out))
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+
+	cmd = exec.Command("neva", "run", "--Werror", "main")
+
+	out, err = cmd.CombinedOutput()
+	require.Error(t, err)
+	require.Equal(t, warnings+"5 warning(s) treated as errors\n", string(out))
+	require.Equal(t, 1, cmd.ProcessState.ExitCode())
+}
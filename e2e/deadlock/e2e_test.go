@@ -0,0 +1,19 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	require.Equal(t, 1, cmd.ProcessState.ExitCode())
+
+	require.Contains(t, string(out), "runtime error: deadlock: all functions are blocked\n")
+	require.Contains(t, string(out), "\tlock: waits on lock:data, last message: {}\n")
+	require.Contains(t, string(out), "\tprintln: waits on println:data\n")
+}
@@ -0,0 +1,37 @@
+package externs
+
+import (
+	"context"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func Registry() map[string]runtime.FuncCreator {
+	return map[string]runtime.FuncCreator{
+		"double": double{},
+	}
+}
+
+type double struct{}
+
+func (double) Create(io runtime.IO, _ runtime.Msg) (func(context.Context), error) {
+	dataIn, err := io.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+	resOut, err := io.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) {
+		for {
+			msg, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+			if !resOut.Send(ctx, runtime.NewIntMsg(msg.Int()*2)) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,25 @@
+package test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	defer func() {
+		require.NoError(t, os.RemoveAll("testdata/program"))
+	}()
+
+	cmd := exec.Command("neva", "build", "--target", "go-lib", "--output", "testdata/program", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "", string(out))
+
+	cmd = exec.Command("go", "run", "./testdata/runner")
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.Equal(t, "foo\ngot: foo\nbar\ngot: bar\n", string(out))
+}
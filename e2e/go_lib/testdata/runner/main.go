@@ -0,0 +1,32 @@
+// Command runner uses Neva program compiled with go-lib target.
+// It's not a part of the build because program package is generated by the test.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nevalang/neva/e2e/go_lib/testdata/program"
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	start := make(chan runtime.Msg)
+	stop := make(chan runtime.Msg)
+
+	go func() {
+		for _, s := range []string{"foo", "bar"} {
+			start <- runtime.NewStringMsg(s)
+			fmt.Println("got:", (<-stop).Str())
+		}
+		cancel()
+	}()
+
+	if err := program.Main(ctx, start, stop); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,55 @@
+package test
+
+import (
+	"bufio"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+
+	reader := bufio.NewReader(stdout)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "started\n", line)
+
+	require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+
+	// message that is already in the network is delivered after the signal
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "finished\n", line)
+
+	err = cmd.Wait()
+	require.Error(t, err)
+	require.Equal(t, 128+int(syscall.SIGTERM), cmd.ProcessState.ExitCode())
+}
+
+func TestDrainTimeout(t *testing.T) {
+	cmd := exec.Command("neva", "run", "--drain-timeout", "500ms", "timeout")
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+
+	line, err := bufio.NewReader(stdout).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "started\n", line)
+
+	start := time.Now()
+	require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+
+	err = cmd.Wait()
+	require.Error(t, err)
+	require.Equal(t, 128+int(syscall.SIGTERM), cmd.ProcessState.ExitCode())
+
+	// program is waiting for an hour but it must stop right after the drain timeout
+	require.Less(t, time.Since(start), 5*time.Second)
+}
@@ -15,7 +15,7 @@ func Test(t *testing.T) {
 	require.Contains(
 		t,
 		string(out),
-		"main/main.neva:2:1: Subtype must be either union or literal: want int | float, got any\n",
+		"main/main.neva:2:1: Incompatible type argument: any does not satisfy numeric: Subtype must be element of supertype union: any does not satisfy int | float\n",
 	)
 
 	require.Equal(t, 0, cmd.ProcessState.ExitCode())
@@ -0,0 +1,24 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		`{"age":30,"color":"Green","name":"Alice","scores":{"math":4.5},"shape":{"tag":"Circle","value":1.5},"tags":["a","b"]}`+"\n"+
+			`missing field "color"`+"\n",
+		string(out),
+	)
+
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+}
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	defer func() {
+		require.NoError(t, os.Remove("metrics.json"))
+	}()
+
+	cmd := exec.Command("neva", "run", "--metrics", "metrics.json", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!\n", string(out))
+
+	bb, err := os.ReadFile("metrics.json")
+	require.NoError(t, err)
+
+	var metrics []struct {
+		Path     string `json:"path"`
+		Port     string `json:"port"`
+		Sent     uint64 `json:"sent"`
+		Received uint64 `json:"received"`
+	}
+	require.NoError(t, json.Unmarshal(bb, &metrics))
+
+	received := map[string]uint64{}
+	for _, m := range metrics {
+		received[m.Path+":"+m.Port] = m.Received
+	}
+
+	require.Equal(t, uint64(1), received["lock/in:sig"])
+	require.Equal(t, uint64(1), received["println/in:data"])
+	require.Equal(t, uint64(1), received["out:stop"])
+}
+
+func TestPrometheus(t *testing.T) {
+	defer func() {
+		require.NoError(t, os.Remove("metrics.prom"))
+	}()
+
+	cmd := exec.Command("neva", "run", "--metrics", "metrics.prom", "--metrics-format", "prometheus", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!\n", string(out))
+
+	bb, err := os.ReadFile("metrics.prom")
+	require.NoError(t, err)
+
+	require.Contains(t, string(bb), "# TYPE neva_port_sent_total counter\n")
+	require.Contains(t, string(bb), `neva_port_received_total{path="out",port="stop"} 1`+"\n")
+	require.Contains(t, string(bb), "# TYPE neva_port_send_blocked_seconds_total counter\n")
+}
@@ -0,0 +1,32 @@
+package test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	t.Run("variable is set", func(t *testing.T) {
+		cmd := exec.Command("neva", "run", "main")
+		cmd.Env = append(os.Environ(), "NEVA_E2E_VAR=Hello, os.Exit!")
+
+		out, err := cmd.CombinedOutput()
+		require.Error(t, err)
+
+		require.Equal(t, "Hello, os.Exit!\n", string(out))
+		require.Equal(t, 3, cmd.ProcessState.ExitCode())
+	})
+
+	t.Run("variable is not set", func(t *testing.T) {
+		cmd := exec.Command("neva", "run", "main")
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err)
+
+		require.Equal(t, "not set\n", string(out))
+		require.Equal(t, 0, cmd.ProcessState.ExitCode())
+	})
+}
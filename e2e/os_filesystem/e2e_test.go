@@ -0,0 +1,34 @@
+package test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	defer os.RemoveAll("tmp")
+
+	cmd := exec.Command("neva", "run", "main")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		"b.txt false\nsub true\n5\n",
+		string(out),
+	)
+
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+
+	// renamed file is removed, directory is left
+	_, err = os.Stat("tmp/b.txt")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	info, err := os.Stat("tmp/sub")
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+}
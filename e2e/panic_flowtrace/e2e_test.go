@@ -0,0 +1,28 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "--flowtrace", "main")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	require.Equal(t, 1, cmd.ProcessState.ExitCode())
+
+	require.Contains(t, string(out), "panic: {\"text\": \"parsing \\\"boom\\\":  invalid syntax\"}\n")
+	require.Contains(t, string(out), "\tat panic:data\n\tat parse:err\n\tat parse:data\n")
+}
+
+func TestWithoutFlowtrace(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	require.Equal(t, 1, cmd.ProcessState.ExitCode())
+
+	require.Contains(t, string(out), "panic: {\"text\": \"parsing \\\"boom\\\":  invalid syntax\"}\n")
+	require.NotContains(t, string(out), "\tat ")
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	defer func() {
+		require.NoError(t, os.Remove("profile.json"))
+	}()
+
+	cmd := exec.Command("neva", "run", "--profile", "profile.json", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!\n", string(out))
+
+	bb, err := os.ReadFile("profile.json")
+	require.NoError(t, err)
+
+	var profile struct {
+		TraceEvents []struct {
+			Name  string         `json:"name"`
+			Phase string         `json:"ph"`
+			Tid   int            `json:"tid"`
+			ID    uint64         `json:"id"`
+			Args  map[string]any `json:"args"`
+		} `json:"traceEvents"`
+	}
+	require.NoError(t, json.Unmarshal(bb, &profile))
+
+	tracks := map[string]bool{}
+	flowStarts := map[uint64]bool{}
+	flowEnds := map[uint64]bool{}
+	for _, event := range profile.TraceEvents {
+		switch event.Phase {
+		case "M":
+			tracks[event.Args["name"].(string)] = true
+		case "s":
+			flowStarts[event.ID] = true
+		case "f":
+			flowEnds[event.ID] = true
+		}
+	}
+
+	require.Equal(t, map[string]bool{
+		"main":     true,
+		"greeting": true,
+		"lock":     true,
+		"println":  true,
+	}, tracks)
+	require.NotEmpty(t, flowStarts)
+	require.Equal(t, flowStarts, flowEnds)
+}
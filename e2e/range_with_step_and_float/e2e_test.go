@@ -0,0 +1,20 @@
+package test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	cmd := exec.Command("neva", "run", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"[0,3,6,9]\n[1,0.75,0.5,0.25]\n[-3,-1,1,3]\n",
+		string(out),
+	)
+	require.Equal(t, 0, cmd.ProcessState.ExitCode())
+}
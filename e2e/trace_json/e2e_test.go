@@ -0,0 +1,55 @@
+package test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test(t *testing.T) {
+	defer func() {
+		require.NoError(t, os.Remove("trace.log"))
+	}()
+
+	cmd := exec.Command("neva", "run", "--trace", "--trace-format", "json", "main")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!\n", string(out))
+
+	file, err := os.Open("trace.log")
+	require.NoError(t, err)
+	defer file.Close()
+
+	type event struct {
+		Index     uint64          `json:"index"`
+		Direction string          `json:"direction"`
+		Path      string          `json:"path"`
+		Port      string          `json:"port"`
+		Type      string          `json:"type"`
+		Msg       json.RawMessage `json:"msg"`
+	}
+
+	var events []event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var e event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		require.Contains(t, []string{"sent", "recv"}, e.Direction)
+		events = append(events, e)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.NotEmpty(t, events)
+	require.Equal(t, event{
+		Index:     1,
+		Direction: "sent",
+		Path:      "",
+		Port:      "start",
+		Type:      "runtime.StructMsg",
+		Msg:       json.RawMessage("{}"),
+	}, events[0])
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/nevalang/neva/internal/compiler"
 )
 
+// goDir is a directory next to the manifest where module keeps Go implementations of its runtime functions.
+const goDir = "go"
+
 func (p Builder) LoadModuleByPath(
 	ctx context.Context,
 	wd string,
@@ -26,9 +29,15 @@ func (p Builder) LoadModuleByPath(
 		return compiler.RawModule{}, "", fmt.Errorf("walk: %w", err)
 	}
 
+	goPkgs := map[string]compiler.RawPackage{}
+	if err := retrieveGoSourceCode(modRootPath, goPkgs); err != nil {
+		return compiler.RawModule{}, "", fmt.Errorf("walk go: %w", err)
+	}
+
 	return compiler.RawModule{
-		Manifest: manifest,
-		Packages: pkgs,
+		Manifest:   manifest,
+		Packages:   pkgs,
+		GoPackages: goPkgs,
 	}, modRootPath, nil
 }
 
@@ -72,6 +81,41 @@ func retrieveSourceCode(rootPath string, pkgs map[string]compiler.RawPackage) er
 	})
 }
 
+// retrieveGoSourceCode fills given pkgs with go files from "go" directory of the module (if there's one).
+// Packages are identified by their paths relative to that directory, test files are skipped.
+func retrieveGoSourceCode(modRootPath string, pkgs map[string]compiler.RawPackage) error {
+	goDirPath := filepath.Join(modRootPath, goDir)
+
+	if _, err := os.Stat(goDirPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	fsys := os.DirFS(goDirPath)
+	return fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("filepath walk: %s: %w", filePath, err)
+		}
+
+		if d.IsDir() || filepath.Ext(d.Name()) != ".go" || strings.HasSuffix(d.Name(), "_test.go") {
+			return nil
+		}
+
+		bb, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+
+		pkgPath := filepath.Dir(filePath)
+		if _, ok := pkgs[pkgPath]; !ok {
+			pkgs[pkgPath] = compiler.RawPackage{}
+		}
+
+		pkgs[pkgPath][d.Name()] = bb
+
+		return nil
+	})
+}
+
 func getPkgName(rootPath, filePath string) string {
 	dirPath := filepath.Dir(filePath)
 	if dirPath == rootPath { // current directory is root directory
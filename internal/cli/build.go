@@ -12,6 +12,7 @@ import (
 func newBuildCmd(
 	workdir string,
 	compilerToGo compiler.Compiler,
+	compilerToGoLib compiler.Compiler,
 	compilerToNative compiler.Compiler,
 	compilerToWASM compiler.Compiler,
 	compilerToJSON compiler.Compiler,
@@ -30,12 +31,26 @@ func newBuildCmd(
 				Name:  "trace",
 				Usage: "Write trace information to file",
 			},
+			traceFormatFlag,
+			&cli.StringFlag{
+				Name:  "profile",
+				Usage: "Write Chrome Trace Event file with message flow to given path (open it with chrome://tracing or ui.perfetto.dev)",
+			},
+			&cli.StringFlag{
+				Name:  "metrics",
+				Usage: "Write per-port message counts and blocking time to given path at exit and on SIGUSR1",
+			},
+			metricsFormatFlag,
+			chanBufferFlag,
+			drainTimeoutFlag,
+			flowtraceFlag,
+			werrorFlag,
 			&cli.StringFlag{
 				Name:  "target",
-				Usage: "Target platform for build (options: go, wasm, native, json, dot). For 'native' target, 'target-os' and 'target-arch' flags can be used, but if used, they must be used together.",
+				Usage: "Target platform for build (options: go, go-lib, wasm, native, json, dot). 'go-lib' produces Go package that exposes Main function to embed the program into Go code. For 'native' target, 'target-os' and 'target-arch' flags can be used, but if used, they must be used together.",
 				Action: func(ctx *cli.Context, s string) error {
 					switch s {
-					case "go", "wasm", "native", "json", "dot":
+					case "go", "go-lib", "wasm", "native", "json", "dot":
 						return nil
 					}
 					return fmt.Errorf("Unknown target %s", s)
@@ -60,7 +75,7 @@ func newBuildCmd(
 			}
 
 			switch target {
-			case "go", "wasm", "json", "dot", "native":
+			case "go", "go-lib", "wasm", "json", "dot", "native":
 			default:
 				return fmt.Errorf("Unknown target %s", target)
 			}
@@ -95,15 +110,25 @@ func newBuildCmd(
 			}
 
 			compilerInput := compiler.CompilerInput{
-				Main:   mainPkg,
-				Output: outputDirPath,
-				Trace:  isTraceEnabled,
+				Main:          mainPkg,
+				Output:        outputDirPath,
+				Trace:         isTraceEnabled,
+				TraceFormat:   cliCtx.String("trace-format"),
+				Profile:       cliCtx.String("profile"),
+				Metrics:       cliCtx.String("metrics"),
+				MetricsFormat: cliCtx.String("metrics-format"),
+				ChanBuffer:    cliCtx.Int("chan-buffer"),
+				DrainTimeout:  cliCtx.Duration("drain-timeout"),
+				Flowtrace:     cliCtx.Bool("flowtrace"),
+				Werror:        cliCtx.Bool("Werror"),
 			}
 
 			var compilerToUse compiler.Compiler
 			switch target {
 			case "go":
 				compilerToUse = compilerToGo
+			case "go-lib":
+				compilerToUse = compilerToGoLib
 			case "wasm":
 				compilerToUse = compilerToWASM
 			case "json":
@@ -135,7 +160,8 @@ func newBuildCmd(
 				}()
 			}
 
-			return compilerToUse.Compile(cliCtx.Context, compilerInput)
+			warnings, err := compilerToUse.Compile(cliCtx.Context, compilerInput)
+			return reportCompilation(warnings, err, compilerInput.Werror)
 		},
 	}
 }
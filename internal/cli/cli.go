@@ -3,9 +3,11 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	cli "github.com/urfave/cli/v2"
 
@@ -18,6 +20,7 @@ func NewApp(
 	workdir string,
 	bldr builder.Builder,
 	goc compiler.Compiler,
+	golibc compiler.Compiler,
 	nativec compiler.Compiler,
 	wasmc compiler.Compiler,
 	jsonc compiler.Compiler,
@@ -32,7 +35,7 @@ func NewApp(
 			newNewCmd(workdir),
 			newGetCmd(workdir, bldr),
 			newRunCmd(workdir, nativec),
-			newBuildCmd(workdir, goc, nativec, wasmc, jsonc, dotc),
+			newBuildCmd(workdir, goc, golibc, nativec, wasmc, jsonc, dotc),
 			newOSArchCmd(),
 		},
 	}
@@ -94,6 +97,18 @@ func newGetCmd(workdir string, bldr builder.Builder) *cli.Command {
 	}
 }
 
+// reportCompilation prints compiler warnings to stderr and returns compilation error.
+// Warnings treated as errors fail the command with non-zero exit code, so it can be used in CI.
+func reportCompilation(warnings []compiler.Warning, err error, werror bool) error {
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
+	if err != nil && werror && len(warnings) != 0 {
+		return cli.Exit(err, 1)
+	}
+	return err
+}
+
 func mainPkgPathFromArgs(cCtx *cli.Context) (string, error) {
 	arg := cCtx.Args().First()
 
@@ -108,3 +123,56 @@ func mainPkgPathFromArgs(cCtx *cli.Context) (string, error) {
 
 	return path, nil
 }
+
+var traceFormatFlag = &cli.StringFlag{
+	Name:  "trace-format",
+	Usage: "Format of the trace file (options: text, json). Only used with 'trace' flag",
+	Value: "text",
+	Action: func(_ *cli.Context, s string) error {
+		switch s {
+		case "text", "json":
+			return nil
+		}
+		return fmt.Errorf("Unknown trace format %s", s)
+	},
+}
+
+var metricsFormatFlag = &cli.StringFlag{
+	Name:  "metrics-format",
+	Usage: "Format of the metrics file (options: json, prometheus). Only used with 'metrics' flag",
+	Value: "json",
+	Action: func(_ *cli.Context, s string) error {
+		switch s {
+		case "json", "prometheus":
+			return nil
+		}
+		return fmt.Errorf("Unknown metrics format %s", s)
+	},
+}
+
+var chanBufferFlag = &cli.IntFlag{
+	Name:  "chan-buffer",
+	Usage: "Buffer size of connections that don't use #buffer directive. Zero means unbuffered connections",
+	Action: func(_ *cli.Context, n int) error {
+		if n < 0 {
+			return fmt.Errorf("Channel buffer size can't be negative: %d", n)
+		}
+		return nil
+	},
+}
+
+var flowtraceFlag = &cli.BoolFlag{
+	Name:  "flowtrace",
+	Usage: "Record ports messages travel through to print flowtrace of the message on panic",
+}
+
+var werrorFlag = &cli.BoolFlag{
+	Name:  "Werror",
+	Usage: "Treat compiler warnings as errors",
+}
+
+var drainTimeoutFlag = &cli.DurationFlag{
+	Name:  "drain-timeout",
+	Usage: "How long the program waits for functions to finish after SIGINT or SIGTERM before it exits",
+	Value: 5 * time.Second,
+}
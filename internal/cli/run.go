@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	"github.com/nevalang/neva/internal/compiler"
 
@@ -21,6 +24,20 @@ func newRunCmd(workdir string, nativec compiler.Compiler) *cli.Command {
 				Name:  "trace",
 				Usage: "Write trace information to file",
 			},
+			traceFormatFlag,
+			&cli.StringFlag{
+				Name:  "profile",
+				Usage: "Write Chrome Trace Event file with message flow to given path (open it with chrome://tracing or ui.perfetto.dev)",
+			},
+			&cli.StringFlag{
+				Name:  "metrics",
+				Usage: "Write per-port message counts and blocking time to given path at exit and on SIGUSR1",
+			},
+			metricsFormatFlag,
+			chanBufferFlag,
+			drainTimeoutFlag,
+			flowtraceFlag,
+			werrorFlag,
 		},
 		ArgsUsage: "Provide path to main package",
 		Action: func(cliCtx *cli.Context) error {
@@ -40,12 +57,21 @@ func newRunCmd(workdir string, nativec compiler.Compiler) *cli.Command {
 			}
 
 			input := compiler.CompilerInput{
-				Main:   mainPkg,
-				Output: output,
-				Trace:  trace,
+				Main:          mainPkg,
+				Output:        output,
+				Trace:         trace,
+				TraceFormat:   cliCtx.String("trace-format"),
+				Profile:       cliCtx.String("profile"),
+				Metrics:       cliCtx.String("metrics"),
+				MetricsFormat: cliCtx.String("metrics-format"),
+				ChanBuffer:    cliCtx.Int("chan-buffer"),
+				DrainTimeout:  cliCtx.Duration("drain-timeout"),
+				Flowtrace:     cliCtx.Bool("flowtrace"),
+				Werror:        cliCtx.Bool("Werror"),
 			}
 
-			if err := nativec.Compile(cliCtx.Context, input); err != nil {
+			warnings, err := nativec.Compile(cliCtx.Context, input)
+			if err := reportCompilation(warnings, err, input.Werror); err != nil {
 				return err
 			}
 
@@ -62,7 +88,34 @@ func newRunCmd(workdir string, nativec compiler.Compiler) *cli.Command {
 			cmd.Stdout = os.Stdout
 			cmd.Stderr = os.Stderr
 
-			return cmd.Run()
+			if err := cmd.Start(); err != nil {
+				return err
+			}
+
+			// Program handles signals by itself. SIGINT from terminal is delivered to the whole
+			// process group so we only need to survive it, but SIGTERM must be forwarded.
+			signals := make(chan os.Signal, 1)
+			defer close(signals)
+			signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(signals)
+			go func() {
+				for sig := range signals {
+					if sig == syscall.SIGTERM {
+						_ = cmd.Process.Signal(sig)
+					}
+				}
+			}()
+
+			// exit code of the program is the exit code of the run command,
+			// program has already reported the reason to stderr
+			var exitErr *exec.ExitError
+			if err := cmd.Wait(); errors.As(err, &exitErr) {
+				return cli.Exit("", exitErr.ExitCode())
+			} else if err != nil {
+				return err
+			}
+
+			return nil
 		},
 	}
 }
@@ -13,6 +13,7 @@ import (
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 var (
@@ -21,9 +22,13 @@ var (
 
 type Analyzer struct {
 	resolver ts.Resolver
+	externs  map[string]runtime.FuncSignature // runtime functions that #extern directive can point to
 }
 
-func (a Analyzer) AnalyzeExecutableBuild(build src.Build, mainPkgName string) (src.Build, *compiler.Error) {
+func (a Analyzer) AnalyzeExecutableBuild(
+	build src.Build,
+	mainPkgName string,
+) (src.Build, []compiler.Warning, *compiler.Error) {
 	meta := core.Meta{
 		Location: core.Location{
 			ModRef:  build.EntryModRef,
@@ -33,14 +38,14 @@ func (a Analyzer) AnalyzeExecutableBuild(build src.Build, mainPkgName string) (s
 
 	entryMod, ok := build.Modules[build.EntryModRef]
 	if !ok {
-		return src.Build{}, &compiler.Error{
+		return src.Build{}, nil, &compiler.Error{
 			Message: fmt.Sprintf("entry module not found: %s", build.EntryModRef),
 			Meta:    &meta,
 		}
 	}
 
 	if _, ok := entryMod.Packages[mainPkgName]; !ok {
-		return src.Build{}, &compiler.Error{
+		return src.Build{}, nil, &compiler.Error{
 			Message: "main package not found",
 			Meta:    &meta,
 		}
@@ -49,45 +54,98 @@ func (a Analyzer) AnalyzeExecutableBuild(build src.Build, mainPkgName string) (s
 	scope := src.NewScope(build, meta.Location)
 
 	if err := a.mainSpecificPkgValidation(mainPkgName, entryMod, scope); err != nil {
-		return src.Build{}, compiler.Error{Meta: &meta}.Wrap(err)
+		return src.Build{}, nil, compiler.Error{Meta: &meta}.Wrap(err)
 	}
 
-	analyzedBuild, err := a.AnalyzeBuild(build)
+	analyzedBuild, warnings, err := a.AnalyzeBuild(build)
 	if err != nil {
-		return src.Build{}, compiler.Error{Meta: &meta}.Wrap(err)
+		return src.Build{}, nil, compiler.Error{Meta: &meta}.Wrap(err)
 	}
 
-	return analyzedBuild, nil
+	return analyzedBuild, warnings, nil
 }
 
-func (a Analyzer) AnalyzeBuild(build src.Build) (src.Build, *compiler.Error) {
+// AnalyzeBuild analyzes every module of the build.
+// Warnings are only reported for the entry module, dependencies are not something user can fix.
+func (a Analyzer) AnalyzeBuild(build src.Build) (src.Build, []compiler.Warning, *compiler.Error) {
 	analyzedMods := make(map[core.ModuleRef]src.Module, len(build.Modules))
 
+	if err := a.analyzeGoExterns(build); err != nil {
+		return src.Build{}, nil, err
+	}
+
+	refs := src.NewReferences()
+
+	var warnings []compiler.Warning
 	for modRef, mod := range build.Modules {
 		if err := a.semverCheck(mod, modRef); err != nil {
-			return src.Build{}, err
+			return src.Build{}, nil, err
 		}
 
-		analyzedPkgs, err := a.analyzeModule(modRef, build)
+		analyzedPkgs, modWarnings, err := a.analyzeModule(modRef, build, refs)
 		if err != nil {
-			return src.Build{}, err
+			return src.Build{}, nil, err
+		}
+
+		if modRef == build.EntryModRef {
+			warnings = append(warnings, modWarnings...)
 		}
 
 		analyzedMods[modRef] = src.Module{
-			Manifest: mod.Manifest,
-			Packages: analyzedPkgs,
+			Manifest:   mod.Manifest,
+			Packages:   analyzedPkgs,
+			GoPackages: mod.GoPackages,
 		}
 	}
 
+	warnings = append(warnings, a.analyzeReferences(build, refs)...)
+
 	return src.Build{
 		EntryModRef: build.EntryModRef,
 		Modules:     analyzedMods,
-	}, nil
+	}, warnings, nil
+}
+
+// analyzeGoExterns makes sure runtime functions implemented by modules' Go packages
+// don't collide with each other and with the ones provided by the runtime,
+// because at the end they all are merged into a single registry.
+func (a Analyzer) analyzeGoExterns(build src.Build) *compiler.Error {
+	providers := make(map[string]core.ModuleRef)
+
+	for modRef, mod := range build.Modules {
+		for _, goPkg := range mod.GoPackages {
+			for _, ref := range goPkg.Externs {
+				meta := &core.Meta{Location: core.Location{ModRef: modRef}}
+
+				if _, ok := a.externs[ref]; ok {
+					return &compiler.Error{
+						Message: fmt.Sprintf("Runtime function '%v' is already provided by the runtime", ref),
+						Meta:    meta,
+					}
+				}
+
+				if provider, ok := providers[ref]; ok {
+					return &compiler.Error{
+						Message: fmt.Sprintf("Runtime function '%v' is already provided by module %v", ref, provider),
+						Meta:    meta,
+					}
+				}
+
+				providers[ref] = modRef
+			}
+		}
+	}
+
+	return nil
 }
 
-func (a Analyzer) analyzeModule(modRef core.ModuleRef, build src.Build) (map[string]src.Package, *compiler.Error) {
+func (a Analyzer) analyzeModule(
+	modRef core.ModuleRef,
+	build src.Build,
+	refs *src.References,
+) (map[string]src.Package, []compiler.Warning, *compiler.Error) {
 	if modRef != build.EntryModRef && modRef.Version == "" {
-		return nil, &compiler.Error{
+		return nil, nil, &compiler.Error{
 			Message: "every dependency module must have version",
 			Meta: &core.Meta{
 				Location: core.Location{
@@ -101,7 +159,7 @@ func (a Analyzer) analyzeModule(modRef core.ModuleRef, build src.Build) (map[str
 	mod := build.Modules[modRef]
 
 	if len(mod.Packages) == 0 {
-		return nil, &compiler.Error{
+		return nil, nil, &compiler.Error{
 			Message: "module must contain at least one package",
 			Meta: &core.Meta{
 				Location: location,
@@ -112,15 +170,16 @@ func (a Analyzer) analyzeModule(modRef core.ModuleRef, build src.Build) (map[str
 	pkgsCopy := make(map[string]src.Package, len(mod.Packages))
 	maps.Copy(pkgsCopy, mod.Packages)
 
+	var warnings []compiler.Warning
 	for pkgName, pkg := range pkgsCopy {
 		scope := src.NewScope(build, core.Location{
 			ModRef:  modRef,
 			Package: pkgName,
-		})
+		}).WithReferences(refs)
 
-		resolvedPkg, err := a.analyzePkg(pkg, scope)
+		resolvedPkg, pkgWarnings, err := a.analyzePkg(pkg, scope)
 		if err != nil {
-			return nil, compiler.Error{
+			return nil, nil, compiler.Error{
 				Meta: &core.Meta{
 					Location: core.Location{
 						Package: pkgName,
@@ -130,14 +189,15 @@ func (a Analyzer) analyzeModule(modRef core.ModuleRef, build src.Build) (map[str
 		}
 
 		pkgsCopy[pkgName] = resolvedPkg
+		warnings = append(warnings, pkgWarnings...)
 	}
 
-	return pkgsCopy, nil
+	return pkgsCopy, warnings, nil
 }
 
-func (a Analyzer) analyzePkg(pkg src.Package, scope src.Scope) (src.Package, *compiler.Error) {
+func (a Analyzer) analyzePkg(pkg src.Package, scope src.Scope) (src.Package, []compiler.Warning, *compiler.Error) {
 	if len(pkg) == 0 {
-		return nil, &compiler.Error{
+		return nil, nil, &compiler.Error{
 			Message: "package must contain at least one file",
 			Meta: &core.Meta{
 				Location: *scope.Location(),
@@ -154,6 +214,7 @@ func (a Analyzer) analyzePkg(pkg src.Package, scope src.Scope) (src.Package, *co
 		}
 	}
 
+	var warnings []compiler.Warning
 	for result := range pkg.Entities() {
 		relocatedScope := scope.Relocate(core.Location{
 			ModRef:   scope.Location().ModRef,
@@ -161,20 +222,24 @@ func (a Analyzer) analyzePkg(pkg src.Package, scope src.Scope) (src.Package, *co
 			Filename: result.FileName,
 		})
 
-		analyzedEntity, err := a.analyzeEntity(result.Entity, relocatedScope)
+		analyzedEntity, entityWarnings, err := a.analyzeEntity(result.Entity, relocatedScope)
 		if err != nil {
-			return nil, compiler.Error{
+			return nil, nil, compiler.Error{
 				Meta: result.Entity.Meta(),
 			}.Wrap(err)
 		}
 
 		analyzedFiles[result.FileName].Entities[result.EntityName] = analyzedEntity
+		warnings = append(warnings, entityWarnings...)
 	}
 
-	return analyzedFiles, nil
+	return analyzedFiles, warnings, nil
 }
 
-func (a Analyzer) analyzeEntity(entity src.Entity, scope src.Scope) (src.Entity, *compiler.Error) {
+func (a Analyzer) analyzeEntity(
+	entity src.Entity,
+	scope src.Scope,
+) (src.Entity, []compiler.Warning, *compiler.Error) {
 	resolvedEntity := src.Entity{
 		IsPublic: entity.IsPublic,
 		Kind:     entity.Kind,
@@ -182,12 +247,14 @@ func (a Analyzer) analyzeEntity(entity src.Entity, scope src.Scope) (src.Entity,
 
 	isStd := scope.Location().ModRef.Path == "std"
 
+	var warnings []compiler.Warning
+
 	switch entity.Kind {
 	case src.TypeEntity:
 		resolvedTypeDef, err := a.analyzeTypeDef(entity.Type, scope, analyzeTypeDefParams{allowEmptyBody: isStd})
 		if err != nil {
 			meta := entity.Type.Meta
-			return src.Entity{}, compiler.Error{
+			return src.Entity{}, nil, compiler.Error{
 				Meta: &meta,
 			}.Wrap(err)
 		}
@@ -196,7 +263,7 @@ func (a Analyzer) analyzeEntity(entity src.Entity, scope src.Scope) (src.Entity,
 		resolvedConst, err := a.analyzeConst(entity.Const, scope)
 		if err != nil {
 			meta := entity.Const.Meta
-			return src.Entity{}, compiler.Error{
+			return src.Entity{}, nil, compiler.Error{
 				Meta: &meta,
 			}.Wrap(err)
 		}
@@ -208,29 +275,33 @@ func (a Analyzer) analyzeEntity(entity src.Entity, scope src.Scope) (src.Entity,
 		})
 		if err != nil {
 			meta := entity.Interface.Meta
-			return src.Entity{}, compiler.Error{
+			return src.Entity{}, nil, compiler.Error{
 				Meta: &meta,
 			}.Wrap(err)
 		}
 		resolvedEntity.Interface = resolvedInterface
 	case src.ComponentEntity:
-		analyzedComponent, err := a.analyzeComponent(entity.Component, scope)
+		analyzedComponent, componentWarnings, err := a.analyzeComponent(entity.Component, scope)
 		if err != nil {
-			return src.Entity{}, compiler.Error{
+			return src.Entity{}, nil, compiler.Error{
 				Meta: &entity.Component.Meta,
 			}.Wrap(err)
 		}
 		resolvedEntity.Component = analyzedComponent
+		warnings = componentWarnings
 	default:
-		return src.Entity{}, &compiler.Error{
+		return src.Entity{}, nil, &compiler.Error{
 			Message: fmt.Sprintf("unknown entity kind: %v", entity.Kind),
 			Meta:    entity.Meta(),
 		}
 	}
 
-	return resolvedEntity, nil
+	return resolvedEntity, warnings, nil
 }
 
-func MustNew(resolver ts.Resolver) Analyzer {
-	return Analyzer{resolver: resolver}
+func MustNew(resolver ts.Resolver, externs map[string]runtime.FuncSignature) Analyzer {
+	return Analyzer{
+		resolver: resolver,
+		externs:  externs,
+	}
 }
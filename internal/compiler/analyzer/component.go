@@ -1,30 +1,40 @@
 package analyzer
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 func (a Analyzer) analyzeComponent(
 	component src.Component,
 	scope src.Scope,
-) (src.Component, *compiler.Error) {
+) (src.Component, []compiler.Warning, *compiler.Error) {
 	runtimeFuncArgs, isRuntimeFunc := component.Directives[compiler.ExternDirective]
 
 	if isRuntimeFunc && len(runtimeFuncArgs) == 0 {
-		return src.Component{}, &compiler.Error{
+		return src.Component{}, nil, &compiler.Error{
 			Message: "Component that use #extern directive must provide at least one argument",
 			Meta:    &component.Meta,
 		}
 	}
 
+	if _, hasTypeInfo := component.Directives[compiler.TypeInfoDirective]; hasTypeInfo && !isRuntimeFunc {
+		return src.Component{}, nil, &compiler.Error{
+			Message: "Component that use #typeinfo directive must use #extern directive",
+			Meta:    &component.Meta,
+		}
+	}
+
 	if len(runtimeFuncArgs) > 1 {
 		for _, runtimeFuncArg := range runtimeFuncArgs {
 			parts := strings.Split(runtimeFuncArg, " ")
 			if len(parts) != 2 {
-				return src.Component{}, &compiler.Error{
+				return src.Component{}, nil, &compiler.Error{
 					Message: "Component that use #extern with more than one argument must provide arguments in a form of <type, flow_ref> pairs",
 					Meta:    &component.Meta,
 				}
@@ -32,6 +42,10 @@ func (a Analyzer) analyzeComponent(
 		}
 	}
 
+	if err := a.analyzeExternSignatures(component, scope); err != nil {
+		return src.Component{}, nil, err
+	}
+
 	resolvedInterface, err := a.analyzeInterface(
 		component.Interface,
 		scope,
@@ -41,19 +55,19 @@ func (a Analyzer) analyzeComponent(
 		},
 	)
 	if err != nil {
-		return src.Component{}, compiler.Error{
+		return src.Component{}, nil, compiler.Error{
 			Meta: &component.Meta,
 		}.Wrap(err)
 	}
 
 	if isRuntimeFunc {
 		if len(component.Nodes) != 0 || len(component.Net) != 0 {
-			return src.Component{}, &compiler.Error{
+			return src.Component{}, nil, &compiler.Error{
 				Message: "Component with nodes or network cannot use #extern directive",
 				Meta:    &component.Meta,
 			}
 		}
-		return component, nil
+		return component, nil, nil
 	}
 
 	resolvedNodes, nodesIfaces, hasGuard, err := a.analyzeNodes(
@@ -62,19 +76,19 @@ func (a Analyzer) analyzeComponent(
 		scope,
 	)
 	if err != nil {
-		return src.Component{}, compiler.Error{
+		return src.Component{}, nil, compiler.Error{
 			Meta: &component.Meta,
 		}.Wrap(err)
 	}
 
 	if len(component.Net) == 0 {
-		return src.Component{}, &compiler.Error{
+		return src.Component{}, nil, &compiler.Error{
 			Message: "Component must have network",
 			Meta:    &component.Meta,
 		}
 	}
 
-	analyzedNet, err := a.analyzeNetwork(
+	analyzedNet, warnings, err := a.analyzeNetwork(
 		component.Net,
 		resolvedInterface,
 		hasGuard,
@@ -83,7 +97,7 @@ func (a Analyzer) analyzeComponent(
 		scope,
 	)
 	if err != nil {
-		return src.Component{}, compiler.Error{
+		return src.Component{}, nil, compiler.Error{
 			Meta: &component.Meta,
 		}.Wrap(err)
 	}
@@ -93,5 +107,84 @@ func (a Analyzer) analyzeComponent(
 		Nodes:     resolvedNodes,
 		Net:       analyzedNet,
 		Meta:      component.Meta,
-	}, nil
+	}, warnings, nil
+}
+
+// analyzeExternSignatures makes sure every runtime function referenced by #extern directive
+// is provided by the runtime and expects exactly the ports that component declares,
+// so the mistake is reported by compiler and not at program startup.
+// Functions implemented by module's Go packages are only checked for existence
+// because their signatures are not known until Go code is compiled.
+func (a Analyzer) analyzeExternSignatures(component src.Component, scope src.Scope) *compiler.Error {
+	_, isAutoports := component.Directives[compiler.AutoportsDirective]
+
+	for _, runtimeFuncArg := range component.Directives[compiler.ExternDirective] {
+		parts := strings.Split(runtimeFuncArg, " ")
+		ref := parts[len(parts)-1]
+
+		if mod, ok := scope.Module(); ok {
+			if _, isGoExtern := mod.Extern(ref); isGoExtern {
+				continue
+			}
+		}
+
+		sig, ok := a.externs[ref]
+		if !ok {
+			return &compiler.Error{
+				Message: fmt.Sprintf("Runtime function not found: %v", ref),
+				Meta:    &component.Meta,
+			}
+		}
+
+		if !isAutoports {
+			if err := a.analyzeExternPorts(ref, "inport", component.IO.In, sig.In, component.Meta); err != nil {
+				return err
+			}
+		}
+
+		if err := a.analyzeExternPorts(ref, "outport", component.IO.Out, sig.Out, component.Meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (Analyzer) analyzeExternPorts(
+	ref string,
+	direction string,
+	ports map[string]src.Port,
+	sig map[string]runtime.PortKind,
+	meta core.Meta,
+) *compiler.Error {
+	for name, port := range ports {
+		kind, ok := sig[name]
+		if !ok {
+			return &compiler.Error{
+				Message: fmt.Sprintf("Runtime function '%v' has no %v '%v'", ref, direction, name),
+				Meta:    &port.Meta,
+			}
+		}
+
+		if port.IsArray != (kind == runtime.ArrayPort) {
+			return &compiler.Error{
+				Message: fmt.Sprintf(
+					"Runtime function '%v' expects %v %v '%v'",
+					ref, kind, direction, name,
+				),
+				Meta: &port.Meta,
+			}
+		}
+	}
+
+	for name := range sig {
+		if _, ok := ports[name]; !ok {
+			return &compiler.Error{
+				Message: fmt.Sprintf("Runtime function '%v' expects %v '%v'", ref, direction, name),
+				Meta:    &meta,
+			}
+		}
+	}
+
+	return nil
 }
@@ -3,19 +3,46 @@ package analyzer
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 )
 
 var (
 	ErrConstSeveralValues = errors.New("Constant cannot have several values at once")
 )
 
+// constRef identifies constant by its location and name, it's used to detect cyclic constant definitions.
+type constRef struct {
+	location core.Location
+	name     string
+}
+
 func (a Analyzer) analyzeConst(
 	constant src.Const,
 	scope src.Scope,
 ) (src.Const, *compiler.Error) {
+	return a.analyzeConstInChain(constant, scope, nil)
+}
+
+// analyzeConstInChain is analyzeConst for constant that is referred by the chain of constants being resolved.
+func (a Analyzer) analyzeConstInChain(
+	constant src.Const,
+	scope src.Scope,
+	chain []constRef,
+) (src.Const, *compiler.Error) {
+	// constant expressions are evaluated at compile time, later stages only see the resulting literal
+	if constant.Value.Binary != nil {
+		folded, _, err := a.foldConstExpr(*constant.Value.Binary, scope, chain)
+		if err != nil {
+			return src.Const{}, compiler.Error{Meta: &constant.Meta}.Wrap(err)
+		}
+		folded.Meta = constant.Meta
+		constant.Value = src.ConstValue{Message: &folded}
+	}
+
 	if constant.Value.Message == nil && constant.Value.Ref == nil {
 		return src.Const{}, &compiler.Error{
 			Message: "Constant must either have value or reference to another constant",
@@ -24,7 +51,7 @@ func (a Analyzer) analyzeConst(
 	}
 
 	if constant.Value.Message == nil { // is ref
-		entity, _, err := scope.Entity(*constant.Value.Ref)
+		entity, location, err := scope.Entity(*constant.Value.Ref)
 		if err != nil {
 			return src.Const{}, &compiler.Error{
 				Message: err.Error(),
@@ -39,7 +66,12 @@ func (a Analyzer) analyzeConst(
 			}
 		}
 
-		return a.analyzeConst(entity.Const, scope)
+		chain, chainErr := followConstRef(chain, *constant.Value.Ref, location)
+		if chainErr != nil {
+			return src.Const{}, chainErr
+		}
+
+		return a.analyzeConstInChain(entity.Const, scope, chain)
 	}
 
 	resolvedType, err := a.analyzeTypeExpr(constant.TypeExpr, scope)
@@ -65,9 +97,18 @@ func (a Analyzer) analyzeConst(
 			typeExprStrRepr = "enum"
 		} else if lit.Struct != nil {
 			typeExprStrRepr = "struct"
+		} else if lit.Tagged != nil {
+			typeExprStrRepr = "union"
 		}
 	}
 
+	// struct literal syntax is allowed for dict constants
+	constant.Value = normalizeDictLiterals(constant.Value, resolvedType)
+
+	if err := a.analyzeNestedConstRefs(*constant.Value.Message, scope); err != nil {
+		return src.Const{}, compiler.Error{Meta: &constant.Meta}.Wrap(err)
+	}
+
 	switch typeExprStrRepr {
 	case "bool":
 		if constant.Value.Message.Bool == nil {
@@ -80,7 +121,8 @@ func (a Analyzer) analyzeConst(
 			constant.Value.Message.Float != nil ||
 			constant.Value.Message.Str != nil ||
 			constant.Value.Message.List != nil ||
-			constant.Value.Message.DictOrStruct != nil ||
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil ||
 			constant.Value.Message.Enum != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
@@ -101,7 +143,8 @@ func (a Analyzer) analyzeConst(
 			constant.Value.Message.Float != nil ||
 			constant.Value.Message.Str != nil ||
 			constant.Value.Message.List != nil ||
-			constant.Value.Message.DictOrStruct != nil ||
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil ||
 			constant.Value.Message.Enum != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
@@ -132,7 +175,8 @@ func (a Analyzer) analyzeConst(
 		if constant.Value.Message.Bool != nil ||
 			constant.Value.Message.Str != nil ||
 			constant.Value.Message.List != nil ||
-			constant.Value.Message.DictOrStruct != nil ||
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil ||
 			constant.Value.Message.Enum != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
@@ -153,7 +197,8 @@ func (a Analyzer) analyzeConst(
 			constant.Value.Message.Int != nil ||
 			constant.Value.Message.Float != nil ||
 			constant.Value.Message.List != nil ||
-			constant.Value.Message.DictOrStruct != nil ||
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil ||
 			constant.Value.Message.Enum != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
@@ -173,7 +218,8 @@ func (a Analyzer) analyzeConst(
 		if constant.Value.Message.Bool != nil ||
 			constant.Value.Message.Int != nil ||
 			constant.Value.Message.Float != nil ||
-			constant.Value.Message.DictOrStruct != nil ||
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil ||
 			constant.Value.Message.Enum != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
@@ -183,10 +229,30 @@ func (a Analyzer) analyzeConst(
 				Meta: &constant.Meta,
 			}
 		}
-	case "map", "struct":
-		if constant.Value.Message.DictOrStruct == nil {
+	case "dict":
+		if constant.Value.Message.Dict == nil {
 			return src.Const{}, &compiler.Error{
-				Message: fmt.Sprintf("Map or struct value is missing in map or struct contant: %v", constant),
+				Message: fmt.Sprintf("Dict value is missing in dict contant: %v", constant),
+				Meta:    &constant.Meta,
+			}
+		}
+		if constant.Value.Message.Bool != nil ||
+			constant.Value.Message.Int != nil ||
+			constant.Value.Message.Float != nil ||
+			constant.Value.Message.List != nil ||
+			constant.Value.Message.Enum != nil {
+			return src.Const{}, &compiler.Error{
+				Message: fmt.Sprintf(
+					"Constant cannot have several values at once: %v",
+					constant.Value.Message,
+				),
+				Meta: &constant.Meta,
+			}
+		}
+	case "struct":
+		if constant.Value.Message.Struct == nil {
+			return src.Const{}, &compiler.Error{
+				Message: fmt.Sprintf("Struct value is missing in struct contant: %v", constant),
 				Meta:    &constant.Meta,
 			}
 		}
@@ -214,7 +280,8 @@ func (a Analyzer) analyzeConst(
 			constant.Value.Message.Int != nil ||
 			constant.Value.Message.Float != nil ||
 			constant.Value.Message.List != nil ||
-			constant.Value.Message.DictOrStruct != nil {
+			constant.Value.Message.Dict != nil ||
+			constant.Value.Message.Struct != nil {
 			return src.Const{}, &compiler.Error{
 				Message: fmt.Sprintf(
 					"Constant cannot have several values at once: %v",
@@ -223,6 +290,12 @@ func (a Analyzer) analyzeConst(
 				Meta: &constant.Meta,
 			}
 		}
+	case "union":
+		analyzedMsg, err := a.analyzeUnionLiteral(*constant.Value.Message, resolvedType, scope)
+		if err != nil {
+			return src.Const{}, compiler.Error{Meta: &constant.Meta}.Wrap(err)
+		}
+		constant.Value.Message = &analyzedMsg
 	}
 
 	return src.Const{
@@ -231,3 +304,67 @@ func (a Analyzer) analyzeConst(
 		Meta:     constant.Meta,
 	}, nil
 }
+
+// analyzeNestedConstRefs checks that references inside list, dict and struct literals
+// (e.g. `[one, two]`) point to existing constants.
+func (a Analyzer) analyzeNestedConstRefs(msg src.MsgLiteral, scope src.Scope) *compiler.Error {
+	values := make([]src.ConstValue, 0, len(msg.List)+len(msg.Dict)+len(msg.Struct))
+	values = append(values, msg.List...)
+	for _, v := range msg.Dict {
+		values = append(values, v)
+	}
+	for _, v := range msg.Struct {
+		values = append(values, v)
+	}
+
+	for _, v := range values {
+		if v.Message != nil {
+			if err := a.analyzeNestedConstRefs(*v.Message, scope); err != nil {
+				return err
+			}
+			continue
+		}
+		if v.Ref == nil {
+			continue
+		}
+		entity, _, err := scope.Entity(*v.Ref)
+		if err != nil {
+			return &compiler.Error{
+				Message: err.Error(),
+				Meta:    &v.Ref.Meta,
+			}
+		}
+		if entity.Kind != src.ConstEntity {
+			return &compiler.Error{
+				Message: fmt.Sprintf("Constant refers to an entity that is not constant: %v", entity.Kind),
+				Meta:    &v.Ref.Meta,
+			}
+		}
+	}
+
+	return nil
+}
+
+// followConstRef returns chain extended with referenced constant or error if constant is already in the chain.
+func followConstRef(chain []constRef, ref core.EntityRef, location core.Location) ([]constRef, *compiler.Error) {
+	next := constRef{location: location, name: ref.Name}
+
+	for i, prev := range chain {
+		if prev != next {
+			continue
+		}
+
+		names := make([]string, 0, len(chain)-i+1)
+		for _, c := range chain[i:] {
+			names = append(names, c.name)
+		}
+		names = append(names, next.name)
+
+		return nil, &compiler.Error{
+			Message: fmt.Sprintf("Cyclic constant definition: %v", strings.Join(names, " -> ")),
+			Meta:    &ref.Meta,
+		}
+	}
+
+	return append(chain[:len(chain):len(chain)], next), nil
+}
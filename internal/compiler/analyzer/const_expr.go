@@ -0,0 +1,280 @@
+package analyzer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+)
+
+// foldConstExpr evaluates constant expression at compile time and returns resulting literal with its type.
+// Operands are type-checked the same way as in binary expressions of the network.
+// Chain is the constants being resolved, it's used to detect cycles like `const a int = b + 1` and `const b int = a + 1`.
+func (a Analyzer) foldConstExpr(
+	binary src.Binary,
+	scope src.Scope,
+	chain []constRef,
+) (src.MsgLiteral, ts.Expr, *compiler.Error) {
+	left, leftType, err := a.foldConstOperand(binary.Left, scope, chain)
+	if err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, err
+	}
+
+	right, rightType, err := a.foldConstOperand(binary.Right, scope, chain)
+	if err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, err
+	}
+
+	constr, err := a.getOperatorConstraint(binary)
+	if err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, err
+	}
+
+	if err := a.resolver.IsSubtypeOf(leftType, constr, scope); err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+			Message: fmt.Sprintf(
+				"Invalid left operand type for %s: %v does not satisfy %v: %v",
+				binary.Operator, leftType, constr, err,
+			),
+			Meta: &binary.Meta,
+		}
+	}
+
+	if err := a.resolver.IsSubtypeOf(rightType, constr, scope); err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+			Message: fmt.Sprintf(
+				"Invalid right operand type for %s: %v does not satisfy %v: %v",
+				binary.Operator, rightType, constr, err,
+			),
+			Meta: &binary.Meta,
+		}
+	}
+
+	result, evalErr := evalConstBinary(binary.Operator, left, right)
+	if evalErr != nil {
+		return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+			Message: fmt.Sprintf("Cannot evaluate constant expression %v: %v", binary, evalErr),
+			Meta:    &binary.Meta,
+		}
+	}
+
+	resultType := a.getBinaryExprType(binary.Operator, leftType)
+	if result.Float != nil {
+		resultType = ts.Expr{
+			Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "float"}},
+		}
+	}
+
+	return result, resultType, nil
+}
+
+// foldConstOperand returns literal value and type of the constant expression operand.
+func (a Analyzer) foldConstOperand(
+	operand src.ConnectionSender,
+	scope src.Scope,
+	chain []constRef,
+) (src.MsgLiteral, ts.Expr, *compiler.Error) {
+	value := operand.Const.Value
+
+	if value.Binary != nil {
+		return a.foldConstExpr(*value.Binary, scope, chain)
+	}
+
+	if value.Ref != nil {
+		entity, location, err := scope.Entity(*value.Ref)
+		if err != nil {
+			return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+				Message: err.Error(),
+				Meta:    &value.Ref.Meta,
+			}
+		}
+
+		if entity.Kind != src.ConstEntity {
+			return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+				Message: fmt.Sprintf("Constant expression refers to an entity that is not constant: %v", entity.Kind),
+				Meta:    &value.Ref.Meta,
+			}
+		}
+
+		chain, chainErr := followConstRef(chain, *value.Ref, location)
+		if chainErr != nil {
+			return src.MsgLiteral{}, ts.Expr{}, chainErr
+		}
+
+		analyzedConst, analyzeErr := a.analyzeConstInChain(entity.Const, scope.Relocate(location), chain)
+		if analyzeErr != nil {
+			return src.MsgLiteral{}, ts.Expr{}, compiler.Error{Meta: &value.Ref.Meta}.Wrap(analyzeErr)
+		}
+
+		return *analyzedConst.Value.Message, analyzedConst.TypeExpr, nil
+	}
+
+	if value.Message == nil {
+		return src.MsgLiteral{}, ts.Expr{}, &compiler.Error{
+			Message: "Constant expression operand must either be literal or reference to another constant",
+			Meta:    &operand.Meta,
+		}
+	}
+
+	literalType, err := a.inferLiteralType(value, scope)
+	if err != nil {
+		return src.MsgLiteral{}, ts.Expr{}, err
+	}
+
+	return *value.Message, literalType, nil
+}
+
+// evalConstBinary computes result of the operator applied to primitive literals.
+// Integer operand is converted to float if the other one is float.
+func evalConstBinary(op src.BinaryOperator, left, right src.MsgLiteral) (src.MsgLiteral, error) {
+	switch {
+	case left.Int != nil && right.Int != nil:
+		return evalConstIntBinary(op, int64(*left.Int), int64(*right.Int))
+	case (left.Int != nil || left.Float != nil) && (right.Int != nil || right.Float != nil):
+		return evalConstFloatBinary(op, constLiteralToFloat(left), constLiteralToFloat(right))
+	case left.Str != nil && right.Str != nil:
+		return evalConstStringBinary(op, *left.Str, *right.Str)
+	case left.Bool != nil && right.Bool != nil:
+		return evalConstBoolBinary(op, *left.Bool, *right.Bool)
+	default:
+		return src.MsgLiteral{}, fmt.Errorf("incompatible operands %v and %v", left, right)
+	}
+}
+
+func constLiteralToFloat(msg src.MsgLiteral) float64 {
+	if msg.Float != nil {
+		return *msg.Float
+	}
+	return float64(*msg.Int)
+}
+
+func evalConstIntBinary(op src.BinaryOperator, l, r int64) (src.MsgLiteral, error) {
+	var res int64
+	switch op {
+	case src.AddOp:
+		res = l + r
+	case src.SubOp:
+		res = l - r
+	case src.MulOp:
+		res = l * r
+	case src.DivOp, src.ModOp:
+		if r == 0 {
+			return src.MsgLiteral{}, fmt.Errorf("division by zero")
+		}
+		if op == src.DivOp {
+			res = l / r
+		} else {
+			res = l % r
+		}
+	case src.PowOp:
+		if r < 0 {
+			return src.MsgLiteral{}, fmt.Errorf("negative exponent %v", r)
+		}
+		res = int64(math.Pow(float64(l), float64(r)))
+	case src.BitAndOp:
+		res = l & r
+	case src.BitOrOp:
+		res = l | r
+	case src.BitXorOp:
+		res = l ^ r
+	case src.BitLshOp, src.BitRshOp:
+		if r < 0 {
+			return src.MsgLiteral{}, fmt.Errorf("negative shift count %v", r)
+		}
+		if op == src.BitLshOp {
+			res = l << r
+		} else {
+			res = l >> r
+		}
+	case src.EqOp:
+		return constBoolLiteral(l == r), nil
+	case src.NeOp:
+		return constBoolLiteral(l != r), nil
+	case src.GtOp:
+		return constBoolLiteral(l > r), nil
+	case src.LtOp:
+		return constBoolLiteral(l < r), nil
+	case src.GeOp:
+		return constBoolLiteral(l >= r), nil
+	case src.LeOp:
+		return constBoolLiteral(l <= r), nil
+	default:
+		return src.MsgLiteral{}, fmt.Errorf("operator %v is not supported for integers", op)
+	}
+	return src.MsgLiteral{Int: compiler.Pointer(int(res))}, nil
+}
+
+func evalConstFloatBinary(op src.BinaryOperator, l, r float64) (src.MsgLiteral, error) {
+	var res float64
+	switch op {
+	case src.AddOp:
+		res = l + r
+	case src.SubOp:
+		res = l - r
+	case src.MulOp:
+		res = l * r
+	case src.DivOp:
+		if r == 0 {
+			return src.MsgLiteral{}, fmt.Errorf("division by zero")
+		}
+		res = l / r
+	case src.EqOp:
+		return constBoolLiteral(l == r), nil
+	case src.NeOp:
+		return constBoolLiteral(l != r), nil
+	case src.GtOp:
+		return constBoolLiteral(l > r), nil
+	case src.LtOp:
+		return constBoolLiteral(l < r), nil
+	case src.GeOp:
+		return constBoolLiteral(l >= r), nil
+	case src.LeOp:
+		return constBoolLiteral(l <= r), nil
+	default:
+		return src.MsgLiteral{}, fmt.Errorf("operator %v is not supported for floats", op)
+	}
+	return src.MsgLiteral{Float: &res}, nil
+}
+
+func evalConstStringBinary(op src.BinaryOperator, l, r string) (src.MsgLiteral, error) {
+	switch op {
+	case src.AddOp:
+		return src.MsgLiteral{Str: compiler.Pointer(l + r)}, nil
+	case src.EqOp:
+		return constBoolLiteral(l == r), nil
+	case src.NeOp:
+		return constBoolLiteral(l != r), nil
+	case src.GtOp:
+		return constBoolLiteral(l > r), nil
+	case src.LtOp:
+		return constBoolLiteral(l < r), nil
+	case src.GeOp:
+		return constBoolLiteral(l >= r), nil
+	case src.LeOp:
+		return constBoolLiteral(l <= r), nil
+	default:
+		return src.MsgLiteral{}, fmt.Errorf("operator %v is not supported for strings", op)
+	}
+}
+
+func evalConstBoolBinary(op src.BinaryOperator, l, r bool) (src.MsgLiteral, error) {
+	switch op {
+	case src.AndOp:
+		return constBoolLiteral(l && r), nil
+	case src.OrOp:
+		return constBoolLiteral(l || r), nil
+	case src.EqOp:
+		return constBoolLiteral(l == r), nil
+	case src.NeOp:
+		return constBoolLiteral(l != r), nil
+	default:
+		return src.MsgLiteral{}, fmt.Errorf("operator %v is not supported for booleans", op)
+	}
+}
+
+func constBoolLiteral(v bool) src.MsgLiteral {
+	return src.MsgLiteral{Bool: &v}
+}
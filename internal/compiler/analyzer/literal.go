@@ -0,0 +1,180 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+)
+
+// inferLiteralType returns type of the message literal that has no explicit type,
+// e.g. inline `[1, 2, 3]`, `{a: 1}` or `{"a": 1}` network senders.
+// Lists and dicts must have elements of the same type, empty ones can't be inferred.
+func (a Analyzer) inferLiteralType(value src.ConstValue, scope src.Scope) (ts.Expr, *compiler.Error) {
+	if value.Ref != nil {
+		return a.getResolvedConstTypeByRef(*value.Ref, scope)
+	}
+
+	msg := value.Message
+
+	switch {
+	case msg.Bool != nil:
+		return builtinTypeExpr("bool"), nil
+	case msg.Int != nil:
+		return builtinTypeExpr("int"), nil
+	case msg.Float != nil:
+		return builtinTypeExpr("float"), nil
+	case msg.Str != nil:
+		return builtinTypeExpr("string"), nil
+	case msg.Enum != nil:
+		resolvedExpr, err := a.resolver.ResolveExpr(
+			ts.Expr{Inst: &ts.InstExpr{Ref: msg.Enum.EnumRef}},
+			scope,
+		)
+		if err != nil {
+			return ts.Expr{}, &compiler.Error{
+				Message: err.Error(),
+				Meta:    &msg.Meta,
+			}
+		}
+		return resolvedExpr, nil
+	case msg.Union != nil:
+		resolvedExpr, err := a.resolver.ResolveExpr(
+			ts.Expr{Inst: &ts.InstExpr{Ref: msg.Union.EntityRef}},
+			scope,
+		)
+		if err != nil {
+			return ts.Expr{}, &compiler.Error{
+				Message: err.Error(),
+				Meta:    &msg.Meta,
+			}
+		}
+		return resolvedExpr, nil
+	case msg.List != nil:
+		elType, err := a.inferElementsType(msg.List, msg.Meta, scope)
+		if err != nil {
+			return ts.Expr{}, err
+		}
+		return ts.Expr{
+			Inst: &ts.InstExpr{
+				Ref:  core.EntityRef{Name: "list"},
+				Args: []ts.Expr{elType},
+			},
+		}, nil
+	case msg.Dict != nil:
+		values := make([]src.ConstValue, 0, len(msg.Dict))
+		for _, v := range msg.Dict {
+			values = append(values, v)
+		}
+		elType, err := a.inferElementsType(values, msg.Meta, scope)
+		if err != nil {
+			return ts.Expr{}, err
+		}
+		return ts.Expr{
+			Inst: &ts.InstExpr{
+				Ref:  core.EntityRef{Name: "dict"},
+				Args: []ts.Expr{elType},
+			},
+		}, nil
+	case msg.Struct != nil:
+		fields := make(map[string]ts.Expr, len(msg.Struct))
+		for name, v := range msg.Struct {
+			fieldType, err := a.inferLiteralType(v, scope)
+			if err != nil {
+				return ts.Expr{}, err
+			}
+			fields[name] = fieldType
+		}
+		return ts.Expr{
+			Lit: &ts.LitExpr{Struct: fields},
+		}, nil
+	}
+
+	return ts.Expr{}, &compiler.Error{
+		Message: "Cannot infer type of the literal",
+		Meta:    &msg.Meta,
+	}
+}
+
+// inferElementsType returns type of list or dict elements, all elements must have the same type.
+func (a Analyzer) inferElementsType(
+	values []src.ConstValue,
+	meta core.Meta,
+	scope src.Scope,
+) (ts.Expr, *compiler.Error) {
+	if len(values) == 0 {
+		return ts.Expr{}, &compiler.Error{
+			Message: "Cannot infer element type of empty literal, use typed constant instead",
+			Meta:    &meta,
+		}
+	}
+
+	first, err := a.inferLiteralType(values[0], scope)
+	if err != nil {
+		return ts.Expr{}, err
+	}
+
+	for _, v := range values[1:] {
+		next, err := a.inferLiteralType(v, scope)
+		if err != nil {
+			return ts.Expr{}, err
+		}
+		if a.resolver.IsSubtypeOf(next, first, scope) != nil ||
+			a.resolver.IsSubtypeOf(first, next, scope) != nil {
+			return ts.Expr{}, &compiler.Error{
+				Message: fmt.Sprintf("Literal elements must have the same type: %v and %v", first, next),
+				Meta:    &meta,
+			}
+		}
+	}
+
+	return first, nil
+}
+
+// normalizeDictLiterals turns struct literals into dict literals where dict is expected by the type,
+// so `const d dict<int> = {a: 1}` keeps working. Dict literals are never turned into structs.
+func normalizeDictLiterals(value src.ConstValue, typeExpr ts.Expr) src.ConstValue {
+	if value.Message == nil {
+		return value
+	}
+
+	msg := *value.Message
+
+	switch {
+	case msg.List != nil && isInstOf(typeExpr, "list"):
+		list := make([]src.ConstValue, len(msg.List))
+		for i, el := range msg.List {
+			list[i] = normalizeDictLiterals(el, typeExpr.Inst.Args[0])
+		}
+		msg.List = list
+	case msg.Struct != nil && isInstOf(typeExpr, "dict"):
+		msg.Dict, msg.Struct = msg.Struct, nil
+		fallthrough
+	case msg.Dict != nil && isInstOf(typeExpr, "dict"):
+		dict := make(map[string]src.ConstValue, len(msg.Dict))
+		for k, el := range msg.Dict {
+			dict[k] = normalizeDictLiterals(el, typeExpr.Inst.Args[0])
+		}
+		msg.Dict = dict
+	case msg.Struct != nil && typeExpr.Lit != nil && typeExpr.Lit.Struct != nil:
+		fields := make(map[string]src.ConstValue, len(msg.Struct))
+		for name, el := range msg.Struct {
+			fields[name] = normalizeDictLiterals(el, typeExpr.Lit.Struct[name])
+		}
+		msg.Struct = fields
+	}
+
+	return src.ConstValue{Message: &msg}
+}
+
+func isInstOf(expr ts.Expr, name string) bool {
+	return expr.Inst != nil && expr.Inst.Ref.Name == name && len(expr.Inst.Args) == 1
+}
+
+func builtinTypeExpr(name string) ts.Expr {
+	return ts.Expr{
+		Inst: &ts.InstExpr{Ref: core.EntityRef{Name: name}},
+	}
+}
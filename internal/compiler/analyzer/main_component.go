@@ -5,6 +5,7 @@ import (
 
 	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
 )
 
 func (a Analyzer) analyzeMainComponent(cmp src.Component, scope src.Scope) *compiler.Error {
@@ -54,6 +55,11 @@ func (a Analyzer) analyzeMainFlowIO(io src.IO) *compiler.Error {
 		return &compiler.Error{Message: "Main component must have 'stop' outport", Meta: &io.Meta}
 	}
 
+	// stop outport can also be int, in that case its message is used as the exit code
+	if isIntType(exitOutport.TypeExpr) && !exitOutport.IsArray {
+		return nil
+	}
+
 	if err := a.analyzeMainComponentPort(exitOutport); err != nil {
 		return compiler.Error{Meta: &exitOutport.Meta}.Wrap(err)
 	}
@@ -77,6 +83,14 @@ func (a Analyzer) analyzeMainComponentPort(port src.Port) *compiler.Error {
 	return nil
 }
 
+// isIntType returns true if expr is a reference to builtin int type.
+func isIntType(expr ts.Expr) bool {
+	if expr.Inst == nil || expr.Inst.Ref.Name != "int" {
+		return false
+	}
+	return expr.Inst.Ref.Pkg == "" || expr.Inst.Ref.Pkg == "builtin"
+}
+
 func (Analyzer) analyzeMainComponentNodes(
 	nodes map[string]src.Node,
 	scope src.Scope,
@@ -11,10 +11,11 @@ import (
 )
 
 var (
-	ErrComplexLiteralSender = errors.New("literal network sender must have primitive type")
+	ErrComplexLiteralSender = errors.New("literal network sender must have primitive, list, dict or struct type")
 )
 
 // analyzeNetwork must be called after analyzeNodes so we sure nodes are resolved.
+// Nodes that are not used in the network are reported as warnings and removed from nodes map.
 func (a Analyzer) analyzeNetwork(
 	net []src.Connection,
 	compInterface src.Interface,
@@ -22,7 +23,7 @@ func (a Analyzer) analyzeNetwork(
 	nodes map[string]src.Node,
 	nodesIfaces map[string]foundInterface,
 	scope src.Scope,
-) ([]src.Connection, *compiler.Error) {
+) ([]src.Connection, []compiler.Warning, *compiler.Error) {
 	nodesUsage := make(map[string]netNodeUsage, len(nodes))
 
 	analyzedConnections, err := a.analyzeConnections(
@@ -34,20 +35,21 @@ func (a Analyzer) analyzeNetwork(
 		scope,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if err := a.analyzeNetPortsUsage(
+	warnings, err := a.analyzeNetPortsUsage(
 		compInterface,
 		nodesIfaces,
 		hasGuard,
 		nodesUsage,
 		nodes,
-	); err != nil {
-		return nil, err
+	)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return analyzedConnections, nil
+	return analyzedConnections, warnings, nil
 }
 
 // analyzeConnections does two things:
@@ -301,6 +303,23 @@ func (a Analyzer) analyzeSwitchReceiver(
 	analyzedSenders []src.ConnectionSender,
 	resolvedSenderTypes []*ts.Expr,
 ) ([]src.NormalConnection, []src.ConnectionReceiver, *compiler.Error) {
+	isUnionSwitch, err := a.isUnionSwitch(*receiver.Switch, scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isUnionSwitch {
+		return a.analyzeUnionSwitchReceiver(
+			receiver,
+			iface,
+			nodes,
+			nodesIfaces,
+			scope,
+			nodesUsage,
+			analyzedSenders,
+			resolvedSenderTypes,
+		)
+	}
+
 	analyzedSwitchConns := make([]src.NormalConnection, 0, len(receiver.Switch.Cases))
 
 	for _, switchConn := range receiver.Switch.Cases {
@@ -419,11 +438,8 @@ func (a Analyzer) analyzePortAddrReceiver(
 	for i, resolvedSenderType := range resolvedSenderTypes {
 		if err := a.resolver.IsSubtypeOf(*resolvedSenderType, typeExpr, scope); err != nil {
 			return &compiler.Error{
-				Message: fmt.Sprintf(
-					"Incompatible types: %v -> %v: %v",
-					analyzedSenders[i], portAddr, err.Error(),
-				),
-				Meta: &portAddr.Meta,
+				Message: incompatibleTypesMessage(analyzedSenders[i], portAddr, err),
+				Meta:    &portAddr.Meta,
 			}
 		}
 	}
@@ -441,6 +457,21 @@ func (a Analyzer) analyzePortAddrReceiver(
 	return nil
 }
 
+// incompatibleTypesMessage describes connection with sender that can't be received by receiver.
+// List literal sender like `[1, 2]` was a fan-in of its items before list literals were added,
+// so message explains that it's a single list message now.
+func incompatibleTypesMessage(sender src.ConnectionSender, receiver fmt.Stringer, err error) string {
+	msg := fmt.Sprintf("Incompatible types: %v -> %v: %v", sender, receiver, err.Error())
+
+	if sender.Const == nil || sender.Const.Value.Ref != nil ||
+		sender.Const.Value.Message == nil || sender.Const.Value.Message.List == nil {
+		return msg
+	}
+
+	return msg + ". List literal is sent as a single list message, not as a fan-in of its items. " +
+		"Use constant references for fan-in, e.g. `[$a, $b] -> ...`"
+}
+
 func (a Analyzer) analyzeChainedConnectionReceiver(
 	chainedConn src.Connection,
 	scope src.Scope,
@@ -480,11 +511,8 @@ func (a Analyzer) analyzeChainedConnectionReceiver(
 	for i, resolvedSenderType := range resolvedSenderTypes {
 		if err := a.resolver.IsSubtypeOf(*resolvedSenderType, chainHeadType, scope); err != nil {
 			return src.Connection{}, &compiler.Error{
-				Message: fmt.Sprintf(
-					"Incompatible types: %v -> %v: %v",
-					analyzedSenders[i], chainHead, err.Error(),
-				),
-				Meta: &chainedConn.Meta,
+				Message: incompatibleTypesMessage(analyzedSenders[i], chainHead, err),
+				Meta:    &chainedConn.Meta,
 			}
 		}
 	}
@@ -569,6 +597,7 @@ func (a Analyzer) analyzeSender(
 		sender.Binary == nil &&
 		sender.Unary == nil &&
 		sender.Ternary == nil &&
+		sender.Union == nil &&
 		len(sender.StructSelector) == 0 {
 		return nil, nil, &compiler.Error{
 			Message: "Sender in network must contain port address, constant reference or message literal",
@@ -655,6 +684,29 @@ func (a Analyzer) analyzeSender(
 		return &sender, trueValType, nil
 	}
 
+	if sender.Range != nil {
+		return a.analyzeRangeSender(
+			sender,
+			scope,
+			iface,
+			nodes,
+			nodesIfaces,
+			nodesUsage,
+		)
+	}
+
+	if sender.Union != nil {
+		return a.analyzeUnionSender(
+			sender,
+			scope,
+			iface,
+			nodes,
+			nodesIfaces,
+			nodesUsage,
+			prevChainLink,
+		)
+	}
+
 	if sender.Binary != nil {
 		_, leftType, err := a.analyzeSender(
 			sender.Binary.Left,
@@ -689,15 +741,21 @@ func (a Analyzer) analyzeSender(
 
 		if err := a.resolver.IsSubtypeOf(*leftType, constr, scope); err != nil {
 			return nil, nil, &compiler.Error{
-				Message: fmt.Sprintf("Invalid left operand type for %s: %v", sender.Binary.Operator, err),
-				Meta:    &sender.Binary.Meta,
+				Message: fmt.Sprintf(
+					"Invalid left operand type for %s: %v does not satisfy %v: %v",
+					sender.Binary.Operator, leftType, constr, err,
+				),
+				Meta: &sender.Binary.Meta,
 			}
 		}
 
 		if err := a.resolver.IsSubtypeOf(*rightType, constr, scope); err != nil {
 			return nil, nil, &compiler.Error{
-				Message: fmt.Sprintf("Invalid right operand type for %s: %v", sender.Binary.Operator, err),
-				Meta:    &sender.Binary.Meta,
+				Message: fmt.Sprintf(
+					"Invalid right operand type for %s: %v does not satisfy %v: %v",
+					sender.Binary.Operator, rightType, constr, err,
+				),
+				Meta: &sender.Binary.Meta,
 			}
 		}
 
@@ -851,11 +909,11 @@ func (a Analyzer) analyzeNetPortsUsage(
 	hasGuard bool,
 	nodesUsage map[string]netNodeUsage,
 	nodes map[string]src.Node,
-) *compiler.Error {
+) ([]compiler.Warning, *compiler.Error) {
 	// 1. every self inport must be used
 	inportsUsage, ok := nodesUsage["in"]
 	if !ok {
-		return &compiler.Error{
+		return nil, &compiler.Error{
 			Message: "Unused inports",
 			Meta:    &compInterface.Meta,
 		}
@@ -863,7 +921,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 
 	for inportName := range compInterface.IO.In {
 		if _, ok := inportsUsage.Out[inportName]; !ok { // note that self inports are outports for the network
-			return &compiler.Error{
+			return nil, &compiler.Error{
 				Message: fmt.Sprintf("Unused inport: %v", inportName),
 			}
 		}
@@ -872,7 +930,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 	// 2. every self-outport must be used
 	outportsUsage, ok := nodesUsage["out"]
 	if !ok {
-		return &compiler.Error{
+		return nil, &compiler.Error{
 			Message: "Component must use its outports",
 			Meta:    &compInterface.Meta,
 		}
@@ -888,22 +946,26 @@ func (a Analyzer) analyzeNetPortsUsage(
 			continue
 		}
 
-		return &compiler.Error{
+		return nil, &compiler.Error{
 			Message: fmt.Sprintf("Unused outport: %v", outportName),
 		}
 	}
 
+	var warnings []compiler.Warning
+
 	// 3. check sub-nodes usage in network
 	for nodeName, nodeIface := range nodesIfaces {
 		nodeMeta := nodes[nodeName].Meta
 
-		// every sub-node must be used
+		// sub-node that is not connected at all can't do anything, so it's removed from the component
 		nodeUsage, ok := nodesUsage[nodeName]
 		if !ok {
-			return &compiler.Error{
-				Message: fmt.Sprintf("Unused node found: %v", nodeName),
+			warnings = append(warnings, compiler.Warning{
+				Message: fmt.Sprintf("Unused node: %v", nodeName),
 				Meta:    &nodeMeta,
-			}
+			})
+			delete(nodes, nodeName)
+			continue
 		}
 
 		// every sub-node's inport must be used
@@ -917,7 +979,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 				continue
 			}
 
-			return &compiler.Error{
+			return nil, &compiler.Error{
 				Message: fmt.Sprintf(
 					"Unused node inport: %v:%v",
 					nodeName,
@@ -940,7 +1002,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 			}
 
 			if outportName == "err" && !nodes[nodeName].ErrGuard {
-				return &compiler.Error{
+				return nil, &compiler.Error{
 					Message: fmt.Sprintf("unhandled error: %v:err", nodeName),
 					Meta:    &nodeMeta,
 				}
@@ -951,7 +1013,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 			if _, ok := nodeUsage.Out[""]; ok && len(nodeIface.iface.IO.Out) == 1 {
 				continue
 			}
-			return &compiler.Error{
+			return nil, &compiler.Error{
 				Message: fmt.Sprintf("All node's outports are unused: %v", nodeName),
 				Meta:    &nodeMeta,
 			}
@@ -976,7 +1038,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 
 			for i := uint8(0); i <= maxSlot; i++ {
 				if _, ok := usedSlots[i]; !ok {
-					return &compiler.Error{
+					return nil, &compiler.Error{
 						Message: fmt.Sprintf(
 							"array inport '%s:%s' is used incorrectly: slot %d is missing",
 							nodeName,
@@ -1003,7 +1065,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 
 			for i := uint8(0); i <= maxSlot; i++ {
 				if _, ok := usedSlots[i]; !ok {
-					return &compiler.Error{
+					return nil, &compiler.Error{
 						Message: fmt.Sprintf(
 							"array outport '%s:%s' is used incorrectly: slot %d is missing",
 							nodeName,
@@ -1017,7 +1079,7 @@ func (a Analyzer) analyzeNetPortsUsage(
 		}
 	}
 
-	return nil
+	return warnings, nil
 }
 
 // getReceiverPortType returns resolved port-addr, type expr and isArray bool.
@@ -1198,18 +1260,32 @@ func (a Analyzer) getResolvedSenderType(
 	}
 
 	if sender.Range != nil {
-		// range sends stream<int> from its :data outport
+		// range sends stream<T> from its :res outport, where T is int or float
+		itemType := sender.Range.AnalyzedType
+		if itemType.Inst == nil && itemType.Lit == nil {
+			itemType = rangeIntType
+			if sender.Range.From.Const != nil && sender.Range.From.Const.Value.Message != nil &&
+				sender.Range.From.Const.Value.Message.Float != nil {
+				itemType = rangeFloatType
+			}
+		}
 		rangeType := ts.Expr{
 			Inst: &ts.InstExpr{
-				Ref: core.EntityRef{Name: "stream"},
-				Args: []ts.Expr{{
-					Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "int"}},
-				}},
+				Ref:  core.EntityRef{Name: "stream"},
+				Args: []ts.Expr{itemType},
 			},
 		}
 		return sender, rangeType, false, nil
 	}
 
+	if sender.Union != nil {
+		unionType, _, err := a.getUnionTag(sender.Union.EntityRef, sender.Union.Tag, scope)
+		if err != nil {
+			return src.ConnectionSender{}, ts.Expr{}, false, err
+		}
+		return sender, unionType, false, nil
+	}
+
 	if len(sender.StructSelector) > 0 {
 		_, chainLinkType, _, err := a.getResolvedSenderType(
 			prevChainLink[0],
@@ -1309,26 +1385,21 @@ func (Analyzer) getBinaryExprType(operator src.BinaryOperator, leftType ts.Expr)
 	return leftType
 }
 
+// getOperatorConstraint returns constraint that both operands of the binary expression must satisfy.
 func (Analyzer) getOperatorConstraint(binary src.Binary) (ts.Expr, *compiler.Error) {
 	switch binary.Operator {
 	case src.AddOp:
 		return ts.Expr{
 			Lit: &ts.LitExpr{
 				Union: []ts.Expr{
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "int"}}},
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "float"}}},
+					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "numeric"}}},
 					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "string"}}},
 				},
 			},
 		}, nil
 	case src.SubOp, src.MulOp, src.DivOp:
 		return ts.Expr{
-			Lit: &ts.LitExpr{
-				Union: []ts.Expr{
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "int"}}},
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "float"}}},
-				},
-			},
+			Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "numeric"}},
 		}, nil
 	case src.ModOp, src.PowOp:
 		return ts.Expr{
@@ -1336,17 +1407,11 @@ func (Analyzer) getOperatorConstraint(binary src.Binary) (ts.Expr, *compiler.Err
 		}, nil
 	case src.EqOp, src.NeOp:
 		return ts.Expr{
-			Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "any"}},
+			Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "comparable"}},
 		}, nil
 	case src.GtOp, src.LtOp, src.GeOp, src.LeOp:
 		return ts.Expr{
-			Lit: &ts.LitExpr{
-				Union: []ts.Expr{
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "int"}}},
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "float"}}},
-					{Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "string"}}},
-				},
-			},
+			Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "ordered"}},
 		}, nil
 	case src.AndOp, src.OrOp:
 		return ts.Expr{
@@ -1435,8 +1500,18 @@ func (a Analyzer) getConstSenderType(
 		}
 	}
 
+	// composite literals like [1, 2] or {a: 1} have no type at this point
+	typeExpr := constSender.TypeExpr
+	if typeExpr.Inst == nil && typeExpr.Lit == nil {
+		inferredExpr, err := a.inferLiteralType(constSender.Value, scope)
+		if err != nil {
+			return src.Const{}, ts.Expr{}, err
+		}
+		typeExpr = inferredExpr
+	}
+
 	resolvedExpr, err := a.resolver.ResolveExpr(
-		constSender.TypeExpr,
+		typeExpr,
 		scope,
 	)
 	if err != nil {
@@ -1453,18 +1528,32 @@ func (a Analyzer) getConstSenderType(
 		}
 	}
 
+	msg := *constSender.Value.Message
+	if resolvedExpr.Lit != nil && resolvedExpr.Lit.Tagged != nil {
+		if msg.Meta.Text == "" { // tag-only literals are parsed as primitive ones and have no message meta
+			msg.Meta = constSender.Meta
+		}
+		analyzedMsg, err := a.analyzeUnionLiteral(msg, resolvedExpr, scope)
+		if err != nil {
+			return src.Const{}, ts.Expr{}, err
+		}
+		msg = analyzedMsg
+	}
+
 	return src.Const{
 		TypeExpr: resolvedExpr,
 		Value: src.ConstValue{
 			Message: &src.MsgLiteral{
-				Bool:         constSender.Value.Message.Bool,
-				Int:          constSender.Value.Message.Int,
-				Float:        constSender.Value.Message.Float,
-				Str:          constSender.Value.Message.Str,
-				List:         constSender.Value.Message.List,
-				DictOrStruct: constSender.Value.Message.DictOrStruct,
-				Enum:         constSender.Value.Message.Enum,
-				Meta:         constSender.Value.Message.Meta,
+				Bool:   msg.Bool,
+				Int:    msg.Int,
+				Float:  msg.Float,
+				Str:    msg.Str,
+				List:   msg.List,
+				Dict:   msg.Dict,
+				Struct: msg.Struct,
+				Enum:   msg.Enum,
+				Union:  msg.Union,
+				Meta:   msg.Meta,
 			},
 		},
 		Meta: constSender.Meta,
@@ -1474,14 +1563,14 @@ func (a Analyzer) getConstSenderType(
 func (a Analyzer) validateLiteralSender(resolvedExpr ts.Expr) error {
 	if resolvedExpr.Inst != nil {
 		switch resolvedExpr.Inst.Ref.String() {
-		case "bool", "int", "float", "string":
+		case "bool", "int", "float", "string", "list", "dict":
 			return nil
 		}
 		return ErrComplexLiteralSender
 	}
 
 	if resolvedExpr.Lit == nil ||
-		resolvedExpr.Lit.Enum == nil {
+		(resolvedExpr.Lit.Enum == nil && resolvedExpr.Lit.Struct == nil && resolvedExpr.Lit.Tagged == nil) {
 		return ErrComplexLiteralSender
 	}
 
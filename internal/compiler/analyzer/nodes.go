@@ -2,6 +2,8 @@ package analyzer
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
@@ -82,6 +84,18 @@ func (a Analyzer) analyzeNode(
 		}
 	}
 
+	if usesBindDirective {
+		if err := a.analyzeBindDirective(bindDirectiveArgs[0], node, scope); err != nil {
+			return src.Node{}, foundInterface{}, err
+		}
+	}
+
+	if bufferDirectiveArgs, usesBufferDirective := node.Directives[compiler.BufferDirective]; usesBufferDirective {
+		if err := a.analyzeBufferDirective(bufferDirectiveArgs, node, scope); err != nil {
+			return src.Node{}, foundInterface{}, err
+		}
+	}
+
 	// We need to get resolved frame from parent type parameters
 	// in order to be able to resolve node's args
 	// since they can refer to type parameter of the parent (interface)
@@ -122,6 +136,18 @@ func (a Analyzer) analyzeNode(
 		return src.Node{}, foundInterface{}, aerr
 	}
 
+	if nodeEntity.Kind == src.ComponentEntity {
+		if err := a.analyzeTypeInfoNode(
+			nodeEntity.Component,
+			usesBindDirective,
+			node,
+			resolvedNodeArgs,
+			parentTypeParams.Params,
+		); err != nil {
+			return src.Node{}, foundInterface{}, err
+		}
+	}
+
 	if node.ErrGuard {
 		if _, ok := compIface.IO.Out["err"]; !ok {
 			return src.Node{}, foundInterface{}, &compiler.Error{
@@ -206,6 +232,120 @@ func (a Analyzer) analyzeNode(
 		}, nil
 }
 
+// analyzeBindDirective makes sure #bind directive refers to existing constant, e.g. `#bind(foo)` or `#bind(pkg.foo)`.
+func (Analyzer) analyzeBindDirective(arg string, node src.Node, scope src.Scope) *compiler.Error {
+	ref := core.EntityRef{Name: arg}
+	if pkgName, name, ok := strings.Cut(arg, "."); ok {
+		ref = core.EntityRef{Pkg: pkgName, Name: name}
+	}
+
+	entity, _, err := scope.Entity(ref)
+	if err != nil {
+		return &compiler.Error{
+			Message: fmt.Sprintf("Constant referenced by #bind directive not found: %v", err),
+			Meta:    &node.Meta,
+		}
+	}
+
+	if entity.Kind != src.ConstEntity {
+		return &compiler.Error{
+			Message: fmt.Sprintf("#bind directive must refer to constant, got %v", entity.Kind),
+			Meta:    &node.Meta,
+		}
+	}
+
+	return nil
+}
+
+// analyzeTypeInfoNode makes sure type argument of the node, which component uses #typeinfo,
+// can be passed to runtime. Type parameters of the parent are not allowed
+// because compiler only knows their constraints, not the actual types.
+func (Analyzer) analyzeTypeInfoNode(
+	component src.Component,
+	usesBindDirective bool,
+	node src.Node,
+	resolvedNodeArgs []typesystem.Expr,
+	parentTypeParams []typesystem.Param,
+) *compiler.Error {
+	if _, ok := component.Directives[compiler.TypeInfoDirective]; !ok {
+		return nil
+	}
+
+	if usesBindDirective {
+		return &compiler.Error{
+			Message: "Node can't use #bind if it's instantiated with the component that use #typeinfo",
+			Meta:    &node.Meta,
+		}
+	}
+
+	if len(resolvedNodeArgs) != 1 {
+		return &compiler.Error{
+			Message: "Component that use #typeinfo directive must have exactly one type-argument",
+			Meta:    &component.Meta,
+		}
+	}
+
+	if param, ok := findTypeParamRef(node.TypeArgs[0], parentTypeParams); ok {
+		return &compiler.Error{
+			Message: fmt.Sprintf("Type argument for %v cannot refer to type parameter %v", node.EntityRef, param),
+			Meta:    &node.Meta,
+		}
+	}
+
+	if _, err := compiler.TypeInfo(resolvedNodeArgs[0]); err != nil {
+		return &compiler.Error{
+			Message: fmt.Sprintf("Invalid type argument for %v: %v", node.EntityRef, err),
+			Meta:    &node.Meta,
+		}
+	}
+
+	return nil
+}
+
+// findTypeParamRef returns name of the first type parameter that expression refers to.
+func findTypeParamRef(expr typesystem.Expr, params []typesystem.Param) (string, bool) {
+	if expr.Inst != nil {
+		if expr.Inst.Ref.Pkg == "" {
+			for _, param := range params {
+				if param.Name == expr.Inst.Ref.Name {
+					return param.Name, true
+				}
+			}
+		}
+		for _, arg := range expr.Inst.Args {
+			if name, ok := findTypeParamRef(arg, params); ok {
+				return name, true
+			}
+		}
+		return "", false
+	}
+
+	if expr.Lit == nil {
+		return "", false
+	}
+
+	for _, field := range expr.Lit.Struct {
+		if name, ok := findTypeParamRef(field, params); ok {
+			return name, true
+		}
+	}
+	for _, el := range expr.Lit.Union {
+		if name, ok := findTypeParamRef(el, params); ok {
+			return name, true
+		}
+	}
+	for _, tag := range expr.Lit.Tagged {
+		if tag.Type == nil {
+			continue
+		}
+		if name, ok := findTypeParamRef(*tag.Type, params); ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
 // also does validation
 func (a Analyzer) getNodeInterface(
 	entity src.Entity,
@@ -333,3 +473,68 @@ func (a Analyzer) getNodeInterface(
 		Meta: iface.Meta,
 	}, nil
 }
+
+// analyzeBufferDirective checks that #buffer takes int literal or refers to a non-negative int constant.
+func (a Analyzer) analyzeBufferDirective(args []string, node src.Node, scope src.Scope) *compiler.Error {
+	if len(args) != 1 {
+		return &compiler.Error{
+			Message: "Node with #buffer directive must provide exactly one argument",
+			Meta:    &node.Meta,
+		}
+	}
+
+	// int literals are lexed without sign so they are always non-negative
+	if _, err := strconv.Atoi(args[0]); err == nil {
+		return nil
+	}
+
+	ref := compiler.ParseEntityRef(args[0])
+	visited := map[core.EntityRef]struct{}{}
+	for {
+		if _, ok := visited[ref]; ok {
+			return &compiler.Error{
+				Message: fmt.Sprintf("#buffer directive refers to recursive constant: %v", args[0]),
+				Meta:    &node.Meta,
+			}
+		}
+		visited[ref] = struct{}{}
+
+		entity, location, err := scope.Entity(ref)
+		if err != nil {
+			return &compiler.Error{
+				Message: err.Error(),
+				Meta:    &node.Meta,
+			}
+		}
+
+		if entity.Kind != src.ConstEntity {
+			return &compiler.Error{
+				Message: fmt.Sprintf("#buffer directive must refer to a constant: %v", args[0]),
+				Meta:    &node.Meta,
+			}
+		}
+
+		if entity.Const.Value.Ref != nil {
+			ref = *entity.Const.Value.Ref
+			scope = scope.Relocate(location)
+			continue
+		}
+
+		msg := entity.Const.Value.Message
+		if entity.Const.Value.Binary != nil {
+			analyzedConst, err := a.analyzeConst(entity.Const, scope.Relocate(location))
+			if err != nil {
+				return compiler.Error{Meta: &node.Meta}.Wrap(err)
+			}
+			msg = analyzedConst.Value.Message
+		}
+		if msg == nil || msg.Int == nil || *msg.Int < 0 {
+			return &compiler.Error{
+				Message: fmt.Sprintf("#buffer directive must refer to a non-negative int constant: %v", args[0]),
+				Meta:    &node.Meta,
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+)
+
+var (
+	rangeIntType = ts.Expr{
+		Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "int"}},
+	}
+	rangeFloatType = ts.Expr{
+		Inst: &ts.InstExpr{Ref: core.EntityRef{Name: "float"}},
+	}
+)
+
+// analyzeRangeSender checks members of `from..to..step` expression and returns stream type.
+// Members must be all ints or all floats, int literals are allowed in float ranges (e.g. `0..1..0.1`).
+func (a Analyzer) analyzeRangeSender(
+	sender src.ConnectionSender,
+	scope src.Scope,
+	iface src.Interface,
+	nodes map[string]src.Node,
+	nodesIfaces map[string]foundInterface,
+	nodesUsage map[string]netNodeUsage,
+) (*src.ConnectionSender, *ts.Expr, *compiler.Error) {
+	rangeExpr := *sender.Range
+
+	members := []*src.ConnectionSender{&rangeExpr.From, &rangeExpr.To}
+	if rangeExpr.Step != nil {
+		step := *rangeExpr.Step
+		rangeExpr.Step = &step
+		members = append(members, rangeExpr.Step)
+	}
+
+	isFloat := false
+	memberTypes := make([]ts.Expr, 0, len(members))
+	for _, member := range members {
+		analyzedMember, memberType, err := a.analyzeSender(
+			*member,
+			scope,
+			iface,
+			nodes,
+			nodesIfaces,
+			nodesUsage,
+			nil,
+		)
+		if err != nil {
+			return nil, nil, compiler.Error{Meta: &rangeExpr.Meta}.Wrap(err)
+		}
+
+		switch {
+		case a.resolver.IsSubtypeOf(*memberType, rangeIntType, scope) == nil:
+		case a.resolver.IsSubtypeOf(*memberType, rangeFloatType, scope) == nil:
+			isFloat = true
+		default:
+			return nil, nil, &compiler.Error{
+				Message: fmt.Sprintf("Range member must be int or float, got %v", memberType),
+				Meta:    &member.Meta,
+			}
+		}
+
+		*member = *analyzedMember
+		memberTypes = append(memberTypes, *memberType)
+	}
+
+	rangeType := rangeIntType
+	if isFloat {
+		rangeType = rangeFloatType
+	}
+
+	for i, member := range members {
+		if a.resolver.IsSubtypeOf(memberTypes[i], rangeType, scope) == nil {
+			continue
+		}
+		// only int literals can be used as float members, other senders need explicit conversion
+		if !isIntLiteralSender(*member) {
+			return nil, nil, &compiler.Error{
+				Message: fmt.Sprintf("Range members must have the same type: %v is not float", member),
+				Meta:    &member.Meta,
+			}
+		}
+		*member = intLiteralToFloat(*member)
+	}
+
+	if rangeExpr.Step != nil && isNonPositiveLiteralSender(*rangeExpr.Step) {
+		return nil, nil, &compiler.Error{
+			Message: "Range step must be positive",
+			Meta:    &rangeExpr.Step.Meta,
+		}
+	}
+
+	// desugarer needs this information to use overloaded Range
+	rangeExpr.AnalyzedType = rangeType
+
+	streamType := ts.Expr{
+		Inst: &ts.InstExpr{
+			Ref:  core.EntityRef{Name: "stream"},
+			Args: []ts.Expr{rangeType},
+		},
+	}
+
+	return &src.ConnectionSender{
+		Range: &rangeExpr,
+		Meta:  sender.Meta,
+	}, &streamType, nil
+}
+
+func isIntLiteralSender(sender src.ConnectionSender) bool {
+	return sender.Const != nil &&
+		sender.Const.Value.Message != nil &&
+		sender.Const.Value.Message.Int != nil
+}
+
+func intLiteralToFloat(sender src.ConnectionSender) src.ConnectionSender {
+	msg := *sender.Const.Value.Message
+	f := float64(*msg.Int)
+	msg.Int = nil
+	msg.Float = &f
+
+	constant := *sender.Const
+	constant.TypeExpr = rangeFloatType
+	constant.Value = src.ConstValue{Message: &msg}
+
+	sender.Const = &constant
+	return sender
+}
+
+func isNonPositiveLiteralSender(sender src.ConnectionSender) bool {
+	if sender.Const == nil || sender.Const.Value.Message == nil {
+		return false
+	}
+	msg := sender.Const.Value.Message
+	return (msg.Int != nil && *msg.Int <= 0) || (msg.Float != nil && *msg.Float <= 0)
+}
@@ -0,0 +1,64 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	"github.com/nevalang/neva/pkg"
+)
+
+// analyzeReferences reports imports and private constants of the entry module that are never used,
+// and entities that shadow builtin ones. It must be called after all modules are analyzed.
+func (Analyzer) analyzeReferences(build src.Build, refs *src.References) []compiler.Warning {
+	modRef := build.EntryModRef
+	if modRef.Path == "std" {
+		return nil
+	}
+
+	var builtinPkg src.Package
+	if stdMod, ok := build.Modules[core.ModuleRef{Path: "std", Version: pkg.Version}]; ok {
+		builtinPkg = stdMod.Packages["builtin"]
+	}
+
+	var warnings []compiler.Warning
+	for pkgName, files := range build.Modules[modRef].Packages {
+		pkgLoc := core.Location{ModRef: modRef, Package: pkgName}
+
+		for fileName, file := range files {
+			fileLoc := core.Location{ModRef: modRef, Package: pkgName, Filename: fileName}
+
+			for alias, imp := range file.Imports {
+				if refs.IsImportUsed(fileLoc, alias) {
+					continue
+				}
+				meta := imp.Meta
+				warnings = append(warnings, compiler.Warning{
+					Message: fmt.Sprintf("Unused import: %v", alias),
+					Meta:    &meta,
+				})
+			}
+
+			for name, entity := range file.Entities {
+				if _, _, ok := builtinPkg.Entity(name); ok {
+					warnings = append(warnings, compiler.Warning{
+						Message: fmt.Sprintf("%v shadows builtin entity with the same name", name),
+						Meta:    entity.Meta(),
+					})
+				}
+
+				if entity.Kind != src.ConstEntity || entity.IsPublic || refs.IsEntityUsed(pkgLoc, name) {
+					continue
+				}
+
+				warnings = append(warnings, compiler.Warning{
+					Message: fmt.Sprintf("Unused constant: %v", name),
+					Meta:    entity.Meta(),
+				})
+			}
+		}
+	}
+
+	return warnings
+}
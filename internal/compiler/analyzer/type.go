@@ -19,6 +19,12 @@ func (a Analyzer) analyzeTypeDef(def ts.Def, scope src.Scope, params analyzeType
 		}
 	}
 
+	if def.BodyExpr != nil {
+		if err := a.analyzeUnionTagsCount(*def.BodyExpr); err != nil {
+			return ts.Def{}, err
+		}
+	}
+
 	// Note that we only resolve params. Body is resolved each time there's an expression that refers to it.
 	// We can't resolve body without args. And don't worry about unused bodies. Unused entities are error themselves.
 	resolvedParams, _, err := a.resolver.ResolveParams(def.Params, scope)
@@ -37,6 +43,10 @@ func (a Analyzer) analyzeTypeDef(def ts.Def, scope src.Scope, params analyzeType
 }
 
 func (a Analyzer) analyzeTypeExpr(expr ts.Expr, scope src.Scope) (ts.Expr, *compiler.Error) {
+	if err := a.analyzeUnionTagsCount(expr); err != nil {
+		return ts.Expr{}, err
+	}
+
 	resolvedExpr, err := a.resolver.ResolveExpr(expr, scope)
 	if err != nil {
 		meta := expr.Meta //nolint:forcetypeassert
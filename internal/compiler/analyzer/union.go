@@ -0,0 +1,377 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+)
+
+// analyzeUnionLiteral checks message literal of the tagged union type.
+// Tags without payload are parsed as enum literals (e.g. `Input::None`) so they are turned into union literals.
+func (a Analyzer) analyzeUnionLiteral(
+	msg src.MsgLiteral,
+	unionType ts.Expr,
+	scope src.Scope,
+) (src.MsgLiteral, *compiler.Error) {
+	if msg.Enum != nil {
+		msg.Union = &src.UnionLiteral{
+			EntityRef: msg.Enum.EnumRef,
+			Tag:       msg.Enum.MemberName,
+		}
+		msg.Enum = nil
+	}
+
+	if msg.Union == nil {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: fmt.Sprintf("Union value is missing in union literal: %v", msg),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	literalType, err := a.resolver.ResolveExpr(
+		ts.Expr{Inst: &ts.InstExpr{Ref: msg.Union.EntityRef}},
+		scope,
+	)
+	if err != nil {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: err.Error(),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	if err := a.resolver.IsSubtypeOf(literalType, unionType, scope); err != nil {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: fmt.Sprintf("Incompatible union literal type: %v", err),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	_, payloadType, ok := unionType.Lit.Tag(msg.Union.Tag)
+	if !ok {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: fmt.Sprintf("Union tag not found: %v", msg.Union.Tag),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	if payloadType == nil {
+		if msg.Union.Data != nil {
+			return src.MsgLiteral{}, &compiler.Error{
+				Message: fmt.Sprintf("Union tag %v has no payload", msg.Union.Tag),
+				Meta:    &msg.Meta,
+			}
+		}
+		return msg, nil
+	}
+
+	if msg.Union.Data == nil {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: fmt.Sprintf("Union tag %v requires payload of type %v", msg.Union.Tag, payloadType),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	data := normalizeDictLiterals(*msg.Union.Data, *payloadType)
+
+	// nested union literals must be normalized as well
+	if data.Message != nil && payloadType.Lit != nil && payloadType.Lit.Tagged != nil {
+		nested, err := a.analyzeUnionLiteral(*data.Message, *payloadType, scope)
+		if err != nil {
+			return src.MsgLiteral{}, err
+		}
+		data = src.ConstValue{Message: &nested}
+	}
+
+	dataType, inferErr := a.inferLiteralType(data, scope)
+	if inferErr != nil {
+		return src.MsgLiteral{}, compiler.Error{Meta: &msg.Meta}.Wrap(inferErr)
+	}
+
+	if err := a.resolver.IsSubtypeOf(dataType, *payloadType, scope); err != nil {
+		return src.MsgLiteral{}, &compiler.Error{
+			Message: fmt.Sprintf("Incompatible payload of union tag %v: %v", msg.Union.Tag, err),
+			Meta:    &msg.Meta,
+		}
+	}
+
+	msg.Union = &src.UnionLiteral{
+		EntityRef: msg.Union.EntityRef,
+		Tag:       msg.Union.Tag,
+		Data:      &data,
+	}
+
+	return msg, nil
+}
+
+// analyzeUnionSender checks that payload sender is compatible with the tag and returns union type.
+func (a Analyzer) analyzeUnionSender(
+	sender src.ConnectionSender,
+	scope src.Scope,
+	iface src.Interface,
+	nodes map[string]src.Node,
+	nodesIfaces map[string]foundInterface,
+	nodesUsage map[string]netNodeUsage,
+	prevChainLink []src.ConnectionSender,
+) (*src.ConnectionSender, *ts.Expr, *compiler.Error) {
+	union := *sender.Union
+
+	unionType, payloadType, err := a.getUnionTag(union.EntityRef, union.Tag, scope)
+	if err != nil {
+		return nil, nil, compiler.Error{Meta: &union.Meta}.Wrap(err)
+	}
+
+	if payloadType == nil {
+		return nil, nil, &compiler.Error{
+			Message: fmt.Sprintf("Union tag %v has no payload", union.Tag),
+			Meta:    &union.Meta,
+		}
+	}
+
+	analyzedData, dataType, err := a.analyzeSender(
+		*union.Data,
+		scope,
+		iface,
+		nodes,
+		nodesIfaces,
+		nodesUsage,
+		prevChainLink,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := a.resolver.IsSubtypeOf(*dataType, *payloadType, scope); err != nil {
+		return nil, nil, &compiler.Error{
+			Message: fmt.Sprintf("Incompatible payload of union tag %v: %v", union.Tag, err),
+			Meta:    &union.Meta,
+		}
+	}
+
+	union.Data = analyzedData
+	union.AnalyzedType = unionType
+
+	return &src.ConnectionSender{
+		Union: &union,
+		Meta:  sender.Meta,
+	}, &unionType, nil
+}
+
+// getUnionTag returns resolved tagged union type and payload type of its tag (nil if tag has no payload).
+func (a Analyzer) getUnionTag(
+	ref core.EntityRef,
+	tag string,
+	scope src.Scope,
+) (ts.Expr, *ts.Expr, *compiler.Error) {
+	unionType, err := a.resolver.ResolveExpr(
+		ts.Expr{Inst: &ts.InstExpr{Ref: ref}},
+		scope,
+	)
+	if err != nil {
+		return ts.Expr{}, nil, &compiler.Error{
+			Message: err.Error(),
+			Meta:    &ref.Meta,
+		}
+	}
+
+	if unionType.Lit == nil || unionType.Lit.Tagged == nil {
+		return ts.Expr{}, nil, &compiler.Error{
+			Message: fmt.Sprintf("Type is not a tagged union: %v", ref),
+			Meta:    &ref.Meta,
+		}
+	}
+
+	_, payloadType, ok := unionType.Lit.Tag(tag)
+	if !ok {
+		return ts.Expr{}, nil, &compiler.Error{
+			Message: fmt.Sprintf("Union tag not found: %v", tag),
+			Meta:    &ref.Meta,
+		}
+	}
+
+	return unionType, payloadType, nil
+}
+
+// isUnionSwitch reports whether switch cases are tags of tagged union, e.g. `Input::Int -> :out`.
+// Such switch routes messages by tag instead of comparing them with case values.
+func (a Analyzer) isUnionSwitch(switchRecv src.Switch, scope src.Scope) (bool, *compiler.Error) {
+	var unionCases int
+
+	for _, switchCase := range switchRecv.Cases {
+		if len(switchCase.Senders) != 1 {
+			continue
+		}
+		sender := switchCase.Senders[0]
+		if sender.Const == nil || sender.Const.Value.Message == nil || sender.Const.Value.Message.Enum == nil {
+			continue
+		}
+		resolved, err := a.resolver.ResolveExpr(
+			ts.Expr{Inst: &ts.InstExpr{Ref: sender.Const.Value.Message.Enum.EnumRef}},
+			scope,
+		)
+		if err == nil && resolved.Lit != nil && resolved.Lit.Tagged != nil {
+			unionCases++
+		}
+	}
+
+	if unionCases > 0 && unionCases != len(switchRecv.Cases) {
+		return false, &compiler.Error{
+			Message: "Switch cannot mix union tags with other cases",
+			Meta:    &switchRecv.Meta,
+		}
+	}
+
+	return unionCases > 0, nil
+}
+
+// analyzeUnionSwitchReceiver analyzes switch over union tags.
+// Each case receives payload of its tag (or union itself if tag has no payload),
+// default case receives the union as is.
+func (a Analyzer) analyzeUnionSwitchReceiver(
+	receiver src.ConnectionReceiver,
+	iface src.Interface,
+	nodes map[string]src.Node,
+	nodesIfaces map[string]foundInterface,
+	scope src.Scope,
+	nodesUsage map[string]netNodeUsage,
+	analyzedSenders []src.ConnectionSender,
+	resolvedSenderTypes []*ts.Expr,
+) ([]src.NormalConnection, []src.ConnectionReceiver, *compiler.Error) {
+	analyzedSwitchConns := make([]src.NormalConnection, 0, len(receiver.Switch.Cases))
+
+	for _, switchConn := range receiver.Switch.Cases {
+		caseSender := switchConn.Senders[0]
+		enum := caseSender.Const.Value.Message.Enum
+
+		unionType, payloadType, err := a.getUnionTag(enum.EnumRef, enum.MemberName, scope)
+		if err != nil {
+			return nil, nil, compiler.Error{Meta: &caseSender.Meta}.Wrap(err)
+		}
+
+		for i, resolvedSenderType := range resolvedSenderTypes {
+			if err := a.resolver.IsSubtypeOf(*resolvedSenderType, unionType, scope); err != nil {
+				return nil, nil, &compiler.Error{
+					Message: fmt.Sprintf(
+						"Incompatible types in switch: %v -> %v: %v",
+						analyzedSenders[i], caseSender, err.Error(),
+					),
+					Meta: &caseSender.Meta,
+				}
+			}
+		}
+
+		caseType := unionType
+		if payloadType != nil {
+			caseType = *payloadType
+		}
+
+		analyzedReceivers, err := a.analyzeReceiverSide(
+			switchConn.Receivers,
+			scope,
+			iface,
+			nodes,
+			nodesIfaces,
+			nodesUsage,
+			[]*ts.Expr{&caseType},
+			[]src.ConnectionSender{caseSender},
+		)
+		if err != nil {
+			return nil, nil, &compiler.Error{
+				Message: fmt.Sprintf("Invalid switch case: %v", err),
+				Meta:    &switchConn.Meta,
+			}
+		}
+
+		analyzedSwitchConns = append(analyzedSwitchConns, src.NormalConnection{
+			Senders: []src.ConnectionSender{{
+				Union: &src.Union{
+					EntityRef:    enum.EnumRef,
+					Tag:          enum.MemberName,
+					Meta:         caseSender.Meta,
+					AnalyzedType: unionType,
+				},
+				Meta: caseSender.Meta,
+			}},
+			Receivers: analyzedReceivers,
+			Meta:      switchConn.Meta,
+		})
+	}
+
+	if receiver.Switch.Default == nil {
+		return nil, nil, &compiler.Error{
+			Message: "Switch must have a default case",
+			Meta:    &receiver.Meta,
+		}
+	}
+
+	analyzedDefault, err := a.analyzeReceiverSide(
+		receiver.Switch.Default,
+		scope,
+		iface,
+		nodes,
+		nodesIfaces,
+		nodesUsage,
+		resolvedSenderTypes,
+		analyzedSenders,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return analyzedSwitchConns, analyzedDefault, nil
+}
+
+// maxUnionTags is how many tags fit into the uint8 tag index of union messages.
+const maxUnionTags = 256
+
+// analyzeUnionTagsCount checks that tagged unions of the type expression (including nested ones)
+// don't have more tags than union message can refer to. Referenced types are checked by their definitions.
+func (a Analyzer) analyzeUnionTagsCount(expr ts.Expr) *compiler.Error {
+	if expr.Inst != nil {
+		for _, arg := range expr.Inst.Args {
+			if err := a.analyzeUnionTagsCount(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if expr.Lit == nil {
+		return nil
+	}
+
+	if len(expr.Lit.Tagged) > maxUnionTags {
+		return &compiler.Error{
+			Message: fmt.Sprintf(
+				"Tagged union cannot have more than %d tags, got %d",
+				maxUnionTags, len(expr.Lit.Tagged),
+			),
+			Meta: &expr.Meta,
+		}
+	}
+
+	for _, tag := range expr.Lit.Tagged {
+		if tag.Type == nil {
+			continue
+		}
+		if err := a.analyzeUnionTagsCount(*tag.Type); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range expr.Lit.Struct {
+		if err := a.analyzeUnionTagsCount(field); err != nil {
+			return err
+		}
+	}
+
+	for _, el := range expr.Lit.Union {
+		if err := a.analyzeUnionTagsCount(el); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"path"
 	"sort"
 	"strings"
 	"text/template"
 
-	"github.com/nevalang/neva/internal"
 	"github.com/nevalang/neva/internal/compiler"
 	"github.com/nevalang/neva/internal/compiler/ir"
 	"github.com/nevalang/neva/pkg"
@@ -17,19 +17,82 @@ import (
 
 type Backend struct{}
 
+const (
+	goModule   = "github.com/nevalang/neva/pkg" // must match imports in runtime package
+	externsDir = "externs"                      // where modules' go packages are placed
+)
+
 var (
 	ErrExecTmpl       = errors.New("execute template")
 	ErrUnknownMsgType = errors.New("unknown msg type")
 )
 
-func (b Backend) Emit(dst string, prog *ir.Program, trace bool) error {
+func (b Backend) Emit(dst string, prog *ir.Program, opts compiler.EmitOptions) error {
+	files := map[string][]byte{}
+
+	goPkgImports := make([]string, 0, len(prog.GoPackages))
+	for _, goPkg := range prog.GoPackages {
+		dir := path.Join(externsDir, goPkg.Path)
+		for fileName, content := range goPkg.Files {
+			files[path.Join(dir, fileName)] = content
+		}
+		goPkgImports = append(goPkgImports, path.Join(goModule, dir))
+	}
+
+	mainGo, err := b.render(mainGoTemplate, prog, templateData{
+		Trace:         opts.Trace,
+		TraceFormat:   traceFormat(opts.TraceFormat),
+		Profile:       opts.Profile,
+		Metrics:       opts.Metrics,
+		MetricsFormat: metricsFormat(opts.MetricsFormat),
+		DrainTimeout:  opts.DrainTimeout.Nanoseconds(),
+		Flowtrace:     opts.Flowtrace,
+		GoPackages:    goPkgImports,
+	})
+	if err != nil {
+		return err
+	}
+
+	files["main.go"] = mainGo
+	files["go.mod"] = []byte("module " + goModule + "\n\ngo 1.23")
+
+	if err := b.insertRuntimeFiles(files); err != nil {
+		return err
+	}
+
+	return compiler.SaveFilesToDir(dst, files)
+}
+
+// traceFormat returns format of the trace file, text is the default one.
+func traceFormat(format string) string {
+	if format == "" {
+		return "text"
+	}
+	return format
+}
+
+// metricsFormat returns format of the metrics file, json is the default one.
+func metricsFormat(format string) string {
+	if format == "" {
+		return "json"
+	}
+	return format
+}
+
+// render executes given template with the data about the program.
+// The template can use "newProgram" template to define the function that creates runtime program.
+func (b Backend) render(tplText string, prog *ir.Program, data templateData) ([]byte, error) {
+	// buffers must be moved to final receivers before intermediate connections are removed
+	buffers := ir.ReduceBuffers(prog.Connections, prog.Buffers)
+
 	// graph must not contain intermediate connections to be supported by runtime
 	prog.Connections = ir.GraphReduction(prog.Connections)
 
-	addrToChanVar, chanVarNames := b.buildPortChanMap(prog.Connections)
+	addrToChanVar, _ := b.buildPortChanMap(prog.Connections)
+	chans := b.buildChans(prog.Connections, addrToChanVar, buffers, prog.ChanBuffer)
 	funcCalls, err := b.buildFuncCalls(prog.Funcs, addrToChanVar)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	funcmap := template.FuncMap{
@@ -43,32 +106,26 @@ func (b Backend) Emit(dst string, prog *ir.Program, trace bool) error {
 		},
 	}
 
-	tmpl, err := template.New("tpl.go").Funcs(funcmap).Parse(mainGoTemplate)
+	tmpl, err := template.New("tpl.go").Funcs(funcmap).Parse(tplText)
 	if err != nil {
-		return err
-	}
-
-	tplData := templateData{
-		CompilerVersion: pkg.Version,
-		ChanVarNames:    chanVarNames,
-		FuncCalls:       funcCalls,
-		Trace:           trace,
+		return nil, err
 	}
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, tplData); err != nil {
-		return errors.Join(ErrExecTmpl, err)
+	if _, err := tmpl.Parse(newProgramTemplate); err != nil {
+		return nil, err
 	}
 
-	files := map[string][]byte{}
-	files["main.go"] = buf.Bytes()
-	files["go.mod"] = []byte("module github.com/nevalang/neva/internal\n\ngo 1.23") //nolint:lll // must match imports in runtime package
+	data.CompilerVersion = pkg.Version
+	data.Chans = chans
+	data.FuncCalls = funcCalls
+	data.ExitCode = prog.ExitCode
 
-	if err := b.insertRuntimeFiles(files); err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Join(ErrExecTmpl, err)
 	}
 
-	return compiler.SaveFilesToDir(dst, files)
+	return buf.Bytes(), nil
 }
 
 func (b Backend) buildFuncCalls(
@@ -231,14 +288,14 @@ func (b Backend) getMessageString(msg *ir.Message) (string, error) {
 			if err != nil {
 				return "", err
 			}
-			keyValuePairs = append(keyValuePairs, fmt.Sprintf(`"%s": %s`, k, el))
+			keyValuePairs = append(keyValuePairs, fmt.Sprintf(`%q: %s`, k, el))
 		}
 		return fmt.Sprintf("runtime.NewDictMsg(map[string]runtime.Msg{%s})", strings.Join(keyValuePairs, ", ")), nil
 	case ir.MsgTypeStruct:
 		names := make([]string, 0, len(msg.DictOrStruct))
 		values := make([]string, 0, len(msg.DictOrStruct))
 		for k, v := range msg.DictOrStruct {
-			names = append(names, fmt.Sprintf(`"%s"`, k))
+			names = append(names, fmt.Sprintf(`%q`, k))
 			el, err := b.getMessageString(compiler.Pointer(v))
 			if err != nil {
 				return "", err
@@ -248,24 +305,33 @@ func (b Backend) getMessageString(msg *ir.Message) (string, error) {
 		return fmt.Sprintf(`runtime.NewStructMsg([]string{%s}, []runtime.Msg{%s})`,
 			strings.Join(names, ", "),
 			strings.Join(values, ", ")), nil
+	case ir.MsgTypeUnion:
+		if msg.Union.Data == nil {
+			return fmt.Sprintf("runtime.NewUnionMsg(%d, nil)", msg.Union.Tag), nil
+		}
+		data, err := b.getMessageString(msg.Union.Data)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("runtime.NewUnionMsg(%d, %s)", msg.Union.Tag, data), nil
 	}
 	return "", fmt.Errorf("%w: %v", ErrUnknownMsgType, msg.Type)
 }
 
 func (b Backend) insertRuntimeFiles(files map[string][]byte) error {
 	if err := fs.WalkDir(
-		internal.Efs,
+		pkg.Efs,
 		"runtime",
 		func(path string, dirEntry fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
 
-			if dirEntry.IsDir() {
+			if dirEntry.IsDir() || strings.HasSuffix(path, "_test.go") {
 				return nil
 			}
 
-			bb, err := internal.Efs.ReadFile(path)
+			bb, err := pkg.Efs.ReadFile(path)
 			if err != nil {
 				return err
 			}
@@ -280,6 +346,33 @@ func (b Backend) insertRuntimeFiles(files map[string][]byte) error {
 	return nil
 }
 
+// buildChans returns channels for every connection, ordered by name.
+// Connections without explicit buffer get the default one.
+func (b Backend) buildChans(
+	connections map[ir.PortAddr]ir.PortAddr,
+	addrToChanVar map[ir.PortAddr]string,
+	buffers map[ir.PortAddr]int,
+	defaultBuffer int,
+) []templateChan {
+	chans := make([]templateChan, 0, len(connections))
+	for sender, receiver := range connections {
+		size, ok := buffers[receiver]
+		if !ok {
+			size = defaultBuffer
+		}
+		chans = append(chans, templateChan{
+			Name:   addrToChanVar[sender],
+			Buffer: size,
+		})
+	}
+
+	sort.Slice(chans, func(i, j int) bool {
+		return chans[i].Name < chans[j].Name
+	})
+
+	return chans
+}
+
 func (b Backend) buildPortChanMap(connections map[ir.PortAddr]ir.PortAddr) (map[ir.PortAddr]string, []string) {
 	portsCount := len(connections) * 2
 	varNames := make([]string, 0, portsCount)
@@ -0,0 +1,67 @@
+package golang
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/nevalang/neva/internal/compiler"
+	"github.com/nevalang/neva/internal/compiler/ir"
+)
+
+// LibBackend emits Go package that can be imported and used by Go programs.
+// Unlike Backend it doesn't produce Go module. Instead generated package
+// imports runtime from github.com/nevalang/neva/pkg.
+type LibBackend struct {
+	golang Backend
+}
+
+var (
+	ErrGoPackagesInLib = errors.New("modules with Go packages are not supported by go-lib target")
+	ErrProfileInLib    = errors.New("profiling is not supported by go-lib target")
+	ErrMetricsInLib    = errors.New("metrics are not supported by go-lib target")
+)
+
+func (b LibBackend) Emit(dst string, prog *ir.Program, opts compiler.EmitOptions) error {
+	if len(prog.GoPackages) > 0 {
+		return ErrGoPackagesInLib
+	}
+	if opts.Profile != "" {
+		return ErrProfileInLib
+	}
+	if opts.Metrics != "" {
+		return ErrMetricsInLib
+	}
+
+	programGo, err := b.golang.render(libGoTemplate, prog, templateData{
+		PkgName:     libPkgName(dst),
+		Trace:       opts.Trace,
+		TraceFormat: traceFormat(opts.TraceFormat),
+	})
+	if err != nil {
+		return err
+	}
+
+	return compiler.SaveFilesToDir(dst, map[string][]byte{
+		"program.go": programGo,
+	})
+}
+
+// libPkgName turns name of the output directory into valid Go package name.
+func libPkgName(dst string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(filepath.Base(dst)) {
+		if r == '_' || unicode.IsLetter(r) || (unicode.IsDigit(r) && sb.Len() > 0) {
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() == 0 {
+		return "neva"
+	}
+	return sb.String()
+}
+
+func NewLibBackend(golang Backend) LibBackend {
+	return LibBackend{golang: golang}
+}
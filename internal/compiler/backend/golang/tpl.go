@@ -2,9 +2,23 @@ package golang
 
 type templateData struct {
 	CompilerVersion string
-	ChanVarNames    []string
+	PkgName         string // only used by library template
+	Chans           []templateChan
 	FuncCalls       []templateFuncCall
 	Trace           bool
+	TraceFormat     string
+	Profile         string // path to the profile file, empty if profiling is disabled
+	Metrics         string // path to the metrics file, empty if metrics are disabled
+	MetricsFormat   string
+	DrainTimeout    int64    // nanoseconds functions have to finish after interruption
+	Flowtrace       bool     // print flowtrace of the message on panic
+	GoPackages      []string // import paths of packages with runtime functions implemented by modules
+	ExitCode        bool     // message from Main's stop outport is the exit code
+}
+
+type templateChan struct {
+	Name   string
+	Buffer int // zero means unbuffered
 }
 
 type templateFuncCall struct {
@@ -22,40 +36,166 @@ var mainGoTemplate = `// Code generated by Neva v{{.CompilerVersion}}. DO NOT ED
 package main
 
 import (
+    "errors"
     "fmt"
     "os"
     "context"
 
-    "github.com/nevalang/neva/internal/runtime"
-    "github.com/nevalang/neva/internal/runtime/funcs"
+    "github.com/nevalang/neva/pkg/runtime"
+    "github.com/nevalang/neva/pkg/runtime/funcs"
+    {{- range $i, $path := .GoPackages}}
+    extern{{$i}} "{{$path}}"
+    {{- end}}
 )
 
 func main() {
-    var (
-        {{- range .ChanVarNames}}
-        {{.}} = make(chan runtime.OrderedMsg)
-        {{- end}}
-    )
-    {{- if .Trace }}
+    os.Exit(run())
+}
 
-    interceptor := runtime.NewDebugInterceptor()
+// run returns exit code, so deferred functions are called before the process exits.
+func run() (code int) {
+    var interceptors []runtime.Interceptor
+    {{- if .Flowtrace }}
 
-    close, err := interceptor.Open("trace.log")
+    flowtraceInterceptor := runtime.NewFlowtraceInterceptor()
+    interceptors = append(interceptors, flowtraceInterceptor)
+    {{- end }}
+    {{- if .Trace }}
+
+    debugInterceptor := runtime.NewDebugInterceptor("{{.TraceFormat}}")
+    closeTrace, err := debugInterceptor.Open("trace.log")
     if err != nil {
         fmt.Fprintln(os.Stderr, "can't open trace file:", err.Error())
-        os.Exit(1)
+        return 1
     }
     defer func() {
-        if err := close(); err != nil {
+        if err := closeTrace(); err != nil {
             fmt.Fprintln(os.Stderr, "can't close trace file:", err.Error())
-            os.Exit(1)
+            code = 1
         }
     }()
-    {{- else }}
+    interceptors = append(interceptors, debugInterceptor)
+    {{- end }}
+    {{- if .Profile }}
 
+    profileInterceptor := runtime.NewProfileInterceptor()
+    closeProfile, err := profileInterceptor.Open({{printf "%q" .Profile}})
+    if err != nil {
+        fmt.Fprintln(os.Stderr, "can't open profile file:", err.Error())
+        return 1
+    }
+    defer func() {
+        if err := closeProfile(); err != nil {
+            fmt.Fprintln(os.Stderr, "can't write profile file:", err.Error())
+            code = 1
+        }
+    }()
+    interceptors = append(interceptors, profileInterceptor)
+    {{- end }}
+    {{- if .Metrics }}
+
+    metricsInterceptor := runtime.NewMetricsInterceptor()
+    closeMetrics, err := metricsInterceptor.Open({{printf "%q" .Metrics}}, "{{.MetricsFormat}}")
+    if err != nil {
+        fmt.Fprintln(os.Stderr, "can't open metrics file:", err.Error())
+        return 1
+    }
+    defer func() {
+        if err := closeMetrics(); err != nil {
+            fmt.Fprintln(os.Stderr, "can't write metrics file:", err.Error())
+            code = 1
+        }
+    }()
+    interceptors = append(interceptors, metricsInterceptor)
+    {{- end }}
+
+    interceptor := runtime.NewMultiInterceptor(interceptors...)
+
+    registry := funcs.NewRegistry()
+    {{- range $i, $_ := .GoPackages}}
+    for ref, creator := range extern{{$i}}.Registry() {
+        registry[ref] = creator
+    }
+    {{- end}}
+
+    runErr := runtime.RunGracefully(
+        context.Background(),
+        newProgram(interceptor),
+        registry,
+        {{.DrainTimeout}}, // nanoseconds
+    )
+    if runErr == nil {
+        return 0
+    }
+
+    var interrupted *runtime.InterruptedError
+    if errors.As(runErr, &interrupted) {
+        if !interrupted.Drained {
+            fmt.Fprintln(os.Stderr, "runtime error:", runErr.Error())
+        }
+        return interrupted.ExitCode()
+    }
+
+    var exit *runtime.ExitError
+    if errors.As(runErr, &exit) {
+        return exit.Code
+    }
+
+    var panicked *runtime.PanicError
+    if errors.As(runErr, &panicked) {
+        fmt.Fprintln(os.Stderr, panicked.Error())
+        {{- if .Flowtrace }}
+        fmt.Fprint(os.Stderr, flowtraceInterceptor.Format(panicked.Port))
+        {{- end }}
+        return 1
+    }
+
+    fmt.Fprintln(os.Stderr, "runtime error:", runErr.Error())
+    return 1
+}
+{{template "newProgram" .}}`
+
+var libGoTemplate = `// Code generated by Neva v{{.CompilerVersion}}. DO NOT EDIT.
+
+// Package {{.PkgName}} allows to run Neva program from Go code.
+// It requires github.com/nevalang/neva module of version v{{.CompilerVersion}}.
+package {{.PkgName}}
+
+import (
+    "context"
+
+    "github.com/nevalang/neva/pkg/runtime"
+    "github.com/nevalang/neva/pkg/runtime/funcs"
+)
+
+// Main runs the program until ctx is cancelled or program panics.
+// Every message from start is sent to the Main's :start inport
+// and every message from the Main's :stop outport is sent to stop.
+func Main(ctx context.Context, start <-chan runtime.Msg, stop chan<- runtime.Msg) error {
+    {{- if .Trace }}
+    interceptor := runtime.NewDebugInterceptor("{{.TraceFormat}}")
+
+    close, err := interceptor.Open("trace.log")
+    if err != nil {
+        return err
+    }
+    defer close()
+    {{- else }}
     interceptor := runtime.ProdInterceptor{}
     {{- end }}
 
+    return runtime.Serve(ctx, newProgram(interceptor), funcs.NewRegistry(), start, stop)
+}
+{{template "newProgram" .}}`
+
+var newProgramTemplate = `{{define "newProgram"}}
+func newProgram(interceptor runtime.Interceptor) runtime.Program {
+    var (
+        {{- range .Chans}}
+        {{.Name}} = make(chan runtime.OrderedMsg{{if .Buffer}}, {{.Buffer}}{{end}})
+        {{- end}}
+    )
+
     var (
         startPort = runtime.NewSingleOutport(
             runtime.PortAddr{Path: "in", Port: "start"},
@@ -90,15 +230,11 @@ func main() {
         {{- end}}
     }
 
-    rprog := runtime.Program{
-        Start: startPort,
-        Stop: stopPort,
+    return runtime.Program{
+        Start:     startPort,
+        Stop:      stopPort,
         FuncCalls: funcCalls,
+        ExitCode:  {{.ExitCode}},
     }
-    
-    if err := runtime.Run(context.Background(), rprog, funcs.NewRegistry()); err != nil {
-		fmt.Fprintln(os.Stderr, "runtime error:", err.Error())
-		os.Exit(1)
-	}
 }
-`
+{{end}}`
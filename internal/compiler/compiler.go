@@ -2,7 +2,9 @@ package compiler
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/nevalang/neva/internal/compiler/ir"
 	"github.com/nevalang/neva/internal/compiler/sourcecode"
@@ -16,23 +18,60 @@ type Compiler struct {
 }
 
 type CompilerInput struct {
-	Main   string
-	Output string
-	Trace  bool
+	Main          string
+	Output        string
+	Trace         bool
+	TraceFormat   string // text (default) or json, only used with Trace
+	Profile       string // path to Chrome Trace Event file, empty means no profiling
+	Metrics       string // path to metrics file, empty means no metrics
+	MetricsFormat string // json (default) or prometheus, only used with Metrics
+	ChanBuffer    int    // buffer size of connections without #buffer directive
+	DrainTimeout  time.Duration
+	Flowtrace     bool // print flowtrace of the message on panic
+	Werror        bool // treat warnings as errors
 }
 
-func (c Compiler) Compile(ctx context.Context, input CompilerInput) error {
+// EmitOptions are passed to the backend and affect how generated program behaves.
+type EmitOptions struct {
+	Trace         bool          // write trace.log file at runtime
+	TraceFormat   string        // format of trace.log entries, empty means text
+	Profile       string        // write Chrome Trace Event file to this path at runtime
+	Metrics       string        // dump port metrics to this path at exit and on SIGUSR1
+	MetricsFormat string        // format of metrics file, empty means json
+	DrainTimeout  time.Duration // how long functions can finish their work after SIGINT or SIGTERM
+	Flowtrace     bool          // record ports messages travel through to print them on panic
+}
+
+// Compile compiles the program and returns warnings found along the way.
+// If input.Werror is set, compilation fails if there are any warnings.
+func (c Compiler) Compile(ctx context.Context, input CompilerInput) ([]Warning, error) {
 	feResult, err := c.fe.Process(ctx, input.Main)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	meResult, err := c.me.Process(feResult)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if input.Werror && len(meResult.Warnings) != 0 {
+		return meResult.Warnings, &Error{
+			Message: fmt.Sprintf("%d warning(s) treated as errors", len(meResult.Warnings)),
+		}
 	}
 
-	return c.be.Emit(input.Output, meResult.IR, input.Trace)
+	meResult.IR.ChanBuffer = input.ChanBuffer
+
+	return meResult.Warnings, c.be.Emit(input.Output, meResult.IR, EmitOptions{
+		Trace:         input.Trace,
+		TraceFormat:   input.TraceFormat,
+		Profile:       input.Profile,
+		Metrics:       input.Metrics,
+		MetricsFormat: input.MetricsFormat,
+		DrainTimeout:  input.DrainTimeout,
+		Flowtrace:     input.Flowtrace,
+	})
 }
 
 type Frontend struct {
@@ -91,10 +130,11 @@ type MiddleendResult struct {
 	AnalyzedBuild  sourcecode.Build
 	DesugaredBuild sourcecode.Build
 	IR             *ir.Program
+	Warnings       []Warning // sorted by location
 }
 
 func (m Middleend) Process(feResult FrontendResult) (MiddleendResult, *Error) {
-	analyzedBuild, err := m.analyzer.AnalyzeExecutableBuild(
+	analyzedBuild, analyzerWarnings, err := m.analyzer.AnalyzeExecutableBuild(
 		feResult.ParsedBuild,
 		feResult.MainPkg,
 	)
@@ -102,9 +142,9 @@ func (m Middleend) Process(feResult FrontendResult) (MiddleendResult, *Error) {
 		return MiddleendResult{}, err
 	}
 
-	desugaredBuild, derr := m.desugarer.Desugar(analyzedBuild)
+	desugaredBuild, desugarerWarnings, derr := m.desugarer.Desugar(analyzedBuild)
 	if derr != nil {
-		return MiddleendResult{}, err
+		return MiddleendResult{}, &Error{Message: derr.Error()}
 	}
 
 	irProg, irerr := m.irgen.Generate(desugaredBuild, feResult.MainPkg)
@@ -123,6 +163,7 @@ func (m Middleend) Process(feResult FrontendResult) (MiddleendResult, *Error) {
 		AnalyzedBuild:  analyzedBuild,
 		DesugaredBuild: desugaredBuild,
 		IR:             irProg,
+		Warnings:       sortWarnings(append(analyzerWarnings, desugarerWarnings...)),
 	}, nil
 }
 
@@ -12,6 +12,8 @@ const (
 	ExternDirective    src.Directive = "extern"
 	BindDirective      src.Directive = "bind"
 	AutoportsDirective src.Directive = "autoports"
+	BufferDirective    src.Directive = "buffer"
+	TypeInfoDirective  src.Directive = "typeinfo"
 )
 
 type (
@@ -29,18 +31,19 @@ type (
 	}
 
 	RawModule struct {
-		Manifest src.ModuleManifest    // Manifest must be parsed by builder before passing into compiler
-		Packages map[string]RawPackage // Packages themselves on the other hand can be parsed by compiler
+		Manifest   src.ModuleManifest    // Manifest must be parsed by builder before passing into compiler
+		Packages   map[string]RawPackage // Packages themselves on the other hand can be parsed by compiler
+		GoPackages map[string]RawPackage // Go packages from "go" directory that implement module's runtime functions
 	}
 
 	RawPackage map[string][]byte
 
 	Analyzer interface {
-		AnalyzeExecutableBuild(mod src.Build, mainPkgName string) (src.Build, *Error)
+		AnalyzeExecutableBuild(mod src.Build, mainPkgName string) (src.Build, []Warning, *Error)
 	}
 
 	Desugarer interface {
-		Desugar(build src.Build) (src.Build, error)
+		Desugar(build src.Build) (src.Build, []Warning, error)
 	}
 
 	Irgen interface {
@@ -48,6 +51,6 @@ type (
 	}
 
 	Backend interface {
-		Emit(dst string, prog *ir.Program, trace bool) error
+		Emit(dst string, prog *ir.Program, opts EmitOptions) error
 	}
 )
@@ -63,6 +63,7 @@ func (d *Desugarer) desugarComponent(
 		desugarNetResult.nodesPortsUsed,
 	)
 	if unusedOutports.len() != 0 {
+		d.warnings = append(d.warnings, d.getUnusedOutportsWarnings(component, scope, unusedOutports)...)
 		unusedOutportsResult := d.handleUnusedOutports(unusedOutports, component.Meta)
 		desugaredNetwork = append(desugaredNetwork, unusedOutportsResult.virtualConnections...)
 		desugaredNodes[unusedOutportsResult.voidNodeName] = unusedOutportsResult.delNode
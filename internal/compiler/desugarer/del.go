@@ -1,6 +1,9 @@
 package desugarer
 
 import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 )
@@ -69,6 +72,37 @@ func (Desugarer) handleUnusedOutports(
 	return result
 }
 
+// getUnusedOutportsWarnings reports outports of user-defined components that are implicitly connected to Del.
+// Runtime functions often have outports that are not needed (e.g. Println:res) so they are not reported.
+func (Desugarer) getUnusedOutportsWarnings(
+	component src.Component,
+	scope Scope,
+	unusedOutports nodeOutportsUsed,
+) []compiler.Warning {
+	var warnings []compiler.Warning
+
+	for nodeName, ports := range unusedOutports.m {
+		node := component.Nodes[nodeName]
+
+		entity, _, err := scope.Entity(node.EntityRef)
+		if err != nil || entity.Kind != src.ComponentEntity {
+			continue
+		}
+		if _, isExtern := entity.Component.Directives[compiler.ExternDirective]; isExtern {
+			continue
+		}
+
+		for portName := range ports {
+			warnings = append(warnings, compiler.Warning{
+				Message: fmt.Sprintf("Unused outport is discarded: %v:%v", nodeName, portName),
+				Meta:    &node.Meta,
+			})
+		}
+	}
+
+	return warnings
+}
+
 func (Desugarer) findUnusedOutports(
 	component src.Component,
 	scope src.Scope,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"maps"
 
+	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 	"github.com/nevalang/neva/pkg"
@@ -21,6 +22,8 @@ type Desugarer struct {
 	fanOutCounter         uint64
 	fanInCounter          uint64
 	rangeCounter          uint64
+	unionCounter          uint64
+	unionSwitchCounter    uint64
 	// Arithmetic
 	addCounter uint64
 	subCounter uint64
@@ -44,23 +47,34 @@ type Desugarer struct {
 	bitXorCounter uint64
 	bitLshCounter uint64
 	bitRshCounter uint64
+	// Diagnostics
+	warnings []compiler.Warning
 }
 
-func (d *Desugarer) Desugar(build src.Build) (src.Build, error) {
+// Desugar returns desugared build and warnings about implicit behavior (e.g. discarded outports) in the entry module.
+func (d *Desugarer) Desugar(build src.Build) (src.Build, []compiler.Warning, error) {
 	desugaredMods := make(map[core.ModuleRef]src.Module, len(build.Modules))
 
+	d.warnings = nil
 	for modRef := range build.Modules {
 		desugaredMod, err := d.desugarModule(build, modRef)
 		if err != nil {
-			return src.Build{}, fmt.Errorf("desugar module %s: %w", modRef, err)
+			return src.Build{}, nil, fmt.Errorf("desugar module %s: %w", modRef, err)
 		}
 		desugaredMods[modRef] = desugaredMod
 	}
 
+	var warnings []compiler.Warning
+	for _, warning := range d.warnings {
+		if warning.Meta.Location.ModRef == build.EntryModRef {
+			warnings = append(warnings, warning)
+		}
+	}
+
 	return src.Build{
 		EntryModRef: build.EntryModRef,
 		Modules:     desugaredMods,
-	}, nil
+	}, warnings, nil
 }
 
 func (d *Desugarer) desugarModule(
@@ -80,8 +94,9 @@ func (d *Desugarer) desugarModule(
 	// copy all modules but replace manifest in current one
 	modsCopy := maps.Clone(build.Modules)
 	modsCopy[modRef] = src.Module{
-		Manifest: desugaredManifest,
-		Packages: mod.Packages,
+		Manifest:   desugaredManifest,
+		Packages:   mod.Packages,
+		GoPackages: mod.GoPackages,
 	}
 
 	// create new build with patched modules (current module has patched manifest with std dependency)
@@ -108,8 +123,9 @@ func (d *Desugarer) desugarModule(
 	}
 
 	return src.Module{
-		Manifest: desugaredManifest,
-		Packages: desugaredPkgs,
+		Manifest:   desugaredManifest,
+		Packages:   desugaredPkgs,
+		GoPackages: mod.GoPackages,
 	}, nil
 }
 
@@ -284,13 +284,24 @@ func (d *Desugarer) desugarSingleReceiver(
 	}
 
 	if receiver.Switch != nil {
-		d.switchCounter++
-		switchNodeName := fmt.Sprintf("__switch__%d", d.switchCounter)
+		// switch over union tags routes by tag index instead of comparing messages
+		isUnionSwitch := receiver.Switch.Cases[0].Senders[0].Union != nil
+
+		var switchNodeName, switchComponent string
+		if isUnionSwitch {
+			d.unionSwitchCounter++
+			switchNodeName = fmt.Sprintf("__union_switch__%d", d.unionSwitchCounter)
+			switchComponent = "UnionSwitch"
+		} else {
+			d.switchCounter++
+			switchNodeName = fmt.Sprintf("__switch__%d", d.switchCounter)
+			switchComponent = "Switch"
+		}
 
 		nodesToInsert[switchNodeName] = src.Node{
 			EntityRef: core.EntityRef{
 				Pkg:  "builtin",
-				Name: "Switch",
+				Name: switchComponent,
 				Meta: locOnlyMeta,
 			},
 			Meta: locOnlyMeta,
@@ -318,10 +329,20 @@ func (d *Desugarer) desugarSingleReceiver(
 
 		// For each case in the switch
 		for i, caseConn := range receiver.Switch.Cases {
+			caseSenders := caseConn.Senders
+			if isUnionSwitch {
+				tagIdx, err := d.getUnionTagIdx(*caseConn.Senders[0].Union)
+				if err != nil {
+					return desugarReceiverResult{}, err
+				}
+				tagCfg := d.createUnionTagCfgMsg(tagIdx, locOnlyMeta)
+				caseSenders = []src.ConnectionSender{{Const: &tagCfg, Meta: locOnlyMeta}}
+			}
+
 			// Connect case-sender to switch:case[i]
 			insert = append(insert, src.Connection{
 				Normal: &src.NormalConnection{
-					Senders: caseConn.Senders,
+					Senders: caseSenders,
 					Receivers: []src.ConnectionReceiver{
 						{
 							PortAddr: &src.PortAddr{
@@ -826,11 +847,32 @@ func (d *Desugarer) desugarSingleSender(
 		return desugarSenderResult(result), nil
 	}
 
+	if sender.Union != nil {
+		result, err := d.desugarUnionSender(
+			iface,
+			*sender.Union,
+			normConn,
+			nodesToInsert,
+			constsToInsert,
+			usedNodeOutports,
+			scope,
+			nodes,
+		)
+		if err != nil {
+			return desugarSenderResult{}, fmt.Errorf("desugar union sender: %w", err)
+		}
+		return desugarSenderResult(result), nil
+	}
+
 	result, err := d.desugarRangeSender(
+		iface,
 		*sender.Range,
 		normConn,
 		nodesToInsert,
 		constsToInsert,
+		usedNodeOutports,
+		scope,
+		nodes,
 	)
 	if err != nil {
 		return desugarSenderResult{}, err
@@ -1042,33 +1084,24 @@ type handleRangeSenderResult struct {
 	insert  []src.Connection
 }
 
-// desugarRangeSender desugars `from..to -> XXX` part.
+// desugarRangeSender desugars `from..to..step -> XXX` part.
 // It does not create connection to range:sig,
 // it's done in chained connection desugaring.
 func (d *Desugarer) desugarRangeSender(
+	iface src.Interface,
 	rangeExpr src.Range,
 	normConn src.NormalConnection,
 	nodesToInsert map[string]src.Node,
 	constsToInsert map[string]src.Const,
+	usedNodeOutports nodeOutportsUsed,
+	scope Scope,
+	nodes map[string]src.Node,
 ) (handleRangeSenderResult, error) {
 	locOnlyMeta := core.Meta{Location: rangeExpr.Meta.Location}
 
 	d.rangeCounter++
 
 	rangeNodeName := fmt.Sprintf("__range%d__", d.rangeCounter)
-	fromConstName := fmt.Sprintf("__range%d_from__", d.rangeCounter)
-	toConstName := fmt.Sprintf("__range%d_to__", d.rangeCounter)
-
-	constsToInsert[fromConstName] = src.Const{
-		TypeExpr: ts.Expr{Inst: &ts.InstExpr{Ref: core.EntityRef{Pkg: "builtin", Name: "int"}}},
-		Value:    src.ConstValue{Message: &src.MsgLiteral{Int: compiler.Pointer(int(rangeExpr.From))}},
-		Meta:     locOnlyMeta,
-	}
-	constsToInsert[toConstName] = src.Const{
-		TypeExpr: ts.Expr{Inst: &ts.InstExpr{Ref: core.EntityRef{Pkg: "builtin", Name: "int"}}},
-		Value:    src.ConstValue{Message: &src.MsgLiteral{Int: compiler.Pointer(int(rangeExpr.To))}},
-		Meta:     locOnlyMeta,
-	}
 
 	nodesToInsert[rangeNodeName] = src.Node{
 		EntityRef: core.EntityRef{
@@ -1076,31 +1109,63 @@ func (d *Desugarer) desugarRangeSender(
 			Name: "Range",
 			Meta: locOnlyMeta,
 		},
-		Meta: locOnlyMeta,
+		TypeArgs: []ts.Expr{rangeExpr.AnalyzedType},
+		Meta:     locOnlyMeta,
 	}
-	nodesToInsert[fromConstName] = src.Node{
-		EntityRef: core.EntityRef{
-			Pkg:  "builtin",
-			Name: "New",
-			Meta: locOnlyMeta,
-		},
-		Directives: map[src.Directive][]string{
-			"bind": {fromConstName},
-		},
-		Meta: locOnlyMeta,
+
+	step := d.getRangeDefaultStep(rangeExpr, locOnlyMeta)
+	if rangeExpr.Step != nil {
+		step = *rangeExpr.Step
 	}
-	nodesToInsert[toConstName] = src.Node{
-		EntityRef: core.EntityRef{
-			Pkg:  "builtin",
-			Name: "New",
-			Meta: locOnlyMeta,
-		},
-		Directives: map[src.Directive][]string{
-			"bind": {toConstName},
-		},
-		Meta: locOnlyMeta,
+
+	// from -> range:from
+	// to -> range:to
+	// step -> range:step
+	members := []struct {
+		port   string
+		sender src.ConnectionSender
+	}{
+		{"from", rangeExpr.From},
+		{"to", rangeExpr.To},
+		{"step", step},
 	}
 
+	// members might be sugared (e.g. literals), so we need to desugar them
+	insert := make([]src.Connection, 0, len(members))
+	for _, member := range members {
+		desugarConnRes, err := d.desugarConnection(
+			iface,
+			src.Connection{
+				Normal: &src.NormalConnection{
+					Senders: []src.ConnectionSender{member.sender},
+					Receivers: []src.ConnectionReceiver{
+						{
+							PortAddr: &src.PortAddr{
+								Node: rangeNodeName,
+								Port: member.port,
+								Meta: locOnlyMeta,
+							},
+							Meta: locOnlyMeta,
+						},
+					},
+					Meta: locOnlyMeta,
+				},
+				Meta: locOnlyMeta,
+			},
+			usedNodeOutports,
+			scope,
+			nodes,
+			nodesToInsert,
+			constsToInsert,
+		)
+		if err != nil {
+			return handleRangeSenderResult{}, err
+		}
+		insert = append(insert, *desugarConnRes.replace)
+		insert = append(insert, desugarConnRes.insert...)
+	}
+
+	// range:res -> XXX
 	replace := src.NormalConnection{
 		Senders: []src.ConnectionSender{
 			{
@@ -1116,69 +1181,28 @@ func (d *Desugarer) desugarRangeSender(
 		Meta:      locOnlyMeta,
 	}
 
-	insert := []src.Connection{
-		// $from -> range:from
-		{
-			Normal: &src.NormalConnection{
-				Senders: []src.ConnectionSender{
-					{
-						PortAddr: &src.PortAddr{
-							Node: fromConstName,
-							Port: "res",
-							Meta: locOnlyMeta,
-						},
-						Meta: locOnlyMeta,
-					},
-				},
-				Receivers: []src.ConnectionReceiver{
-					{
-						PortAddr: &src.PortAddr{
-							Node: rangeNodeName,
-							Port: "from",
-							Meta: locOnlyMeta,
-						},
-						Meta: locOnlyMeta,
-					},
-				},
-				Meta: locOnlyMeta,
-			},
-			Meta: locOnlyMeta,
-		},
-		// $to -> range:to
-		{
-			Normal: &src.NormalConnection{
-				Senders: []src.ConnectionSender{
-					{
-						PortAddr: &src.PortAddr{
-							Node: toConstName,
-							Port: "res",
-							Meta: locOnlyMeta,
-						},
-						Meta: locOnlyMeta,
-					},
-				},
-				Receivers: []src.ConnectionReceiver{
-					{
-						PortAddr: &src.PortAddr{
-							Node: rangeNodeName,
-							Port: "to",
-							Meta: locOnlyMeta,
-						},
-						Meta: locOnlyMeta,
-					},
-				},
-				Meta: locOnlyMeta,
-			},
-			Meta: locOnlyMeta,
-		},
-	}
-
 	return handleRangeSenderResult{
 		insert:  insert,
 		replace: replace,
 	}, nil
 }
 
+// getRangeDefaultStep returns literal sender of step 1 with the type of the range.
+func (Desugarer) getRangeDefaultStep(rangeExpr src.Range, meta core.Meta) src.ConnectionSender {
+	msg := &src.MsgLiteral{Int: compiler.Pointer(1), Meta: meta}
+	if rangeExpr.AnalyzedType.Inst != nil && rangeExpr.AnalyzedType.Inst.Ref.Name == "float" {
+		msg = &src.MsgLiteral{Float: compiler.Pointer(1.0), Meta: meta}
+	}
+	return src.ConnectionSender{
+		Const: &src.Const{
+			TypeExpr: rangeExpr.AnalyzedType,
+			Value:    src.ConstValue{Message: msg},
+			Meta:     meta,
+		},
+		Meta: meta,
+	}
+}
+
 // desugarFanIn returns connections that must be used instead of given one.
 // It recursevely desugars each connection before return so result is final.
 func (d *Desugarer) desugarFanIn(
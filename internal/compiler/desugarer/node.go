@@ -1,15 +1,17 @@
 package desugarer
 
 import (
+	"encoding/json"
 	"fmt"
 	"maps"
 
 	"github.com/nevalang/neva/internal/compiler"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
 )
 
-func (Desugarer) handleNode(
+func (d *Desugarer) handleNode(
 	scope src.Scope,
 	node src.Node,
 	desugaredNodes map[string]src.Node,
@@ -62,6 +64,13 @@ func (Desugarer) handleNode(
 	// everything after this is only for component nodes
 	component := nodeEntity.Component
 
+	if _, hasTypeInfoDirective := component.Directives[compiler.TypeInfoDirective]; hasTypeInfoDirective {
+		node, err = d.bindTypeInfo(node, virtualEntities)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// only if node component uses #autoports
 	_, hasAutportsDirectory := component.Directives[compiler.AutoportsDirective]
 
@@ -163,3 +172,57 @@ func (Desugarer) handleNode(
 
 	return extraConnections, nil
 }
+
+var strConstTypeExpr = ts.Expr{
+	Inst: &ts.InstExpr{
+		Ref: core.EntityRef{Pkg: "builtin", Name: "string"},
+	},
+}
+
+// bindTypeInfo creates constant with description of node's type argument
+// and binds it to the node, so runtime function knows what type of data it works with.
+// Type argument is resolved and validated by analyzer.
+func (d *Desugarer) bindTypeInfo(
+	node src.Node,
+	virtualEntities map[string]src.Entity,
+) (src.Node, error) {
+	typeInfo, err := compiler.TypeInfo(node.TypeArgs[0])
+	if err != nil {
+		return src.Node{}, err
+	}
+
+	typeInfoJSON, err := json.Marshal(typeInfo)
+	if err != nil {
+		return src.Node{}, err
+	}
+
+	locOnlyMeta := core.Meta{
+		Location: node.Meta.Location,
+	}
+
+	d.virtualConstCount++
+	constName := fmt.Sprintf("__const__%d", d.virtualConstCount)
+
+	virtualEntities[constName] = src.Entity{
+		Kind: src.ConstEntity,
+		Const: src.Const{
+			TypeExpr: strConstTypeExpr,
+			Value: src.ConstValue{
+				Message: &src.MsgLiteral{
+					Str:  compiler.Pointer(string(typeInfoJSON)),
+					Meta: locOnlyMeta,
+				},
+			},
+			Meta: locOnlyMeta,
+		},
+	}
+
+	directives := maps.Clone(node.Directives)
+	if directives == nil {
+		directives = map[src.Directive][]string{}
+	}
+	directives[compiler.BindDirective] = []string{constName}
+	node.Directives = directives
+
+	return node, nil
+}
@@ -1,213 +1,144 @@
 package desugarer
 
-// import (
-// 	"fmt"
-
-// 	"github.com/nevalang/neva/internal/compiler"
-// 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
-// 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
-// )
-
-// type handleUnionSenderResult struct {
-// 	replace src.Connection
-// 	insert  []src.Connection
-// }
-
-// // desugarUnionSender handles the four cases of union senders:
-// // 1. Input::Int -> (non-chained, tag only)
-// // 2. -> Input::Int -> (chained, tag only)
-// // 3. Input::Int(foo) -> (non-chained, with value)
-// // 4. -> Input::Int(foo) -> (chained, with value)
-// func (d *Desugarer) desugarUnionSender(
-// 	union src.UnionSender,
-// 	normConn src.NormalConnection,
-// 	nodesToInsert map[string]src.Node,
-// 	constsToInsert map[string]src.Const,
-// ) (handleUnionSenderResult, error) {
-// 	if union.Data == nil {
-// 		// cases 1 & 2: tag only
-// 		return d.handleTagOnlyUnionSender(union, normConn, nodesToInsert, constsToInsert)
-// 	}
-// 	// cases 3 & 4: with value
-// 	return d.handleValueUnionSender(union, normConn, nodesToInsert, constsToInsert)
-// }
-
-// // handleTagOnlyUnionSender handles cases 1 & 2 (tag-only union senders)
-// func (d *Desugarer) handleTagOnlyUnionSender(
-// 	union src.UnionSender,
-// 	normConn src.NormalConnection,
-// 	nodesToInsert map[string]src.Node,
-// 	constsToInsert map[string]src.Const,
-// ) (handleUnionSenderResult, error) {
-// 	// create virtual const of type union with specified tag
-// 	d.virtualConstCount++
-// 	constName := fmt.Sprintf("__union_const__%d", d.virtualConstCount)
-
-// 	// create const with union type and tag
-// 	constsToInsert[constName] = src.Const{
-// 		Value: src.ConstValue{
-// 			Message: &src.MsgLiteral{
-// 				Union: &src.UnionLiteral{
-// 					EntityRef: union.EntityRef,
-// 					Tag:       union.Tag,
-// 				},
-// 			},
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	// create new node and bind const to it
-// 	constNodeName := fmt.Sprintf("__new__%d", d.virtualConstCount)
-// 	nodesToInsert[constNodeName] = src.Node{
-// 		EntityRef: core.EntityRef{
-// 			Pkg:  "builtin",
-// 			Name: "New",
-// 		},
-// 		Directives: map[src.Directive][]string{
-// 			compiler.BindDirective: {constName},
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	// create connection from new node to original receiver
-// 	replace := src.Connection{
-// 		Normal: &src.NormalConnection{
-// 			Senders: []src.ConnectionSender{{
-// 				PortAddr: &src.PortAddr{
-// 					Node: constNodeName,
-// 					Port: "res",
-// 				},
-// 				Meta: union.Meta,
-// 			}},
-// 			Receivers: normConn.Receivers,
-// 			Meta:      union.Meta,
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	insert := []src.Connection{
-// 		{
-// 			Normal: &src.NormalConnection{
-// 				Senders: []src.ConnectionSender{{
-// 					Const: &src.Const{
-// 						Value: src.ConstValue{
-// 							Message: &src.MsgLiteral{
-// 								Union: &src.UnionLiteral{
-// 									EntityRef: union.EntityRef,
-// 									Tag:       union.Tag,
-// 								},
-// 							},
-// 						},
-// 					},
-// 					Meta: union.Meta,
-// 				}},
-// 				Receivers: []src.ConnectionReceiver{{
-// 					PortAddr: &src.PortAddr{
-// 						Node: constNodeName,
-// 						Port: "data",
-// 					},
-// 					Meta: union.Meta,
-// 				}},
-// 				Meta: union.Meta,
-// 			},
-// 			Meta: union.Meta,
-// 		},
-// 	}
-
-// 	return handleUnionSenderResult{
-// 		replace: replace,
-// 		insert:  insert,
-// 	}, nil
-// }
-
-// // handleValueUnionSender handles cases 3 & 4 (union senders with wrapped values)
-// func (d *Desugarer) handleValueUnionSender(
-// 	union src.UnionSender,
-// 	normConn src.NormalConnection,
-// 	nodesToInsert map[string]src.Node,
-// 	constsToInsert map[string]src.Const,
-// ) (handleUnionSenderResult, error) {
-// 	// create virtual const for tag
-// 	d.virtualConstCount++
-// 	constName := fmt.Sprintf("__union_tag__%d", d.virtualConstCount)
-
-// 	constsToInsert[constName] = src.Const{
-// 		Value: src.ConstValue{
-// 			Message: &src.MsgLiteral{
-// 				Str: &union.Tag,
-// 			},
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	// create union wrapper node
-// 	nodeName := fmt.Sprintf("__union__%d", d.virtualConstCount)
-// 	nodesToInsert[nodeName] = src.Node{
-// 		EntityRef: core.EntityRef{
-// 			Pkg:  "builtin",
-// 			Name: "UnionWrap",
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	// create connections for the union wrapper
-// 	replace := src.Connection{
-// 		Normal: &src.NormalConnection{
-// 			Senders: []src.ConnectionSender{{
-// 				PortAddr: &src.PortAddr{
-// 					Node: nodeName,
-// 					Port: "res",
-// 				},
-// 				Meta: union.Meta,
-// 			}},
-// 			Receivers: normConn.Receivers,
-// 			Meta:      union.Meta,
-// 		},
-// 		Meta: union.Meta,
-// 	}
-
-// 	insert := []src.Connection{
-// 		{
-// 			Normal: &src.NormalConnection{
-// 				Senders: []src.ConnectionSender{{
-// 					Const: &src.Const{
-// 						Value: src.ConstValue{
-// 							Message: &src.MsgLiteral{
-// 								Str: &union.Tag,
-// 							},
-// 						},
-// 					},
-// 					Meta: union.Meta,
-// 				}},
-// 				Receivers: []src.ConnectionReceiver{{
-// 					PortAddr: &src.PortAddr{
-// 						Node: nodeName,
-// 						Port: "tag",
-// 					},
-// 					Meta: union.Meta,
-// 				}},
-// 				Meta: union.Meta,
-// 			},
-// 			Meta: union.Meta,
-// 		},
-// 		{
-// 			Normal: &src.NormalConnection{
-// 				Senders: []src.ConnectionSender{*union.Data},
-// 				Receivers: []src.ConnectionReceiver{{
-// 					PortAddr: &src.PortAddr{
-// 						Node: nodeName,
-// 						Port: "data",
-// 					},
-// 					Meta: union.Meta,
-// 				}},
-// 				Meta: union.Meta,
-// 			},
-// 			Meta: union.Meta,
-// 		},
-// 	}
-
-// 	return handleUnionSenderResult{
-// 		replace: replace,
-// 		insert:  insert,
-// 	}, nil
-// }
+import (
+	"fmt"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+)
+
+type handleUnionSenderResult struct {
+	replace src.Connection
+	insert  []src.Connection
+}
+
+var intConstTypeExpr = ts.Expr{
+	Inst: &ts.InstExpr{
+		Ref: core.EntityRef{Pkg: "builtin", Name: "int"},
+	},
+}
+
+// desugarUnionSender turns `Input::Int(:x)` into UnionWrap node configured with tag index.
+// Union literals with constant payload are not handled here, they are regular constants.
+func (d *Desugarer) desugarUnionSender(
+	iface src.Interface,
+	union src.Union,
+	normConn src.NormalConnection,
+	nodesToInsert map[string]src.Node,
+	constsToInsert map[string]src.Const,
+	usedNodeOutports nodeOutportsUsed,
+	scope Scope,
+	nodes map[string]src.Node,
+) (handleUnionSenderResult, error) {
+	locOnlyMeta := core.Meta{
+		Location: union.Meta.Location,
+	}
+
+	tagIdx, err := d.getUnionTagIdx(union)
+	if err != nil {
+		return handleUnionSenderResult{}, err
+	}
+
+	d.virtualConstCount++
+	constName := fmt.Sprintf("__const__%d", d.virtualConstCount)
+
+	d.unionCounter++
+	unionNodeName := fmt.Sprintf("__union__%d", d.unionCounter)
+
+	nodesToInsert[unionNodeName] = src.Node{
+		Directives: map[src.Directive][]string{
+			compiler.BindDirective: {constName},
+		},
+		EntityRef: core.EntityRef{
+			Pkg:  "builtin",
+			Name: "UnionWrap",
+			Meta: locOnlyMeta,
+		},
+		TypeArgs: []ts.Expr{union.AnalyzedType},
+		Meta:     locOnlyMeta,
+	}
+
+	constsToInsert[constName] = d.createUnionTagCfgMsg(tagIdx, locOnlyMeta)
+
+	// data -> union:data
+	desugarDataRes, err := d.desugarConnection(
+		iface,
+		src.Connection{
+			Normal: &src.NormalConnection{
+				Senders: []src.ConnectionSender{*union.Data},
+				Receivers: []src.ConnectionReceiver{
+					{
+						PortAddr: &src.PortAddr{
+							Node: unionNodeName,
+							Port: "data",
+							Meta: locOnlyMeta,
+						},
+						Meta: locOnlyMeta,
+					},
+				},
+				Meta: locOnlyMeta,
+			},
+		},
+		usedNodeOutports,
+		scope,
+		nodes,
+		nodesToInsert,
+		constsToInsert,
+	)
+	if err != nil {
+		return handleUnionSenderResult{}, err
+	}
+
+	insert := append([]src.Connection{*desugarDataRes.replace}, desugarDataRes.insert...)
+
+	// union:res -> XXX
+	replace := src.Connection{
+		Normal: &src.NormalConnection{
+			Senders: []src.ConnectionSender{
+				{
+					PortAddr: &src.PortAddr{
+						Node: unionNodeName,
+						Port: "res",
+						Meta: locOnlyMeta,
+					},
+					Meta: locOnlyMeta,
+				},
+			},
+			Receivers: normConn.Receivers, // desugaring of original receivers is job of caller
+			Meta:      locOnlyMeta,
+		},
+		Meta: locOnlyMeta,
+	}
+
+	return handleUnionSenderResult{
+		replace: replace,
+		insert:  insert,
+	}, nil
+}
+
+// getUnionTagIdx returns index of the tag in analyzed union type, it's runtime representation of the tag.
+func (Desugarer) getUnionTagIdx(union src.Union) (int, error) {
+	if union.AnalyzedType.Lit == nil || union.AnalyzedType.Lit.Tagged == nil {
+		return 0, fmt.Errorf("union sender without analyzed type: %v", union)
+	}
+	idx, _, ok := union.AnalyzedType.Lit.Tag(union.Tag)
+	if !ok {
+		return 0, fmt.Errorf("union tag not found: %v", union)
+	}
+	return idx, nil
+}
+
+func (Desugarer) createUnionTagCfgMsg(tagIdx int, meta core.Meta) src.Const {
+	return src.Const{
+		TypeExpr: intConstTypeExpr,
+		Value: src.ConstValue{
+			Message: &src.MsgLiteral{
+				Int:  compiler.Pointer(tagIdx),
+				Meta: meta,
+			},
+		},
+		Meta: meta,
+	}
+}
@@ -33,6 +33,20 @@ func GraphReduction(connections map[PortAddr]PortAddr) map[PortAddr]PortAddr {
 	return result
 }
 
+// ReduceBuffers moves buffer sizes of intermediate receivers to the final ones,
+// so they can be used with the reduced graph. If several buffered receivers lead
+// to the same final receiver, the biggest buffer is used.
+func ReduceBuffers(connections map[PortAddr]PortAddr, buffers map[PortAddr]int) map[PortAddr]int {
+	result := make(map[PortAddr]int, len(buffers))
+	for receiver, size := range buffers {
+		final, _ := getFinalReceiver(receiver, connections)
+		if existing, ok := result[final]; !ok || size > existing {
+			result[final] = size
+		}
+	}
+	return result
+}
+
 // getFinalReceiver returns the final receiver for a given port address.
 // It also returns true if the given port address was intermediate, false otherwise.
 func getFinalReceiver(
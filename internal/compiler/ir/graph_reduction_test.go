@@ -90,3 +90,23 @@ func Test_GraphReduction(t *testing.T) {
 		})
 	}
 }
+
+func Test_ReduceBuffers(t *testing.T) {
+	// a:foo -> b:bar; b:bar -> c:baz; x:foo -> c:qux
+	connections := map[PortAddr]PortAddr{
+		{Path: "a", Port: "foo"}: {Path: "b", Port: "bar"},
+		{Path: "b", Port: "bar"}: {Path: "c", Port: "baz"},
+		{Path: "x", Port: "foo"}: {Path: "c", Port: "qux"},
+	}
+
+	buffers := map[PortAddr]int{
+		{Path: "b", Port: "bar"}: 8,  // intermediate
+		{Path: "c", Port: "baz"}: 4,  // final, smaller than intermediate
+		{Path: "c", Port: "qux"}: 16, // final
+	}
+
+	assert.Equal(t, map[PortAddr]int{
+		{Path: "c", Port: "baz"}: 8,
+		{Path: "c", Port: "qux"}: 16,
+	}, ReduceBuffers(connections, buffers))
+}
@@ -6,6 +6,16 @@ import "fmt"
 type Program struct {
 	Connections map[PortAddr]PortAddr `json:"connections,omitempty"`
 	Funcs       []FuncCall            `json:"funcs,omitempty"`
+	GoPackages  []GoPackage           `json:"goPackages,omitempty"` // Runtime functions implemented by modules.
+	Buffers     map[PortAddr]int      `json:"buffers,omitempty"`    // Buffer sizes of connections by their receivers.
+	ChanBuffer  int                   `json:"chanBuffer,omitempty"` // Buffer size of connections not listed in Buffers.
+	ExitCode    bool                  `json:"exitCode,omitempty"`   // Message from Main's stop outport is the exit code.
+}
+
+// GoPackage is a source code of runtime functions implemented by one of the program's modules.
+type GoPackage struct {
+	Path  string            `json:"path,omitempty"`  // Unique path of the package within the program.
+	Files map[string][]byte `json:"files,omitempty"` // File names mapped to their content.
 }
 
 // PortAddr is a composite unique identifier for a port.
@@ -45,6 +55,13 @@ type Message struct {
 	String       string             `json:"str,omitempty"`
 	List         []Message          `json:"list,omitempty"`
 	DictOrStruct map[string]Message `json:"map,omitempty"`
+	Union        *UnionMessage      `json:"union,omitempty"`
+}
+
+// UnionMessage is a tagged union value, tag is index of the variant in the union type.
+type UnionMessage struct {
+	Tag  uint8    `json:"tag"`
+	Data *Message `json:"data,omitempty"` // nil for tags without payload
 }
 
 // MsgType is an enumeration of message types.
@@ -58,4 +75,5 @@ const (
 	MsgTypeList   MsgType = "list"
 	MsgTypeDict   MsgType = "dict"
 	MsgTypeStruct MsgType = "struct"
+	MsgTypeUnion  MsgType = "union"
 )
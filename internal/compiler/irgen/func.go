@@ -2,6 +2,8 @@ package irgen
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/nevalang/neva/internal/compiler"
@@ -54,3 +56,31 @@ func getConfigMsg(node src.Node, scope src.Scope) (*ir.Message, error) {
 		entity.Const.TypeExpr,
 	)
 }
+
+// getBufferSize returns size of the buffer for connections to node's inports.
+func getBufferSize(node src.Node, scope src.Scope) (int, bool, error) {
+	args, ok := node.Directives[compiler.BufferDirective]
+	if !ok {
+		return 0, false, nil
+	}
+
+	if size, err := strconv.Atoi(args[0]); err == nil {
+		return size, true, nil
+	}
+
+	ref := compiler.ParseEntityRef(args[0])
+	for {
+		entity, location, err := scope.Entity(ref)
+		if err != nil {
+			return 0, false, err
+		}
+		if entity.Const.Value.Ref == nil {
+			if entity.Const.Value.Message == nil || entity.Const.Value.Message.Int == nil {
+				return 0, false, fmt.Errorf("buffer size must be int: %v", ref)
+			}
+			return *entity.Const.Value.Message.Int, true, nil
+		}
+		ref = *entity.Const.Value.Ref
+		scope = scope.Relocate(location)
+	}
+}
@@ -2,6 +2,9 @@ package irgen
 
 import (
 	"fmt"
+	"path"
+	"sort"
+	"strings"
 
 	"github.com/nevalang/neva/internal/compiler/ir"
 	src "github.com/nevalang/neva/internal/compiler/sourcecode"
@@ -60,20 +63,87 @@ func (g Generator) Generate(
 	result := &ir.Program{
 		Connections: map[ir.PortAddr]ir.PortAddr{},
 		Funcs:       []ir.FuncCall{},
+		Buffers:     map[ir.PortAddr]int{},
 	}
 
-	g.processNode(
-		rootNodeCtx,
-		src.NewScope(build, loc),
-		result,
-	)
+	scope := src.NewScope(build, loc)
+
+	g.processNode(rootNodeCtx, scope, result)
+
+	exitCode, err := g.isExitCodeStop(scope)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ir.Program{
 		Connections: result.Connections,
 		Funcs:       result.Funcs,
+		GoPackages:  g.getGoPackages(build),
+		Buffers:     result.Buffers,
+		ExitCode:    exitCode,
 	}, nil
 }
 
+// isExitCodeStop returns true if Main's stop outport is of type int, so its message is the exit code.
+func (Generator) isExitCodeStop(scope src.Scope) (bool, error) {
+	entity, _, err := scope.Entity(core.EntityRef{Name: "Main"})
+	if err != nil {
+		return false, err
+	}
+
+	stop, ok := entity.Component.Interface.IO.Out["stop"]
+	if !ok || stop.TypeExpr.Inst == nil {
+		return false, nil
+	}
+
+	ref := stop.TypeExpr.Inst.Ref
+	return ref.Name == "int" && (ref.Pkg == "" || ref.Pkg == "builtin"), nil
+}
+
+// getGoPackages collects Go packages of all modules so backend can link them with the runtime.
+func (Generator) getGoPackages(build src.Build) []ir.GoPackage {
+	var result []ir.GoPackage
+
+	for modRef, mod := range build.Modules {
+		for pkgPath, goPkg := range mod.GoPackages {
+			result = append(result, ir.GoPackage{
+				Path:  path.Join(goModulePath(modRef), pkgPath),
+				Files: goPkg.Files,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// goModulePath turns module reference into a valid Go import path segment.
+func goModulePath(modRef core.ModuleRef) string {
+	if modRef.Path == "@" {
+		return "entry"
+	}
+
+	s := modRef.Path
+	if modRef.Version != "" {
+		s += "_" + modRef.Version
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '/':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	return b.String()
+}
+
 func (g Generator) processNode(
 	nodeCtx nodeContext,
 	scope src.Scope,
@@ -90,6 +160,16 @@ func (g Generator) processNode(
 	inportAddrs := g.insertAndReturnInports(nodeCtx)   // for inports we only use parent context because all inports are used
 	outportAddrs := g.insertAndReturnOutports(nodeCtx) //  for outports we use both parent context and component's interface
 
+	bufferSize, isBuffered, err := getBufferSize(nodeCtx.node, scope)
+	if err != nil {
+		panic(err)
+	}
+	if isBuffered {
+		for _, addr := range inportAddrs {
+			result.Buffers[addr] = bufferSize
+		}
+	}
+
 	runtimeFuncRef, err := g.getFuncRef(component, nodeCtx.node.TypeArgs)
 	if err != nil {
 		panic(err)
@@ -64,37 +64,59 @@ func getIRMsgBySrcRef(
 			Type: ir.MsgTypeList,
 			List: listMsg,
 		}, nil
-	case constant.Message.DictOrStruct != nil:
-		m := make(map[string]ir.Message, len(constant.Message.DictOrStruct))
+	case constant.Message.Dict != nil:
+		dictElType := typeExpr.Inst.Args[0]
+		dictMsg := make(map[string]ir.Message, len(constant.Message.Dict))
 
-		isStruct := typeExpr.Lit != nil && typeExpr.Lit.Struct != nil
-
-		for name, el := range constant.Message.DictOrStruct {
-			var elType ts.Expr
-			if isStruct {
-				elType = typeExpr.Lit.Struct[name]
-			} else {
-				elType = typeExpr.Inst.Args[0]
+		for key, el := range constant.Message.Dict {
+			result, err := getIRMsgBySrcRef(el, scope, dictElType)
+			if err != nil {
+				return nil, err
 			}
+			dictMsg[key] = *result
+		}
 
-			result, err := getIRMsgBySrcRef(el, scope, elType)
+		return &ir.Message{
+			Type:         ir.MsgTypeDict,
+			DictOrStruct: dictMsg,
+		}, nil
+	case constant.Message.Struct != nil:
+		structMsg := make(map[string]ir.Message, len(constant.Message.Struct))
+
+		for name, el := range constant.Message.Struct {
+			result, err := getIRMsgBySrcRef(el, scope, typeExpr.Lit.Struct[name])
 			if err != nil {
 				return nil, err
 			}
+			structMsg[name] = *result
+		}
 
-			m[name] = *result
+		return &ir.Message{
+			Type:         ir.MsgTypeStruct,
+			DictOrStruct: structMsg,
+		}, nil
+	case constant.Message.Union != nil:
+		if typeExpr.Lit == nil || typeExpr.Lit.Tagged == nil {
+			return nil, fmt.Errorf("union literal of non-union type: %v", typeExpr)
+		}
+
+		tagIdx, payloadType, ok := typeExpr.Lit.Tag(constant.Message.Union.Tag)
+		if !ok {
+			return nil, fmt.Errorf("union tag not found: %v", constant.Message.Union.Tag)
 		}
 
-		var irType ir.MsgType
-		if isStruct {
-			irType = ir.MsgTypeStruct
-		} else {
-			irType = ir.MsgTypeDict
+		unionMsg := &ir.UnionMessage{Tag: uint8(tagIdx)}
+		if constant.Message.Union.Data != nil {
+			data, err := getIRMsgBySrcRef(*constant.Message.Union.Data, scope, *payloadType)
+			if err != nil {
+				return nil, err
+			}
+			unionMsg.Data = data
 		}
 
 		return &ir.Message{
-			Type:         irType,
-			DictOrStruct: m,
+			Type:  ir.MsgTypeUnion,
+			Union: unionMsg,
 		}, nil
 	}
 
@@ -182,6 +182,12 @@ func (s *BasenevaListener) EnterTypeArgs(ctx *TypeArgsContext) {}
 // ExitTypeArgs is called when production typeArgs is exited.
 func (s *BasenevaListener) ExitTypeArgs(ctx *TypeArgsContext) {}
 
+// EnterOpenTypeExpr is called when production openTypeExpr is entered.
+func (s *BasenevaListener) EnterOpenTypeExpr(ctx *OpenTypeExprContext) {}
+
+// ExitOpenTypeExpr is called when production openTypeExpr is exited.
+func (s *BasenevaListener) ExitOpenTypeExpr(ctx *OpenTypeExprContext) {}
+
 // EnterTypeLitExpr is called when production typeLitExpr is entered.
 func (s *BasenevaListener) EnterTypeLitExpr(ctx *TypeLitExprContext) {}
 
@@ -212,6 +218,24 @@ func (s *BasenevaListener) EnterStructField(ctx *StructFieldContext) {}
 // ExitStructField is called when production structField is exited.
 func (s *BasenevaListener) ExitStructField(ctx *StructFieldContext) {}
 
+// EnterTaggedUnionTypeExpr is called when production taggedUnionTypeExpr is entered.
+func (s *BasenevaListener) EnterTaggedUnionTypeExpr(ctx *TaggedUnionTypeExprContext) {}
+
+// ExitTaggedUnionTypeExpr is called when production taggedUnionTypeExpr is exited.
+func (s *BasenevaListener) ExitTaggedUnionTypeExpr(ctx *TaggedUnionTypeExprContext) {}
+
+// EnterUnionTags is called when production unionTags is entered.
+func (s *BasenevaListener) EnterUnionTags(ctx *UnionTagsContext) {}
+
+// ExitUnionTags is called when production unionTags is exited.
+func (s *BasenevaListener) ExitUnionTags(ctx *UnionTagsContext) {}
+
+// EnterUnionTag is called when production unionTag is entered.
+func (s *BasenevaListener) EnterUnionTag(ctx *UnionTagContext) {}
+
+// ExitUnionTag is called when production unionTag is exited.
+func (s *BasenevaListener) ExitUnionTag(ctx *UnionTagContext) {}
+
 // EnterUnionTypeExpr is called when production unionTypeExpr is entered.
 func (s *BasenevaListener) EnterUnionTypeExpr(ctx *UnionTypeExprContext) {}
 
@@ -284,6 +308,18 @@ func (s *BasenevaListener) EnterConstDef(ctx *ConstDefContext) {}
 // ExitConstDef is called when production constDef is exited.
 func (s *BasenevaListener) ExitConstDef(ctx *ConstDefContext) {}
 
+// EnterConstExpr is called when production constExpr is entered.
+func (s *BasenevaListener) EnterConstExpr(ctx *ConstExprContext) {}
+
+// ExitConstExpr is called when production constExpr is exited.
+func (s *BasenevaListener) ExitConstExpr(ctx *ConstExprContext) {}
+
+// EnterConstOperand is called when production constOperand is entered.
+func (s *BasenevaListener) EnterConstOperand(ctx *ConstOperandContext) {}
+
+// ExitConstOperand is called when production constOperand is exited.
+func (s *BasenevaListener) ExitConstOperand(ctx *ConstOperandContext) {}
+
 // EnterConstLit is called when production constLit is entered.
 func (s *BasenevaListener) EnterConstLit(ctx *ConstLitContext) {}
 
@@ -398,6 +434,12 @@ func (s *BasenevaListener) EnterNodeDIArgs(ctx *NodeDIArgsContext) {}
 // ExitNodeDIArgs is called when production nodeDIArgs is exited.
 func (s *BasenevaListener) ExitNodeDIArgs(ctx *NodeDIArgsContext) {}
 
+// EnterAnonCompDef is called when production anonCompDef is entered.
+func (s *BasenevaListener) EnterAnonCompDef(ctx *AnonCompDefContext) {}
+
+// ExitAnonCompDef is called when production anonCompDef is exited.
+func (s *BasenevaListener) ExitAnonCompDef(ctx *AnonCompDefContext) {}
+
 // EnterConnDefList is called when production connDefList is entered.
 func (s *BasenevaListener) EnterConnDefList(ctx *ConnDefListContext) {}
 
@@ -583,3 +625,15 @@ func (s *BasenevaListener) EnterDefaultCase(ctx *DefaultCaseContext) {}
 
 // ExitDefaultCase is called when production defaultCase is exited.
 func (s *BasenevaListener) ExitDefaultCase(ctx *DefaultCaseContext) {}
+
+// EnterListSenderLit is called when production listSenderLit is entered.
+func (s *BasenevaListener) EnterListSenderLit(ctx *ListSenderLitContext) {}
+
+// ExitListSenderLit is called when production listSenderLit is exited.
+func (s *BasenevaListener) ExitListSenderLit(ctx *ListSenderLitContext) {}
+
+// EnterUnionSender is called when production unionSender is entered.
+func (s *BasenevaListener) EnterUnionSender(ctx *UnionSenderContext) {}
+
+// ExitUnionSender is called when production unionSender is exited.
+func (s *BasenevaListener) ExitUnionSender(ctx *UnionSenderContext) {}
@@ -44,18 +44,19 @@ func nevalexerLexerInit() {
 	}
 	staticData.LiteralNames = []string{
 		"", "'#'", "'('", "','", "')'", "'import'", "'{'", "'}'", "':'", "'@'",
-		"'/'", "'.'", "'type'", "'<'", "'>'", "'enum'", "'struct'", "'|'", "'interface'",
-		"'['", "']'", "'const'", "'='", "'true'", "'false'", "'::'", "'def'",
-		"'---'", "'?'", "'->'", "'=>'", "'!'", "'++'", "'--'", "'+'", "'*'",
-		"'%'", "'**'", "'=='", "'!='", "'>='", "'<='", "'&&'", "'||'", "'&'",
-		"'^'", "'$'", "'..'", "'switch'", "'_'", "", "'pub'", "", "", "'-'",
+		"'/'", "'.'", "'type'", "'<'", "'>'", "'>>'", "'|'", "'enum'", "'struct'",
+		"'union'", "'interface'", "'['", "']'", "'const'", "'='", "'true'",
+		"'false'", "'::'", "'def'", "'---'", "'?'", "'->'", "'=>'", "'!'", "'++'",
+		"'--'", "'+'", "'*'", "'%'", "'**'", "'=='", "'!='", "'>='", "'<='",
+		"'&&'", "'||'", "'&'", "'^'", "'<<'", "'$'", "'..'", "'switch'", "'_'",
+		"", "'pub'", "", "", "'-'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "COMMENT",
-		"PUB_KW", "IDENTIFIER", "INT", "MINUS", "FLOAT", "STRING", "NEWLINE",
-		"WS",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "COMMENT", "PUB_KW", "IDENTIFIER", "INT", "MINUS", "FLOAT",
+		"STRING", "NEWLINE", "WS",
 	}
 	staticData.RuleNames = []string{
 		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
@@ -64,12 +65,12 @@ func nevalexerLexerInit() {
 		"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
 		"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
 		"T__41", "T__42", "T__43", "T__44", "T__45", "T__46", "T__47", "T__48",
-		"COMMENT", "PUB_KW", "IDENTIFIER", "LETTER", "INT", "MINUS", "FLOAT",
-		"STRING", "NEWLINE", "WS",
+		"T__49", "T__50", "T__51", "COMMENT", "PUB_KW", "IDENTIFIER", "LETTER",
+		"INT", "MINUS", "FLOAT", "STRING", "NEWLINE", "WS",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 58, 337, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 0, 61, 376, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
 		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
 		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
 		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
@@ -80,141 +81,160 @@ func nevalexerLexerInit() {
 		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
 		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
 		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1,
-		4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1,
-		7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1,
-		11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15,
-		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 19,
-		1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22, 1,
-		22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24,
-		1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1,
-		27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31,
-		1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1,
-		35, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39,
-		1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1,
-		42, 1, 43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 47,
-		1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1,
-		49, 1, 49, 5, 49, 279, 8, 49, 10, 49, 12, 49, 282, 9, 49, 1, 50, 1, 50,
-		1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 5, 51, 291, 8, 51, 10, 51, 12, 51, 294,
-		9, 51, 1, 52, 1, 52, 1, 53, 4, 53, 299, 8, 53, 11, 53, 12, 53, 300, 1,
-		54, 1, 54, 1, 55, 5, 55, 306, 8, 55, 10, 55, 12, 55, 309, 9, 55, 1, 55,
-		1, 55, 4, 55, 313, 8, 55, 11, 55, 12, 55, 314, 1, 56, 1, 56, 5, 56, 319,
-		8, 56, 10, 56, 12, 56, 322, 9, 56, 1, 56, 1, 56, 1, 57, 3, 57, 327, 8,
-		57, 1, 57, 1, 57, 1, 58, 4, 58, 332, 8, 58, 11, 58, 12, 58, 333, 1, 58,
-		1, 58, 1, 320, 0, 59, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8,
-		17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17,
+		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 1, 0, 1,
+		0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1,
+		4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1,
+		10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
+		19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
+		1, 22, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1,
+		25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 31, 1,
+		31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 35,
+		1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1,
+		39, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43,
+		1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1,
+		47, 1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 5, 52, 297,
+		8, 52, 10, 52, 12, 52, 300, 9, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1,
+		54, 1, 54, 5, 54, 309, 8, 54, 10, 54, 12, 54, 312, 9, 54, 1, 55, 1, 55,
+		1, 56, 4, 56, 317, 8, 56, 11, 56, 12, 56, 318, 1, 57, 1, 57, 1, 58, 5,
+		58, 324, 8, 58, 10, 58, 12, 58, 327, 9, 58, 1, 58, 1, 58, 4, 58, 331, 8,
+		58, 11, 58, 12, 58, 332, 1, 59, 1, 59, 1, 59, 1, 59, 5, 59, 339, 8, 59,
+		10, 59, 12, 59, 342, 9, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 5, 59, 349,
+		8, 59, 10, 59, 12, 59, 352, 9, 59, 1, 59, 1, 59, 1, 59, 5, 59, 357, 8,
+		59, 10, 59, 12, 59, 360, 9, 59, 1, 59, 3, 59, 363, 8, 59, 1, 60, 3, 60,
+		366, 8, 60, 1, 60, 1, 60, 1, 61, 4, 61, 371, 8, 61, 11, 61, 12, 61, 372,
+		1, 61, 1, 61, 0, 0, 62, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15,
+		8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17,
 		35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26,
 		53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35,
 		71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44,
 		89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105,
-		0, 107, 53, 109, 54, 111, 55, 113, 56, 115, 57, 117, 58, 1, 0, 4, 2, 0,
-		10, 10, 13, 13, 3, 0, 65, 90, 95, 95, 97, 122, 1, 0, 48, 57, 2, 0, 9, 9,
-		32, 32, 344, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7,
-		1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0,
-		15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0,
-		0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0,
-		0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0,
-		0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1,
-		0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53,
-		1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0,
-		61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0,
-		0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0,
-		0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0,
-		0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1,
-		0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99,
-		1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0,
-		0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1,
-		0, 0, 0, 0, 117, 1, 0, 0, 0, 1, 119, 1, 0, 0, 0, 3, 121, 1, 0, 0, 0, 5,
-		123, 1, 0, 0, 0, 7, 125, 1, 0, 0, 0, 9, 127, 1, 0, 0, 0, 11, 134, 1, 0,
-		0, 0, 13, 136, 1, 0, 0, 0, 15, 138, 1, 0, 0, 0, 17, 140, 1, 0, 0, 0, 19,
-		142, 1, 0, 0, 0, 21, 144, 1, 0, 0, 0, 23, 146, 1, 0, 0, 0, 25, 151, 1,
-		0, 0, 0, 27, 153, 1, 0, 0, 0, 29, 155, 1, 0, 0, 0, 31, 160, 1, 0, 0, 0,
-		33, 167, 1, 0, 0, 0, 35, 169, 1, 0, 0, 0, 37, 179, 1, 0, 0, 0, 39, 181,
-		1, 0, 0, 0, 41, 183, 1, 0, 0, 0, 43, 189, 1, 0, 0, 0, 45, 191, 1, 0, 0,
-		0, 47, 196, 1, 0, 0, 0, 49, 202, 1, 0, 0, 0, 51, 205, 1, 0, 0, 0, 53, 209,
-		1, 0, 0, 0, 55, 213, 1, 0, 0, 0, 57, 215, 1, 0, 0, 0, 59, 218, 1, 0, 0,
-		0, 61, 221, 1, 0, 0, 0, 63, 223, 1, 0, 0, 0, 65, 226, 1, 0, 0, 0, 67, 229,
-		1, 0, 0, 0, 69, 231, 1, 0, 0, 0, 71, 233, 1, 0, 0, 0, 73, 235, 1, 0, 0,
-		0, 75, 238, 1, 0, 0, 0, 77, 241, 1, 0, 0, 0, 79, 244, 1, 0, 0, 0, 81, 247,
-		1, 0, 0, 0, 83, 250, 1, 0, 0, 0, 85, 253, 1, 0, 0, 0, 87, 256, 1, 0, 0,
-		0, 89, 258, 1, 0, 0, 0, 91, 260, 1, 0, 0, 0, 93, 262, 1, 0, 0, 0, 95, 265,
-		1, 0, 0, 0, 97, 272, 1, 0, 0, 0, 99, 274, 1, 0, 0, 0, 101, 283, 1, 0, 0,
-		0, 103, 287, 1, 0, 0, 0, 105, 295, 1, 0, 0, 0, 107, 298, 1, 0, 0, 0, 109,
-		302, 1, 0, 0, 0, 111, 307, 1, 0, 0, 0, 113, 316, 1, 0, 0, 0, 115, 326,
-		1, 0, 0, 0, 117, 331, 1, 0, 0, 0, 119, 120, 5, 35, 0, 0, 120, 2, 1, 0,
-		0, 0, 121, 122, 5, 40, 0, 0, 122, 4, 1, 0, 0, 0, 123, 124, 5, 44, 0, 0,
-		124, 6, 1, 0, 0, 0, 125, 126, 5, 41, 0, 0, 126, 8, 1, 0, 0, 0, 127, 128,
-		5, 105, 0, 0, 128, 129, 5, 109, 0, 0, 129, 130, 5, 112, 0, 0, 130, 131,
-		5, 111, 0, 0, 131, 132, 5, 114, 0, 0, 132, 133, 5, 116, 0, 0, 133, 10,
-		1, 0, 0, 0, 134, 135, 5, 123, 0, 0, 135, 12, 1, 0, 0, 0, 136, 137, 5, 125,
-		0, 0, 137, 14, 1, 0, 0, 0, 138, 139, 5, 58, 0, 0, 139, 16, 1, 0, 0, 0,
-		140, 141, 5, 64, 0, 0, 141, 18, 1, 0, 0, 0, 142, 143, 5, 47, 0, 0, 143,
-		20, 1, 0, 0, 0, 144, 145, 5, 46, 0, 0, 145, 22, 1, 0, 0, 0, 146, 147, 5,
-		116, 0, 0, 147, 148, 5, 121, 0, 0, 148, 149, 5, 112, 0, 0, 149, 150, 5,
-		101, 0, 0, 150, 24, 1, 0, 0, 0, 151, 152, 5, 60, 0, 0, 152, 26, 1, 0, 0,
-		0, 153, 154, 5, 62, 0, 0, 154, 28, 1, 0, 0, 0, 155, 156, 5, 101, 0, 0,
-		156, 157, 5, 110, 0, 0, 157, 158, 5, 117, 0, 0, 158, 159, 5, 109, 0, 0,
-		159, 30, 1, 0, 0, 0, 160, 161, 5, 115, 0, 0, 161, 162, 5, 116, 0, 0, 162,
-		163, 5, 114, 0, 0, 163, 164, 5, 117, 0, 0, 164, 165, 5, 99, 0, 0, 165,
-		166, 5, 116, 0, 0, 166, 32, 1, 0, 0, 0, 167, 168, 5, 124, 0, 0, 168, 34,
-		1, 0, 0, 0, 169, 170, 5, 105, 0, 0, 170, 171, 5, 110, 0, 0, 171, 172, 5,
-		116, 0, 0, 172, 173, 5, 101, 0, 0, 173, 174, 5, 114, 0, 0, 174, 175, 5,
-		102, 0, 0, 175, 176, 5, 97, 0, 0, 176, 177, 5, 99, 0, 0, 177, 178, 5, 101,
-		0, 0, 178, 36, 1, 0, 0, 0, 179, 180, 5, 91, 0, 0, 180, 38, 1, 0, 0, 0,
-		181, 182, 5, 93, 0, 0, 182, 40, 1, 0, 0, 0, 183, 184, 5, 99, 0, 0, 184,
-		185, 5, 111, 0, 0, 185, 186, 5, 110, 0, 0, 186, 187, 5, 115, 0, 0, 187,
-		188, 5, 116, 0, 0, 188, 42, 1, 0, 0, 0, 189, 190, 5, 61, 0, 0, 190, 44,
-		1, 0, 0, 0, 191, 192, 5, 116, 0, 0, 192, 193, 5, 114, 0, 0, 193, 194, 5,
-		117, 0, 0, 194, 195, 5, 101, 0, 0, 195, 46, 1, 0, 0, 0, 196, 197, 5, 102,
-		0, 0, 197, 198, 5, 97, 0, 0, 198, 199, 5, 108, 0, 0, 199, 200, 5, 115,
-		0, 0, 200, 201, 5, 101, 0, 0, 201, 48, 1, 0, 0, 0, 202, 203, 5, 58, 0,
-		0, 203, 204, 5, 58, 0, 0, 204, 50, 1, 0, 0, 0, 205, 206, 5, 100, 0, 0,
-		206, 207, 5, 101, 0, 0, 207, 208, 5, 102, 0, 0, 208, 52, 1, 0, 0, 0, 209,
-		210, 5, 45, 0, 0, 210, 211, 5, 45, 0, 0, 211, 212, 5, 45, 0, 0, 212, 54,
-		1, 0, 0, 0, 213, 214, 5, 63, 0, 0, 214, 56, 1, 0, 0, 0, 215, 216, 5, 45,
-		0, 0, 216, 217, 5, 62, 0, 0, 217, 58, 1, 0, 0, 0, 218, 219, 5, 61, 0, 0,
-		219, 220, 5, 62, 0, 0, 220, 60, 1, 0, 0, 0, 221, 222, 5, 33, 0, 0, 222,
-		62, 1, 0, 0, 0, 223, 224, 5, 43, 0, 0, 224, 225, 5, 43, 0, 0, 225, 64,
-		1, 0, 0, 0, 226, 227, 5, 45, 0, 0, 227, 228, 5, 45, 0, 0, 228, 66, 1, 0,
-		0, 0, 229, 230, 5, 43, 0, 0, 230, 68, 1, 0, 0, 0, 231, 232, 5, 42, 0, 0,
-		232, 70, 1, 0, 0, 0, 233, 234, 5, 37, 0, 0, 234, 72, 1, 0, 0, 0, 235, 236,
-		5, 42, 0, 0, 236, 237, 5, 42, 0, 0, 237, 74, 1, 0, 0, 0, 238, 239, 5, 61,
-		0, 0, 239, 240, 5, 61, 0, 0, 240, 76, 1, 0, 0, 0, 241, 242, 5, 33, 0, 0,
-		242, 243, 5, 61, 0, 0, 243, 78, 1, 0, 0, 0, 244, 245, 5, 62, 0, 0, 245,
-		246, 5, 61, 0, 0, 246, 80, 1, 0, 0, 0, 247, 248, 5, 60, 0, 0, 248, 249,
-		5, 61, 0, 0, 249, 82, 1, 0, 0, 0, 250, 251, 5, 38, 0, 0, 251, 252, 5, 38,
-		0, 0, 252, 84, 1, 0, 0, 0, 253, 254, 5, 124, 0, 0, 254, 255, 5, 124, 0,
-		0, 255, 86, 1, 0, 0, 0, 256, 257, 5, 38, 0, 0, 257, 88, 1, 0, 0, 0, 258,
-		259, 5, 94, 0, 0, 259, 90, 1, 0, 0, 0, 260, 261, 5, 36, 0, 0, 261, 92,
-		1, 0, 0, 0, 262, 263, 5, 46, 0, 0, 263, 264, 5, 46, 0, 0, 264, 94, 1, 0,
-		0, 0, 265, 266, 5, 115, 0, 0, 266, 267, 5, 119, 0, 0, 267, 268, 5, 105,
-		0, 0, 268, 269, 5, 116, 0, 0, 269, 270, 5, 99, 0, 0, 270, 271, 5, 104,
-		0, 0, 271, 96, 1, 0, 0, 0, 272, 273, 5, 95, 0, 0, 273, 98, 1, 0, 0, 0,
-		274, 275, 5, 47, 0, 0, 275, 276, 5, 47, 0, 0, 276, 280, 1, 0, 0, 0, 277,
-		279, 8, 0, 0, 0, 278, 277, 1, 0, 0, 0, 279, 282, 1, 0, 0, 0, 280, 278,
-		1, 0, 0, 0, 280, 281, 1, 0, 0, 0, 281, 100, 1, 0, 0, 0, 282, 280, 1, 0,
-		0, 0, 283, 284, 5, 112, 0, 0, 284, 285, 5, 117, 0, 0, 285, 286, 5, 98,
-		0, 0, 286, 102, 1, 0, 0, 0, 287, 292, 3, 105, 52, 0, 288, 291, 3, 105,
-		52, 0, 289, 291, 3, 107, 53, 0, 290, 288, 1, 0, 0, 0, 290, 289, 1, 0, 0,
-		0, 291, 294, 1, 0, 0, 0, 292, 290, 1, 0, 0, 0, 292, 293, 1, 0, 0, 0, 293,
-		104, 1, 0, 0, 0, 294, 292, 1, 0, 0, 0, 295, 296, 7, 1, 0, 0, 296, 106,
-		1, 0, 0, 0, 297, 299, 7, 2, 0, 0, 298, 297, 1, 0, 0, 0, 299, 300, 1, 0,
-		0, 0, 300, 298, 1, 0, 0, 0, 300, 301, 1, 0, 0, 0, 301, 108, 1, 0, 0, 0,
-		302, 303, 5, 45, 0, 0, 303, 110, 1, 0, 0, 0, 304, 306, 7, 2, 0, 0, 305,
-		304, 1, 0, 0, 0, 306, 309, 1, 0, 0, 0, 307, 305, 1, 0, 0, 0, 307, 308,
-		1, 0, 0, 0, 308, 310, 1, 0, 0, 0, 309, 307, 1, 0, 0, 0, 310, 312, 5, 46,
-		0, 0, 311, 313, 7, 2, 0, 0, 312, 311, 1, 0, 0, 0, 313, 314, 1, 0, 0, 0,
-		314, 312, 1, 0, 0, 0, 314, 315, 1, 0, 0, 0, 315, 112, 1, 0, 0, 0, 316,
-		320, 5, 39, 0, 0, 317, 319, 9, 0, 0, 0, 318, 317, 1, 0, 0, 0, 319, 322,
-		1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 320, 318, 1, 0, 0, 0, 321, 323, 1, 0,
-		0, 0, 322, 320, 1, 0, 0, 0, 323, 324, 5, 39, 0, 0, 324, 114, 1, 0, 0, 0,
-		325, 327, 5, 13, 0, 0, 326, 325, 1, 0, 0, 0, 326, 327, 1, 0, 0, 0, 327,
-		328, 1, 0, 0, 0, 328, 329, 5, 10, 0, 0, 329, 116, 1, 0, 0, 0, 330, 332,
-		7, 3, 0, 0, 331, 330, 1, 0, 0, 0, 332, 333, 1, 0, 0, 0, 333, 331, 1, 0,
-		0, 0, 333, 334, 1, 0, 0, 0, 334, 335, 1, 0, 0, 0, 335, 336, 6, 58, 0, 0,
-		336, 118, 1, 0, 0, 0, 10, 0, 280, 290, 292, 300, 307, 314, 320, 326, 333,
-		1, 0, 1, 0,
+		53, 107, 54, 109, 55, 111, 0, 113, 56, 115, 57, 117, 58, 119, 59, 121,
+		60, 123, 61, 1, 0, 7, 2, 0, 10, 10, 13, 13, 3, 0, 65, 90, 95, 95, 97, 122,
+		1, 0, 48, 57, 2, 0, 39, 39, 92, 92, 2, 0, 34, 34, 92, 92, 1, 0, 96, 96,
+		2, 0, 9, 9, 32, 32, 389, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0,
+		0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1,
+		0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21,
+		1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0,
+		29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0,
+		0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0,
+		0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0,
+		0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1,
+		0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67,
+		1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0,
+		75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0,
+		0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0,
+		0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0,
+		0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105,
+		1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0,
+		0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1,
+		0, 0, 0, 0, 123, 1, 0, 0, 0, 1, 125, 1, 0, 0, 0, 3, 127, 1, 0, 0, 0, 5,
+		129, 1, 0, 0, 0, 7, 131, 1, 0, 0, 0, 9, 133, 1, 0, 0, 0, 11, 140, 1, 0,
+		0, 0, 13, 142, 1, 0, 0, 0, 15, 144, 1, 0, 0, 0, 17, 146, 1, 0, 0, 0, 19,
+		148, 1, 0, 0, 0, 21, 150, 1, 0, 0, 0, 23, 152, 1, 0, 0, 0, 25, 157, 1,
+		0, 0, 0, 27, 159, 1, 0, 0, 0, 29, 161, 1, 0, 0, 0, 31, 164, 1, 0, 0, 0,
+		33, 166, 1, 0, 0, 0, 35, 171, 1, 0, 0, 0, 37, 178, 1, 0, 0, 0, 39, 184,
+		1, 0, 0, 0, 41, 194, 1, 0, 0, 0, 43, 196, 1, 0, 0, 0, 45, 198, 1, 0, 0,
+		0, 47, 204, 1, 0, 0, 0, 49, 206, 1, 0, 0, 0, 51, 211, 1, 0, 0, 0, 53, 217,
+		1, 0, 0, 0, 55, 220, 1, 0, 0, 0, 57, 224, 1, 0, 0, 0, 59, 228, 1, 0, 0,
+		0, 61, 230, 1, 0, 0, 0, 63, 233, 1, 0, 0, 0, 65, 236, 1, 0, 0, 0, 67, 238,
+		1, 0, 0, 0, 69, 241, 1, 0, 0, 0, 71, 244, 1, 0, 0, 0, 73, 246, 1, 0, 0,
+		0, 75, 248, 1, 0, 0, 0, 77, 250, 1, 0, 0, 0, 79, 253, 1, 0, 0, 0, 81, 256,
+		1, 0, 0, 0, 83, 259, 1, 0, 0, 0, 85, 262, 1, 0, 0, 0, 87, 265, 1, 0, 0,
+		0, 89, 268, 1, 0, 0, 0, 91, 271, 1, 0, 0, 0, 93, 273, 1, 0, 0, 0, 95, 275,
+		1, 0, 0, 0, 97, 278, 1, 0, 0, 0, 99, 280, 1, 0, 0, 0, 101, 283, 1, 0, 0,
+		0, 103, 290, 1, 0, 0, 0, 105, 292, 1, 0, 0, 0, 107, 301, 1, 0, 0, 0, 109,
+		305, 1, 0, 0, 0, 111, 313, 1, 0, 0, 0, 113, 316, 1, 0, 0, 0, 115, 320,
+		1, 0, 0, 0, 117, 325, 1, 0, 0, 0, 119, 362, 1, 0, 0, 0, 121, 365, 1, 0,
+		0, 0, 123, 370, 1, 0, 0, 0, 125, 126, 5, 35, 0, 0, 126, 2, 1, 0, 0, 0,
+		127, 128, 5, 40, 0, 0, 128, 4, 1, 0, 0, 0, 129, 130, 5, 44, 0, 0, 130,
+		6, 1, 0, 0, 0, 131, 132, 5, 41, 0, 0, 132, 8, 1, 0, 0, 0, 133, 134, 5,
+		105, 0, 0, 134, 135, 5, 109, 0, 0, 135, 136, 5, 112, 0, 0, 136, 137, 5,
+		111, 0, 0, 137, 138, 5, 114, 0, 0, 138, 139, 5, 116, 0, 0, 139, 10, 1,
+		0, 0, 0, 140, 141, 5, 123, 0, 0, 141, 12, 1, 0, 0, 0, 142, 143, 5, 125,
+		0, 0, 143, 14, 1, 0, 0, 0, 144, 145, 5, 58, 0, 0, 145, 16, 1, 0, 0, 0,
+		146, 147, 5, 64, 0, 0, 147, 18, 1, 0, 0, 0, 148, 149, 5, 47, 0, 0, 149,
+		20, 1, 0, 0, 0, 150, 151, 5, 46, 0, 0, 151, 22, 1, 0, 0, 0, 152, 153, 5,
+		116, 0, 0, 153, 154, 5, 121, 0, 0, 154, 155, 5, 112, 0, 0, 155, 156, 5,
+		101, 0, 0, 156, 24, 1, 0, 0, 0, 157, 158, 5, 60, 0, 0, 158, 26, 1, 0, 0,
+		0, 159, 160, 5, 62, 0, 0, 160, 28, 1, 0, 0, 0, 161, 162, 5, 62, 0, 0, 162,
+		163, 5, 62, 0, 0, 163, 30, 1, 0, 0, 0, 164, 165, 5, 124, 0, 0, 165, 32,
+		1, 0, 0, 0, 166, 167, 5, 101, 0, 0, 167, 168, 5, 110, 0, 0, 168, 169, 5,
+		117, 0, 0, 169, 170, 5, 109, 0, 0, 170, 34, 1, 0, 0, 0, 171, 172, 5, 115,
+		0, 0, 172, 173, 5, 116, 0, 0, 173, 174, 5, 114, 0, 0, 174, 175, 5, 117,
+		0, 0, 175, 176, 5, 99, 0, 0, 176, 177, 5, 116, 0, 0, 177, 36, 1, 0, 0,
+		0, 178, 179, 5, 117, 0, 0, 179, 180, 5, 110, 0, 0, 180, 181, 5, 105, 0,
+		0, 181, 182, 5, 111, 0, 0, 182, 183, 5, 110, 0, 0, 183, 38, 1, 0, 0, 0,
+		184, 185, 5, 105, 0, 0, 185, 186, 5, 110, 0, 0, 186, 187, 5, 116, 0, 0,
+		187, 188, 5, 101, 0, 0, 188, 189, 5, 114, 0, 0, 189, 190, 5, 102, 0, 0,
+		190, 191, 5, 97, 0, 0, 191, 192, 5, 99, 0, 0, 192, 193, 5, 101, 0, 0, 193,
+		40, 1, 0, 0, 0, 194, 195, 5, 91, 0, 0, 195, 42, 1, 0, 0, 0, 196, 197, 5,
+		93, 0, 0, 197, 44, 1, 0, 0, 0, 198, 199, 5, 99, 0, 0, 199, 200, 5, 111,
+		0, 0, 200, 201, 5, 110, 0, 0, 201, 202, 5, 115, 0, 0, 202, 203, 5, 116,
+		0, 0, 203, 46, 1, 0, 0, 0, 204, 205, 5, 61, 0, 0, 205, 48, 1, 0, 0, 0,
+		206, 207, 5, 116, 0, 0, 207, 208, 5, 114, 0, 0, 208, 209, 5, 117, 0, 0,
+		209, 210, 5, 101, 0, 0, 210, 50, 1, 0, 0, 0, 211, 212, 5, 102, 0, 0, 212,
+		213, 5, 97, 0, 0, 213, 214, 5, 108, 0, 0, 214, 215, 5, 115, 0, 0, 215,
+		216, 5, 101, 0, 0, 216, 52, 1, 0, 0, 0, 217, 218, 5, 58, 0, 0, 218, 219,
+		5, 58, 0, 0, 219, 54, 1, 0, 0, 0, 220, 221, 5, 100, 0, 0, 221, 222, 5,
+		101, 0, 0, 222, 223, 5, 102, 0, 0, 223, 56, 1, 0, 0, 0, 224, 225, 5, 45,
+		0, 0, 225, 226, 5, 45, 0, 0, 226, 227, 5, 45, 0, 0, 227, 58, 1, 0, 0, 0,
+		228, 229, 5, 63, 0, 0, 229, 60, 1, 0, 0, 0, 230, 231, 5, 45, 0, 0, 231,
+		232, 5, 62, 0, 0, 232, 62, 1, 0, 0, 0, 233, 234, 5, 61, 0, 0, 234, 235,
+		5, 62, 0, 0, 235, 64, 1, 0, 0, 0, 236, 237, 5, 33, 0, 0, 237, 66, 1, 0,
+		0, 0, 238, 239, 5, 43, 0, 0, 239, 240, 5, 43, 0, 0, 240, 68, 1, 0, 0, 0,
+		241, 242, 5, 45, 0, 0, 242, 243, 5, 45, 0, 0, 243, 70, 1, 0, 0, 0, 244,
+		245, 5, 43, 0, 0, 245, 72, 1, 0, 0, 0, 246, 247, 5, 42, 0, 0, 247, 74,
+		1, 0, 0, 0, 248, 249, 5, 37, 0, 0, 249, 76, 1, 0, 0, 0, 250, 251, 5, 42,
+		0, 0, 251, 252, 5, 42, 0, 0, 252, 78, 1, 0, 0, 0, 253, 254, 5, 61, 0, 0,
+		254, 255, 5, 61, 0, 0, 255, 80, 1, 0, 0, 0, 256, 257, 5, 33, 0, 0, 257,
+		258, 5, 61, 0, 0, 258, 82, 1, 0, 0, 0, 259, 260, 5, 62, 0, 0, 260, 261,
+		5, 61, 0, 0, 261, 84, 1, 0, 0, 0, 262, 263, 5, 60, 0, 0, 263, 264, 5, 61,
+		0, 0, 264, 86, 1, 0, 0, 0, 265, 266, 5, 38, 0, 0, 266, 267, 5, 38, 0, 0,
+		267, 88, 1, 0, 0, 0, 268, 269, 5, 124, 0, 0, 269, 270, 5, 124, 0, 0, 270,
+		90, 1, 0, 0, 0, 271, 272, 5, 38, 0, 0, 272, 92, 1, 0, 0, 0, 273, 274, 5,
+		94, 0, 0, 274, 94, 1, 0, 0, 0, 275, 276, 5, 60, 0, 0, 276, 277, 5, 60,
+		0, 0, 277, 96, 1, 0, 0, 0, 278, 279, 5, 36, 0, 0, 279, 98, 1, 0, 0, 0,
+		280, 281, 5, 46, 0, 0, 281, 282, 5, 46, 0, 0, 282, 100, 1, 0, 0, 0, 283,
+		284, 5, 115, 0, 0, 284, 285, 5, 119, 0, 0, 285, 286, 5, 105, 0, 0, 286,
+		287, 5, 116, 0, 0, 287, 288, 5, 99, 0, 0, 288, 289, 5, 104, 0, 0, 289,
+		102, 1, 0, 0, 0, 290, 291, 5, 95, 0, 0, 291, 104, 1, 0, 0, 0, 292, 293,
+		5, 47, 0, 0, 293, 294, 5, 47, 0, 0, 294, 298, 1, 0, 0, 0, 295, 297, 8,
+		0, 0, 0, 296, 295, 1, 0, 0, 0, 297, 300, 1, 0, 0, 0, 298, 296, 1, 0, 0,
+		0, 298, 299, 1, 0, 0, 0, 299, 106, 1, 0, 0, 0, 300, 298, 1, 0, 0, 0, 301,
+		302, 5, 112, 0, 0, 302, 303, 5, 117, 0, 0, 303, 304, 5, 98, 0, 0, 304,
+		108, 1, 0, 0, 0, 305, 310, 3, 111, 55, 0, 306, 309, 3, 111, 55, 0, 307,
+		309, 3, 113, 56, 0, 308, 306, 1, 0, 0, 0, 308, 307, 1, 0, 0, 0, 309, 312,
+		1, 0, 0, 0, 310, 308, 1, 0, 0, 0, 310, 311, 1, 0, 0, 0, 311, 110, 1, 0,
+		0, 0, 312, 310, 1, 0, 0, 0, 313, 314, 7, 1, 0, 0, 314, 112, 1, 0, 0, 0,
+		315, 317, 7, 2, 0, 0, 316, 315, 1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318,
+		316, 1, 0, 0, 0, 318, 319, 1, 0, 0, 0, 319, 114, 1, 0, 0, 0, 320, 321,
+		5, 45, 0, 0, 321, 116, 1, 0, 0, 0, 322, 324, 7, 2, 0, 0, 323, 322, 1, 0,
+		0, 0, 324, 327, 1, 0, 0, 0, 325, 323, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0,
+		326, 328, 1, 0, 0, 0, 327, 325, 1, 0, 0, 0, 328, 330, 5, 46, 0, 0, 329,
+		331, 7, 2, 0, 0, 330, 329, 1, 0, 0, 0, 331, 332, 1, 0, 0, 0, 332, 330,
+		1, 0, 0, 0, 332, 333, 1, 0, 0, 0, 333, 118, 1, 0, 0, 0, 334, 340, 5, 39,
+		0, 0, 335, 336, 5, 92, 0, 0, 336, 339, 9, 0, 0, 0, 337, 339, 8, 3, 0, 0,
+		338, 335, 1, 0, 0, 0, 338, 337, 1, 0, 0, 0, 339, 342, 1, 0, 0, 0, 340,
+		338, 1, 0, 0, 0, 340, 341, 1, 0, 0, 0, 341, 343, 1, 0, 0, 0, 342, 340,
+		1, 0, 0, 0, 343, 363, 5, 39, 0, 0, 344, 350, 5, 34, 0, 0, 345, 346, 5,
+		92, 0, 0, 346, 349, 9, 0, 0, 0, 347, 349, 8, 4, 0, 0, 348, 345, 1, 0, 0,
+		0, 348, 347, 1, 0, 0, 0, 349, 352, 1, 0, 0, 0, 350, 348, 1, 0, 0, 0, 350,
+		351, 1, 0, 0, 0, 351, 353, 1, 0, 0, 0, 352, 350, 1, 0, 0, 0, 353, 363,
+		5, 34, 0, 0, 354, 358, 5, 96, 0, 0, 355, 357, 8, 5, 0, 0, 356, 355, 1,
+		0, 0, 0, 357, 360, 1, 0, 0, 0, 358, 356, 1, 0, 0, 0, 358, 359, 1, 0, 0,
+		0, 359, 361, 1, 0, 0, 0, 360, 358, 1, 0, 0, 0, 361, 363, 5, 96, 0, 0, 362,
+		334, 1, 0, 0, 0, 362, 344, 1, 0, 0, 0, 362, 354, 1, 0, 0, 0, 363, 120,
+		1, 0, 0, 0, 364, 366, 5, 13, 0, 0, 365, 364, 1, 0, 0, 0, 365, 366, 1, 0,
+		0, 0, 366, 367, 1, 0, 0, 0, 367, 368, 5, 10, 0, 0, 368, 122, 1, 0, 0, 0,
+		369, 371, 7, 6, 0, 0, 370, 369, 1, 0, 0, 0, 371, 372, 1, 0, 0, 0, 372,
+		370, 1, 0, 0, 0, 372, 373, 1, 0, 0, 0, 373, 374, 1, 0, 0, 0, 374, 375,
+		6, 61, 0, 0, 375, 124, 1, 0, 0, 0, 15, 0, 298, 308, 310, 318, 325, 332,
+		338, 340, 348, 350, 358, 362, 365, 372, 1, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -304,13 +324,16 @@ const (
 	nevaLexerT__46      = 47
 	nevaLexerT__47      = 48
 	nevaLexerT__48      = 49
-	nevaLexerCOMMENT    = 50
-	nevaLexerPUB_KW     = 51
-	nevaLexerIDENTIFIER = 52
-	nevaLexerINT        = 53
-	nevaLexerMINUS      = 54
-	nevaLexerFLOAT      = 55
-	nevaLexerSTRING     = 56
-	nevaLexerNEWLINE    = 57
-	nevaLexerWS         = 58
+	nevaLexerT__49      = 50
+	nevaLexerT__50      = 51
+	nevaLexerT__51      = 52
+	nevaLexerCOMMENT    = 53
+	nevaLexerPUB_KW     = 54
+	nevaLexerIDENTIFIER = 55
+	nevaLexerINT        = 56
+	nevaLexerMINUS      = 57
+	nevaLexerFLOAT      = 58
+	nevaLexerSTRING     = 59
+	nevaLexerNEWLINE    = 60
+	nevaLexerWS         = 61
 )
@@ -88,6 +88,9 @@ type nevaListener interface {
 	// EnterTypeArgs is called when entering the typeArgs production.
 	EnterTypeArgs(c *TypeArgsContext)
 
+	// EnterOpenTypeExpr is called when entering the openTypeExpr production.
+	EnterOpenTypeExpr(c *OpenTypeExprContext)
+
 	// EnterTypeLitExpr is called when entering the typeLitExpr production.
 	EnterTypeLitExpr(c *TypeLitExprContext)
 
@@ -103,6 +106,15 @@ type nevaListener interface {
 	// EnterStructField is called when entering the structField production.
 	EnterStructField(c *StructFieldContext)
 
+	// EnterTaggedUnionTypeExpr is called when entering the taggedUnionTypeExpr production.
+	EnterTaggedUnionTypeExpr(c *TaggedUnionTypeExprContext)
+
+	// EnterUnionTags is called when entering the unionTags production.
+	EnterUnionTags(c *UnionTagsContext)
+
+	// EnterUnionTag is called when entering the unionTag production.
+	EnterUnionTag(c *UnionTagContext)
+
 	// EnterUnionTypeExpr is called when entering the unionTypeExpr production.
 	EnterUnionTypeExpr(c *UnionTypeExprContext)
 
@@ -139,6 +151,12 @@ type nevaListener interface {
 	// EnterConstDef is called when entering the constDef production.
 	EnterConstDef(c *ConstDefContext)
 
+	// EnterConstExpr is called when entering the constExpr production.
+	EnterConstExpr(c *ConstExprContext)
+
+	// EnterConstOperand is called when entering the constOperand production.
+	EnterConstOperand(c *ConstOperandContext)
+
 	// EnterConstLit is called when entering the constLit production.
 	EnterConstLit(c *ConstLitContext)
 
@@ -196,6 +214,9 @@ type nevaListener interface {
 	// EnterNodeDIArgs is called when entering the nodeDIArgs production.
 	EnterNodeDIArgs(c *NodeDIArgsContext)
 
+	// EnterAnonCompDef is called when entering the anonCompDef production.
+	EnterAnonCompDef(c *AnonCompDefContext)
+
 	// EnterConnDefList is called when entering the connDefList production.
 	EnterConnDefList(c *ConnDefListContext)
 
@@ -289,6 +310,12 @@ type nevaListener interface {
 	// EnterDefaultCase is called when entering the defaultCase production.
 	EnterDefaultCase(c *DefaultCaseContext)
 
+	// EnterListSenderLit is called when entering the listSenderLit production.
+	EnterListSenderLit(c *ListSenderLitContext)
+
+	// EnterUnionSender is called when entering the unionSender production.
+	EnterUnionSender(c *UnionSenderContext)
+
 	// ExitProg is called when exiting the prog production.
 	ExitProg(c *ProgContext)
 
@@ -370,6 +397,9 @@ type nevaListener interface {
 	// ExitTypeArgs is called when exiting the typeArgs production.
 	ExitTypeArgs(c *TypeArgsContext)
 
+	// ExitOpenTypeExpr is called when exiting the openTypeExpr production.
+	ExitOpenTypeExpr(c *OpenTypeExprContext)
+
 	// ExitTypeLitExpr is called when exiting the typeLitExpr production.
 	ExitTypeLitExpr(c *TypeLitExprContext)
 
@@ -385,6 +415,15 @@ type nevaListener interface {
 	// ExitStructField is called when exiting the structField production.
 	ExitStructField(c *StructFieldContext)
 
+	// ExitTaggedUnionTypeExpr is called when exiting the taggedUnionTypeExpr production.
+	ExitTaggedUnionTypeExpr(c *TaggedUnionTypeExprContext)
+
+	// ExitUnionTags is called when exiting the unionTags production.
+	ExitUnionTags(c *UnionTagsContext)
+
+	// ExitUnionTag is called when exiting the unionTag production.
+	ExitUnionTag(c *UnionTagContext)
+
 	// ExitUnionTypeExpr is called when exiting the unionTypeExpr production.
 	ExitUnionTypeExpr(c *UnionTypeExprContext)
 
@@ -421,6 +460,12 @@ type nevaListener interface {
 	// ExitConstDef is called when exiting the constDef production.
 	ExitConstDef(c *ConstDefContext)
 
+	// ExitConstExpr is called when exiting the constExpr production.
+	ExitConstExpr(c *ConstExprContext)
+
+	// ExitConstOperand is called when exiting the constOperand production.
+	ExitConstOperand(c *ConstOperandContext)
+
 	// ExitConstLit is called when exiting the constLit production.
 	ExitConstLit(c *ConstLitContext)
 
@@ -478,6 +523,9 @@ type nevaListener interface {
 	// ExitNodeDIArgs is called when exiting the nodeDIArgs production.
 	ExitNodeDIArgs(c *NodeDIArgsContext)
 
+	// ExitAnonCompDef is called when exiting the anonCompDef production.
+	ExitAnonCompDef(c *AnonCompDefContext)
+
 	// ExitConnDefList is called when exiting the connDefList production.
 	ExitConnDefList(c *ConnDefListContext)
 
@@ -570,4 +618,10 @@ type nevaListener interface {
 
 	// ExitDefaultCase is called when exiting the defaultCase production.
 	ExitDefaultCase(c *DefaultCaseContext)
+
+	// ExitListSenderLit is called when exiting the listSenderLit production.
+	ExitListSenderLit(c *ListSenderLitContext)
+
+	// ExitUnionSender is called when exiting the unionSender production.
+	ExitUnionSender(c *UnionSenderContext)
 }
@@ -33,18 +33,19 @@ func nevaParserInit() {
 	staticData := &NevaParserStaticData
 	staticData.LiteralNames = []string{
 		"", "'#'", "'('", "','", "')'", "'import'", "'{'", "'}'", "':'", "'@'",
-		"'/'", "'.'", "'type'", "'<'", "'>'", "'enum'", "'struct'", "'|'", "'interface'",
-		"'['", "']'", "'const'", "'='", "'true'", "'false'", "'::'", "'def'",
-		"'---'", "'?'", "'->'", "'=>'", "'!'", "'++'", "'--'", "'+'", "'*'",
-		"'%'", "'**'", "'=='", "'!='", "'>='", "'<='", "'&&'", "'||'", "'&'",
-		"'^'", "'$'", "'..'", "'switch'", "'_'", "", "'pub'", "", "", "'-'",
+		"'/'", "'.'", "'type'", "'<'", "'>'", "'>>'", "'|'", "'enum'", "'struct'",
+		"'union'", "'interface'", "'['", "']'", "'const'", "'='", "'true'",
+		"'false'", "'::'", "'def'", "'---'", "'?'", "'->'", "'=>'", "'!'", "'++'",
+		"'--'", "'+'", "'*'", "'%'", "'**'", "'=='", "'!='", "'>='", "'<='",
+		"'&&'", "'||'", "'&'", "'^'", "'<<'", "'$'", "'..'", "'switch'", "'_'",
+		"", "'pub'", "", "", "'-'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "COMMENT",
-		"PUB_KW", "IDENTIFIER", "INT", "MINUS", "FLOAT", "STRING", "NEWLINE",
-		"WS",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "COMMENT", "PUB_KW", "IDENTIFIER", "INT", "MINUS", "FLOAT",
+		"STRING", "NEWLINE", "WS",
 	}
 	staticData.RuleNames = []string{
 		"prog", "stmt", "compilerDirectives", "compilerDirective", "compilerDirectivesArgs",
@@ -52,25 +53,27 @@ func nevaParserInit() {
 		"importPath", "importPathMod", "importMod", "importModeDelim", "importPathPkg",
 		"entityRef", "localEntityRef", "importedEntityRef", "pkgRef", "entityName",
 		"typeStmt", "typeDef", "typeParams", "typeParamList", "typeParam", "typeExpr",
-		"typeInstExpr", "typeArgs", "typeLitExpr", "enumTypeExpr", "structTypeExpr",
-		"structFields", "structField", "unionTypeExpr", "nonUnionTypeExpr",
-		"interfaceStmt", "interfaceDef", "inPortsDef", "outPortsDef", "portsDef",
-		"portDef", "singlePortDef", "arrayPortDef", "constStmt", "constDef",
-		"constLit", "primitiveConstLit", "bool", "enumLit", "listLit", "listItems",
-		"compositeItem", "structLit", "structValueFields", "structValueField",
-		"compStmt", "compDef", "compBody", "compNodesDef", "compNodesDefBody",
-		"compNodeDef", "nodeInst", "errGuard", "nodeDIArgs", "connDefList",
-		"connDef", "normConnDef", "senderSide", "multipleSenderSide", "arrBypassConnDef",
-		"singleSenderSide", "unaryExpr", "unaryOp", "ternaryExpr", "binaryExpr",
-		"binaryOp", "receiverSide", "chainedNormConn", "deferredConn", "senderConstRef",
-		"rangeExpr", "rangeMember", "portAddr", "lonelySinglePortAddr", "lonelyArrPortAddr",
-		"singlePortAddr", "arrPortAddr", "portAddrNode", "portAddrPort", "portAddrIdx",
-		"structSelectors", "singleReceiverSide", "multipleReceiverSide", "switchStmt",
-		"defaultCase",
+		"typeInstExpr", "typeArgs", "openTypeExpr", "typeLitExpr", "enumTypeExpr",
+		"structTypeExpr", "structFields", "structField", "taggedUnionTypeExpr",
+		"unionTags", "unionTag", "unionTypeExpr", "nonUnionTypeExpr", "interfaceStmt",
+		"interfaceDef", "inPortsDef", "outPortsDef", "portsDef", "portDef",
+		"singlePortDef", "arrayPortDef", "constStmt", "constDef", "constExpr",
+		"constOperand", "constLit", "primitiveConstLit", "bool", "enumLit",
+		"listLit", "listItems", "compositeItem", "structLit", "structValueFields",
+		"structValueField", "compStmt", "compDef", "compBody", "compNodesDef",
+		"compNodesDefBody", "compNodeDef", "nodeInst", "errGuard", "nodeDIArgs",
+		"anonCompDef", "connDefList", "connDef", "normConnDef", "senderSide",
+		"multipleSenderSide", "arrBypassConnDef", "singleSenderSide", "unaryExpr",
+		"unaryOp", "ternaryExpr", "binaryExpr", "binaryOp", "receiverSide",
+		"chainedNormConn", "deferredConn", "senderConstRef", "rangeExpr", "rangeMember",
+		"portAddr", "lonelySinglePortAddr", "lonelyArrPortAddr", "singlePortAddr",
+		"arrPortAddr", "portAddrNode", "portAddrPort", "portAddrIdx", "structSelectors",
+		"singleReceiverSide", "multipleReceiverSide", "switchStmt", "defaultCase",
+		"listSenderLit", "unionSender",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 58, 1116, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 61, 1368, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -87,501 +90,632 @@ func nevaParserInit() {
 		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
 		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
 		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
-		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 1, 0, 1,
-		0, 1, 0, 5, 0, 192, 8, 0, 10, 0, 12, 0, 195, 9, 0, 1, 0, 1, 0, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 3, 1, 204, 8, 1, 1, 2, 1, 2, 1, 2, 4, 2, 209, 8, 2,
-		11, 2, 12, 2, 210, 1, 3, 1, 3, 1, 3, 3, 3, 216, 8, 3, 1, 4, 1, 4, 1, 4,
-		1, 4, 5, 4, 222, 8, 4, 10, 4, 12, 4, 225, 9, 4, 1, 4, 1, 4, 1, 5, 4, 5,
-		230, 8, 5, 11, 5, 12, 5, 231, 1, 6, 1, 6, 5, 6, 236, 8, 6, 10, 6, 12, 6,
-		239, 9, 6, 1, 6, 1, 6, 5, 6, 243, 8, 6, 10, 6, 12, 6, 246, 9, 6, 1, 6,
-		5, 6, 249, 8, 6, 10, 6, 12, 6, 252, 9, 6, 1, 6, 1, 6, 1, 7, 3, 7, 257,
-		8, 7, 1, 7, 1, 7, 3, 7, 261, 8, 7, 1, 7, 5, 7, 264, 8, 7, 10, 7, 12, 7,
-		267, 9, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 3, 9, 274, 8, 9, 1, 9, 1, 9, 1,
-		10, 1, 10, 3, 10, 280, 8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 286, 8,
-		11, 10, 11, 12, 11, 289, 9, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 5, 13,
-		296, 8, 13, 10, 13, 12, 13, 299, 9, 13, 1, 14, 1, 14, 3, 14, 303, 8, 14,
-		1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1,
-		19, 3, 19, 316, 8, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 3, 20, 323, 8,
-		20, 1, 20, 3, 20, 326, 8, 20, 1, 20, 3, 20, 329, 8, 20, 1, 21, 1, 21, 5,
-		21, 333, 8, 21, 10, 21, 12, 21, 336, 9, 21, 1, 21, 3, 21, 339, 8, 21, 1,
-		21, 1, 21, 1, 22, 1, 22, 1, 22, 5, 22, 346, 8, 22, 10, 22, 12, 22, 349,
-		9, 22, 1, 22, 5, 22, 352, 8, 22, 10, 22, 12, 22, 355, 9, 22, 1, 23, 1,
-		23, 3, 23, 359, 8, 23, 1, 23, 5, 23, 362, 8, 23, 10, 23, 12, 23, 365, 9,
-		23, 1, 24, 1, 24, 1, 24, 3, 24, 370, 8, 24, 1, 25, 1, 25, 3, 25, 374, 8,
-		25, 1, 26, 1, 26, 5, 26, 378, 8, 26, 10, 26, 12, 26, 381, 9, 26, 1, 26,
-		1, 26, 1, 26, 5, 26, 386, 8, 26, 10, 26, 12, 26, 389, 9, 26, 1, 26, 5,
-		26, 392, 8, 26, 10, 26, 12, 26, 395, 9, 26, 1, 26, 5, 26, 398, 8, 26, 10,
-		26, 12, 26, 401, 9, 26, 1, 26, 1, 26, 1, 27, 1, 27, 3, 27, 407, 8, 27,
-		1, 28, 1, 28, 5, 28, 411, 8, 28, 10, 28, 12, 28, 414, 9, 28, 1, 28, 1,
-		28, 5, 28, 418, 8, 28, 10, 28, 12, 28, 421, 9, 28, 1, 28, 1, 28, 1, 28,
-		5, 28, 426, 8, 28, 10, 28, 12, 28, 429, 9, 28, 1, 28, 5, 28, 432, 8, 28,
-		10, 28, 12, 28, 435, 9, 28, 1, 28, 5, 28, 438, 8, 28, 10, 28, 12, 28, 441,
-		9, 28, 1, 28, 1, 28, 1, 29, 1, 29, 5, 29, 447, 8, 29, 10, 29, 12, 29, 450,
-		9, 29, 1, 29, 1, 29, 5, 29, 454, 8, 29, 10, 29, 12, 29, 457, 9, 29, 1,
-		29, 3, 29, 460, 8, 29, 1, 29, 1, 29, 1, 30, 1, 30, 4, 30, 466, 8, 30, 11,
-		30, 12, 30, 467, 1, 30, 5, 30, 471, 8, 30, 10, 30, 12, 30, 474, 9, 30,
-		1, 31, 1, 31, 1, 31, 5, 31, 479, 8, 31, 10, 31, 12, 31, 482, 9, 31, 1,
-		32, 1, 32, 5, 32, 486, 8, 32, 10, 32, 12, 32, 489, 9, 32, 1, 32, 1, 32,
-		5, 32, 493, 8, 32, 10, 32, 12, 32, 496, 9, 32, 1, 32, 4, 32, 499, 8, 32,
-		11, 32, 12, 32, 500, 1, 33, 1, 33, 3, 33, 505, 8, 33, 1, 34, 3, 34, 508,
-		8, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 3, 35, 515, 8, 35, 1, 35, 1,
-		35, 1, 35, 5, 35, 520, 8, 35, 10, 35, 12, 35, 523, 9, 35, 1, 36, 1, 36,
-		1, 37, 1, 37, 1, 38, 1, 38, 5, 38, 531, 8, 38, 10, 38, 12, 38, 534, 9,
-		38, 1, 38, 3, 38, 537, 8, 38, 1, 38, 1, 38, 1, 38, 5, 38, 542, 8, 38, 10,
-		38, 12, 38, 545, 9, 38, 3, 38, 547, 8, 38, 1, 38, 1, 38, 1, 39, 1, 39,
-		3, 39, 553, 8, 39, 1, 40, 5, 40, 556, 8, 40, 10, 40, 12, 40, 559, 9, 40,
-		1, 40, 3, 40, 562, 8, 40, 1, 40, 1, 40, 5, 40, 566, 8, 40, 10, 40, 12,
-		40, 569, 9, 40, 1, 41, 5, 41, 572, 8, 41, 10, 41, 12, 41, 575, 9, 41, 1,
-		41, 1, 41, 1, 41, 1, 41, 3, 41, 581, 8, 41, 1, 41, 5, 41, 584, 8, 41, 10,
-		41, 12, 41, 587, 9, 41, 1, 42, 3, 42, 590, 8, 42, 1, 42, 1, 42, 1, 42,
-		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 600, 8, 43, 1, 43, 5, 43, 603,
-		8, 43, 10, 43, 12, 43, 606, 9, 43, 1, 44, 1, 44, 3, 44, 610, 8, 44, 1,
-		44, 1, 44, 3, 44, 614, 8, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44,
-		621, 8, 44, 1, 45, 1, 45, 3, 45, 625, 8, 45, 1, 45, 1, 45, 3, 45, 629,
-		8, 45, 1, 45, 1, 45, 1, 45, 3, 45, 634, 8, 45, 1, 46, 1, 46, 1, 47, 1,
-		47, 1, 47, 1, 47, 1, 48, 1, 48, 5, 48, 644, 8, 48, 10, 48, 12, 48, 647,
-		9, 48, 1, 48, 3, 48, 650, 8, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1,
-		49, 5, 49, 658, 8, 49, 10, 49, 12, 49, 661, 9, 49, 1, 49, 1, 49, 5, 49,
-		665, 8, 49, 10, 49, 12, 49, 668, 9, 49, 5, 49, 670, 8, 49, 10, 49, 12,
-		49, 673, 9, 49, 3, 49, 675, 8, 49, 1, 50, 1, 50, 3, 50, 679, 8, 50, 1,
-		51, 1, 51, 5, 51, 683, 8, 51, 10, 51, 12, 51, 686, 9, 51, 1, 51, 3, 51,
-		689, 8, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 5, 52, 696, 8, 52, 10, 52,
-		12, 52, 699, 9, 52, 1, 52, 5, 52, 702, 8, 52, 10, 52, 12, 52, 705, 9, 52,
-		1, 53, 1, 53, 1, 53, 1, 53, 5, 53, 711, 8, 53, 10, 53, 12, 53, 714, 9,
-		53, 1, 54, 3, 54, 717, 8, 54, 1, 54, 3, 54, 720, 8, 54, 1, 54, 1, 54, 1,
-		54, 1, 55, 1, 55, 3, 55, 727, 8, 55, 1, 55, 5, 55, 730, 8, 55, 10, 55,
-		12, 55, 733, 9, 55, 1, 56, 1, 56, 5, 56, 737, 8, 56, 10, 56, 12, 56, 740,
-		9, 56, 1, 56, 1, 56, 5, 56, 744, 8, 56, 10, 56, 12, 56, 747, 9, 56, 5,
-		56, 749, 8, 56, 10, 56, 12, 56, 752, 9, 56, 1, 56, 1, 56, 5, 56, 756, 8,
-		56, 10, 56, 12, 56, 759, 9, 56, 3, 56, 761, 8, 56, 1, 56, 1, 56, 5, 56,
-		765, 8, 56, 10, 56, 12, 56, 768, 9, 56, 5, 56, 770, 8, 56, 10, 56, 12,
-		56, 773, 9, 56, 1, 56, 1, 56, 5, 56, 777, 8, 56, 10, 56, 12, 56, 780, 9,
-		56, 3, 56, 782, 8, 56, 1, 56, 1, 56, 5, 56, 786, 8, 56, 10, 56, 12, 56,
-		789, 9, 56, 5, 56, 791, 8, 56, 10, 56, 12, 56, 794, 9, 56, 1, 56, 1, 56,
-		1, 57, 1, 57, 4, 57, 800, 8, 57, 11, 57, 12, 57, 801, 1, 57, 1, 57, 1,
-		58, 1, 58, 3, 58, 808, 8, 58, 1, 58, 3, 58, 811, 8, 58, 1, 58, 5, 58, 814,
-		8, 58, 10, 58, 12, 58, 817, 9, 58, 4, 58, 819, 8, 58, 11, 58, 12, 58, 820,
-		1, 59, 3, 59, 824, 8, 59, 1, 59, 3, 59, 827, 8, 59, 1, 59, 1, 59, 1, 60,
-		1, 60, 5, 60, 833, 8, 60, 10, 60, 12, 60, 836, 9, 60, 1, 60, 3, 60, 839,
-		8, 60, 1, 60, 5, 60, 842, 8, 60, 10, 60, 12, 60, 845, 9, 60, 1, 60, 3,
-		60, 848, 8, 60, 1, 60, 3, 60, 851, 8, 60, 1, 61, 1, 61, 1, 62, 1, 62, 5,
-		62, 857, 8, 62, 10, 62, 12, 62, 860, 9, 62, 1, 62, 1, 62, 1, 62, 1, 63,
-		1, 63, 3, 63, 867, 8, 63, 1, 63, 5, 63, 870, 8, 63, 10, 63, 12, 63, 873,
-		9, 63, 1, 63, 1, 63, 3, 63, 877, 8, 63, 5, 63, 879, 8, 63, 10, 63, 12,
-		63, 882, 9, 63, 1, 64, 1, 64, 3, 64, 886, 8, 64, 1, 65, 1, 65, 1, 65, 1,
-		65, 1, 66, 1, 66, 3, 66, 894, 8, 66, 1, 67, 1, 67, 5, 67, 898, 8, 67, 10,
-		67, 12, 67, 901, 9, 67, 1, 67, 1, 67, 1, 67, 5, 67, 906, 8, 67, 10, 67,
-		12, 67, 909, 9, 67, 1, 67, 1, 67, 5, 67, 913, 8, 67, 10, 67, 12, 67, 916,
-		9, 67, 5, 67, 918, 8, 67, 10, 67, 12, 67, 921, 9, 67, 1, 67, 1, 67, 1,
-		68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
-		1, 69, 3, 69, 937, 8, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 72, 1,
-		72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73,
-		1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 3, 75, 962, 8, 75, 1, 76, 1,
-		76, 1, 77, 1, 77, 5, 77, 968, 8, 77, 10, 77, 12, 77, 971, 9, 77, 1, 77,
-		1, 77, 5, 77, 975, 8, 77, 10, 77, 12, 77, 978, 9, 77, 1, 77, 1, 77, 1,
-		78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 3, 80, 990, 8, 80,
-		1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 998, 8, 81, 1, 82, 1,
-		82, 1, 83, 1, 83, 1, 83, 1, 84, 3, 84, 1006, 8, 84, 1, 84, 1, 84, 1, 84,
-		1, 85, 3, 85, 1012, 8, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1,
-		87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 5, 89,
-		1030, 8, 89, 10, 89, 12, 89, 1033, 9, 89, 1, 90, 1, 90, 1, 90, 1, 90, 3,
-		90, 1039, 8, 90, 1, 91, 1, 91, 5, 91, 1043, 8, 91, 10, 91, 12, 91, 1046,
-		9, 91, 1, 91, 1, 91, 1, 91, 5, 91, 1051, 8, 91, 10, 91, 12, 91, 1054, 9,
-		91, 1, 91, 1, 91, 5, 91, 1058, 8, 91, 10, 91, 12, 91, 1061, 9, 91, 5, 91,
-		1063, 8, 91, 10, 91, 12, 91, 1066, 9, 91, 1, 91, 1, 91, 1, 92, 1, 92, 5,
-		92, 1072, 8, 92, 10, 92, 12, 92, 1075, 9, 92, 1, 92, 1, 92, 5, 92, 1079,
-		8, 92, 10, 92, 12, 92, 1082, 9, 92, 1, 92, 1, 92, 4, 92, 1086, 8, 92, 11,
-		92, 12, 92, 1087, 1, 92, 5, 92, 1091, 8, 92, 10, 92, 12, 92, 1094, 9, 92,
-		1, 92, 4, 92, 1097, 8, 92, 11, 92, 12, 92, 1098, 1, 92, 3, 92, 1102, 8,
-		92, 1, 92, 5, 92, 1105, 8, 92, 10, 92, 12, 92, 1108, 9, 92, 1, 92, 1, 92,
-		1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 0, 0, 94, 0, 2, 4, 6, 8, 10, 12, 14,
-		16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
-		52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
-		88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118,
-		120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148,
-		150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178,
-		180, 182, 184, 186, 0, 4, 1, 0, 10, 11, 1, 0, 23, 24, 2, 0, 31, 33, 54,
-		54, 5, 0, 10, 10, 13, 14, 17, 17, 34, 45, 54, 54, 1189, 0, 193, 1, 0, 0,
-		0, 2, 203, 1, 0, 0, 0, 4, 208, 1, 0, 0, 0, 6, 212, 1, 0, 0, 0, 8, 217,
-		1, 0, 0, 0, 10, 229, 1, 0, 0, 0, 12, 233, 1, 0, 0, 0, 14, 256, 1, 0, 0,
-		0, 16, 268, 1, 0, 0, 0, 18, 273, 1, 0, 0, 0, 20, 279, 1, 0, 0, 0, 22, 281,
-		1, 0, 0, 0, 24, 290, 1, 0, 0, 0, 26, 292, 1, 0, 0, 0, 28, 302, 1, 0, 0,
-		0, 30, 304, 1, 0, 0, 0, 32, 306, 1, 0, 0, 0, 34, 310, 1, 0, 0, 0, 36, 312,
-		1, 0, 0, 0, 38, 315, 1, 0, 0, 0, 40, 320, 1, 0, 0, 0, 42, 330, 1, 0, 0,
-		0, 44, 342, 1, 0, 0, 0, 46, 356, 1, 0, 0, 0, 48, 369, 1, 0, 0, 0, 50, 371,
-		1, 0, 0, 0, 52, 375, 1, 0, 0, 0, 54, 406, 1, 0, 0, 0, 56, 408, 1, 0, 0,
-		0, 58, 444, 1, 0, 0, 0, 60, 463, 1, 0, 0, 0, 62, 475, 1, 0, 0, 0, 64, 483,
-		1, 0, 0, 0, 66, 504, 1, 0, 0, 0, 68, 507, 1, 0, 0, 0, 70, 512, 1, 0, 0,
-		0, 72, 524, 1, 0, 0, 0, 74, 526, 1, 0, 0, 0, 76, 528, 1, 0, 0, 0, 78, 552,
-		1, 0, 0, 0, 80, 557, 1, 0, 0, 0, 82, 573, 1, 0, 0, 0, 84, 589, 1, 0, 0,
-		0, 86, 594, 1, 0, 0, 0, 88, 620, 1, 0, 0, 0, 90, 633, 1, 0, 0, 0, 92, 635,
-		1, 0, 0, 0, 94, 637, 1, 0, 0, 0, 96, 641, 1, 0, 0, 0, 98, 674, 1, 0, 0,
-		0, 100, 678, 1, 0, 0, 0, 102, 680, 1, 0, 0, 0, 104, 692, 1, 0, 0, 0, 106,
-		706, 1, 0, 0, 0, 108, 716, 1, 0, 0, 0, 110, 724, 1, 0, 0, 0, 112, 734,
-		1, 0, 0, 0, 114, 797, 1, 0, 0, 0, 116, 818, 1, 0, 0, 0, 118, 823, 1, 0,
-		0, 0, 120, 830, 1, 0, 0, 0, 122, 852, 1, 0, 0, 0, 124, 854, 1, 0, 0, 0,
-		126, 866, 1, 0, 0, 0, 128, 885, 1, 0, 0, 0, 130, 887, 1, 0, 0, 0, 132,
-		893, 1, 0, 0, 0, 134, 895, 1, 0, 0, 0, 136, 924, 1, 0, 0, 0, 138, 936,
-		1, 0, 0, 0, 140, 938, 1, 0, 0, 0, 142, 941, 1, 0, 0, 0, 144, 943, 1, 0,
-		0, 0, 146, 951, 1, 0, 0, 0, 148, 957, 1, 0, 0, 0, 150, 961, 1, 0, 0, 0,
-		152, 963, 1, 0, 0, 0, 154, 965, 1, 0, 0, 0, 156, 981, 1, 0, 0, 0, 158,
-		984, 1, 0, 0, 0, 160, 989, 1, 0, 0, 0, 162, 997, 1, 0, 0, 0, 164, 999,
-		1, 0, 0, 0, 166, 1001, 1, 0, 0, 0, 168, 1005, 1, 0, 0, 0, 170, 1011, 1,
-		0, 0, 0, 172, 1017, 1, 0, 0, 0, 174, 1019, 1, 0, 0, 0, 176, 1021, 1, 0,
-		0, 0, 178, 1025, 1, 0, 0, 0, 180, 1038, 1, 0, 0, 0, 182, 1040, 1, 0, 0,
-		0, 184, 1069, 1, 0, 0, 0, 186, 1111, 1, 0, 0, 0, 188, 192, 5, 57, 0, 0,
-		189, 192, 5, 50, 0, 0, 190, 192, 3, 2, 1, 0, 191, 188, 1, 0, 0, 0, 191,
-		189, 1, 0, 0, 0, 191, 190, 1, 0, 0, 0, 192, 195, 1, 0, 0, 0, 193, 191,
-		1, 0, 0, 0, 193, 194, 1, 0, 0, 0, 194, 196, 1, 0, 0, 0, 195, 193, 1, 0,
-		0, 0, 196, 197, 5, 0, 0, 1, 197, 1, 1, 0, 0, 0, 198, 204, 3, 12, 6, 0,
-		199, 204, 3, 38, 19, 0, 200, 204, 3, 68, 34, 0, 201, 204, 3, 84, 42, 0,
-		202, 204, 3, 108, 54, 0, 203, 198, 1, 0, 0, 0, 203, 199, 1, 0, 0, 0, 203,
-		200, 1, 0, 0, 0, 203, 201, 1, 0, 0, 0, 203, 202, 1, 0, 0, 0, 204, 3, 1,
-		0, 0, 0, 205, 206, 3, 6, 3, 0, 206, 207, 5, 57, 0, 0, 207, 209, 1, 0, 0,
-		0, 208, 205, 1, 0, 0, 0, 209, 210, 1, 0, 0, 0, 210, 208, 1, 0, 0, 0, 210,
-		211, 1, 0, 0, 0, 211, 5, 1, 0, 0, 0, 212, 213, 5, 1, 0, 0, 213, 215, 5,
-		52, 0, 0, 214, 216, 3, 8, 4, 0, 215, 214, 1, 0, 0, 0, 215, 216, 1, 0, 0,
-		0, 216, 7, 1, 0, 0, 0, 217, 218, 5, 2, 0, 0, 218, 223, 3, 10, 5, 0, 219,
-		220, 5, 3, 0, 0, 220, 222, 3, 10, 5, 0, 221, 219, 1, 0, 0, 0, 222, 225,
-		1, 0, 0, 0, 223, 221, 1, 0, 0, 0, 223, 224, 1, 0, 0, 0, 224, 226, 1, 0,
-		0, 0, 225, 223, 1, 0, 0, 0, 226, 227, 5, 4, 0, 0, 227, 9, 1, 0, 0, 0, 228,
-		230, 5, 52, 0, 0, 229, 228, 1, 0, 0, 0, 230, 231, 1, 0, 0, 0, 231, 229,
-		1, 0, 0, 0, 231, 232, 1, 0, 0, 0, 232, 11, 1, 0, 0, 0, 233, 237, 5, 5,
-		0, 0, 234, 236, 5, 57, 0, 0, 235, 234, 1, 0, 0, 0, 236, 239, 1, 0, 0, 0,
-		237, 235, 1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 240, 1, 0, 0, 0, 239,
-		237, 1, 0, 0, 0, 240, 244, 5, 6, 0, 0, 241, 243, 5, 57, 0, 0, 242, 241,
-		1, 0, 0, 0, 243, 246, 1, 0, 0, 0, 244, 242, 1, 0, 0, 0, 244, 245, 1, 0,
-		0, 0, 245, 250, 1, 0, 0, 0, 246, 244, 1, 0, 0, 0, 247, 249, 3, 14, 7, 0,
-		248, 247, 1, 0, 0, 0, 249, 252, 1, 0, 0, 0, 250, 248, 1, 0, 0, 0, 250,
-		251, 1, 0, 0, 0, 251, 253, 1, 0, 0, 0, 252, 250, 1, 0, 0, 0, 253, 254,
-		5, 7, 0, 0, 254, 13, 1, 0, 0, 0, 255, 257, 3, 16, 8, 0, 256, 255, 1, 0,
-		0, 0, 256, 257, 1, 0, 0, 0, 257, 258, 1, 0, 0, 0, 258, 260, 3, 18, 9, 0,
-		259, 261, 5, 3, 0, 0, 260, 259, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261,
-		265, 1, 0, 0, 0, 262, 264, 5, 57, 0, 0, 263, 262, 1, 0, 0, 0, 264, 267,
-		1, 0, 0, 0, 265, 263, 1, 0, 0, 0, 265, 266, 1, 0, 0, 0, 266, 15, 1, 0,
-		0, 0, 267, 265, 1, 0, 0, 0, 268, 269, 5, 52, 0, 0, 269, 17, 1, 0, 0, 0,
-		270, 271, 3, 20, 10, 0, 271, 272, 5, 8, 0, 0, 272, 274, 1, 0, 0, 0, 273,
-		270, 1, 0, 0, 0, 273, 274, 1, 0, 0, 0, 274, 275, 1, 0, 0, 0, 275, 276,
-		3, 26, 13, 0, 276, 19, 1, 0, 0, 0, 277, 280, 5, 9, 0, 0, 278, 280, 3, 22,
-		11, 0, 279, 277, 1, 0, 0, 0, 279, 278, 1, 0, 0, 0, 280, 21, 1, 0, 0, 0,
-		281, 287, 5, 52, 0, 0, 282, 283, 3, 24, 12, 0, 283, 284, 5, 52, 0, 0, 284,
-		286, 1, 0, 0, 0, 285, 282, 1, 0, 0, 0, 286, 289, 1, 0, 0, 0, 287, 285,
-		1, 0, 0, 0, 287, 288, 1, 0, 0, 0, 288, 23, 1, 0, 0, 0, 289, 287, 1, 0,
-		0, 0, 290, 291, 7, 0, 0, 0, 291, 25, 1, 0, 0, 0, 292, 297, 5, 52, 0, 0,
-		293, 294, 5, 10, 0, 0, 294, 296, 5, 52, 0, 0, 295, 293, 1, 0, 0, 0, 296,
-		299, 1, 0, 0, 0, 297, 295, 1, 0, 0, 0, 297, 298, 1, 0, 0, 0, 298, 27, 1,
-		0, 0, 0, 299, 297, 1, 0, 0, 0, 300, 303, 3, 32, 16, 0, 301, 303, 3, 30,
-		15, 0, 302, 300, 1, 0, 0, 0, 302, 301, 1, 0, 0, 0, 303, 29, 1, 0, 0, 0,
-		304, 305, 5, 52, 0, 0, 305, 31, 1, 0, 0, 0, 306, 307, 3, 34, 17, 0, 307,
-		308, 5, 11, 0, 0, 308, 309, 3, 36, 18, 0, 309, 33, 1, 0, 0, 0, 310, 311,
-		5, 52, 0, 0, 311, 35, 1, 0, 0, 0, 312, 313, 5, 52, 0, 0, 313, 37, 1, 0,
-		0, 0, 314, 316, 5, 51, 0, 0, 315, 314, 1, 0, 0, 0, 315, 316, 1, 0, 0, 0,
-		316, 317, 1, 0, 0, 0, 317, 318, 5, 12, 0, 0, 318, 319, 3, 40, 20, 0, 319,
-		39, 1, 0, 0, 0, 320, 322, 5, 52, 0, 0, 321, 323, 3, 42, 21, 0, 322, 321,
-		1, 0, 0, 0, 322, 323, 1, 0, 0, 0, 323, 325, 1, 0, 0, 0, 324, 326, 3, 48,
-		24, 0, 325, 324, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0, 326, 328, 1, 0, 0, 0,
-		327, 329, 5, 50, 0, 0, 328, 327, 1, 0, 0, 0, 328, 329, 1, 0, 0, 0, 329,
-		41, 1, 0, 0, 0, 330, 334, 5, 13, 0, 0, 331, 333, 5, 57, 0, 0, 332, 331,
-		1, 0, 0, 0, 333, 336, 1, 0, 0, 0, 334, 332, 1, 0, 0, 0, 334, 335, 1, 0,
-		0, 0, 335, 338, 1, 0, 0, 0, 336, 334, 1, 0, 0, 0, 337, 339, 3, 44, 22,
-		0, 338, 337, 1, 0, 0, 0, 338, 339, 1, 0, 0, 0, 339, 340, 1, 0, 0, 0, 340,
-		341, 5, 14, 0, 0, 341, 43, 1, 0, 0, 0, 342, 353, 3, 46, 23, 0, 343, 347,
-		5, 3, 0, 0, 344, 346, 5, 57, 0, 0, 345, 344, 1, 0, 0, 0, 346, 349, 1, 0,
-		0, 0, 347, 345, 1, 0, 0, 0, 347, 348, 1, 0, 0, 0, 348, 350, 1, 0, 0, 0,
-		349, 347, 1, 0, 0, 0, 350, 352, 3, 46, 23, 0, 351, 343, 1, 0, 0, 0, 352,
-		355, 1, 0, 0, 0, 353, 351, 1, 0, 0, 0, 353, 354, 1, 0, 0, 0, 354, 45, 1,
-		0, 0, 0, 355, 353, 1, 0, 0, 0, 356, 358, 5, 52, 0, 0, 357, 359, 3, 48,
-		24, 0, 358, 357, 1, 0, 0, 0, 358, 359, 1, 0, 0, 0, 359, 363, 1, 0, 0, 0,
-		360, 362, 5, 57, 0, 0, 361, 360, 1, 0, 0, 0, 362, 365, 1, 0, 0, 0, 363,
-		361, 1, 0, 0, 0, 363, 364, 1, 0, 0, 0, 364, 47, 1, 0, 0, 0, 365, 363, 1,
-		0, 0, 0, 366, 370, 3, 50, 25, 0, 367, 370, 3, 54, 27, 0, 368, 370, 3, 64,
-		32, 0, 369, 366, 1, 0, 0, 0, 369, 367, 1, 0, 0, 0, 369, 368, 1, 0, 0, 0,
-		370, 49, 1, 0, 0, 0, 371, 373, 3, 28, 14, 0, 372, 374, 3, 52, 26, 0, 373,
-		372, 1, 0, 0, 0, 373, 374, 1, 0, 0, 0, 374, 51, 1, 0, 0, 0, 375, 379, 5,
-		13, 0, 0, 376, 378, 5, 57, 0, 0, 377, 376, 1, 0, 0, 0, 378, 381, 1, 0,
-		0, 0, 379, 377, 1, 0, 0, 0, 379, 380, 1, 0, 0, 0, 380, 382, 1, 0, 0, 0,
-		381, 379, 1, 0, 0, 0, 382, 393, 3, 48, 24, 0, 383, 387, 5, 3, 0, 0, 384,
-		386, 5, 57, 0, 0, 385, 384, 1, 0, 0, 0, 386, 389, 1, 0, 0, 0, 387, 385,
-		1, 0, 0, 0, 387, 388, 1, 0, 0, 0, 388, 390, 1, 0, 0, 0, 389, 387, 1, 0,
-		0, 0, 390, 392, 3, 48, 24, 0, 391, 383, 1, 0, 0, 0, 392, 395, 1, 0, 0,
-		0, 393, 391, 1, 0, 0, 0, 393, 394, 1, 0, 0, 0, 394, 399, 1, 0, 0, 0, 395,
-		393, 1, 0, 0, 0, 396, 398, 5, 57, 0, 0, 397, 396, 1, 0, 0, 0, 398, 401,
-		1, 0, 0, 0, 399, 397, 1, 0, 0, 0, 399, 400, 1, 0, 0, 0, 400, 402, 1, 0,
-		0, 0, 401, 399, 1, 0, 0, 0, 402, 403, 5, 14, 0, 0, 403, 53, 1, 0, 0, 0,
-		404, 407, 3, 56, 28, 0, 405, 407, 3, 58, 29, 0, 406, 404, 1, 0, 0, 0, 406,
-		405, 1, 0, 0, 0, 407, 55, 1, 0, 0, 0, 408, 412, 5, 15, 0, 0, 409, 411,
-		5, 57, 0, 0, 410, 409, 1, 0, 0, 0, 411, 414, 1, 0, 0, 0, 412, 410, 1, 0,
-		0, 0, 412, 413, 1, 0, 0, 0, 413, 415, 1, 0, 0, 0, 414, 412, 1, 0, 0, 0,
-		415, 419, 5, 6, 0, 0, 416, 418, 5, 57, 0, 0, 417, 416, 1, 0, 0, 0, 418,
-		421, 1, 0, 0, 0, 419, 417, 1, 0, 0, 0, 419, 420, 1, 0, 0, 0, 420, 422,
-		1, 0, 0, 0, 421, 419, 1, 0, 0, 0, 422, 433, 5, 52, 0, 0, 423, 427, 5, 3,
-		0, 0, 424, 426, 5, 57, 0, 0, 425, 424, 1, 0, 0, 0, 426, 429, 1, 0, 0, 0,
-		427, 425, 1, 0, 0, 0, 427, 428, 1, 0, 0, 0, 428, 430, 1, 0, 0, 0, 429,
-		427, 1, 0, 0, 0, 430, 432, 5, 52, 0, 0, 431, 423, 1, 0, 0, 0, 432, 435,
-		1, 0, 0, 0, 433, 431, 1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 439, 1, 0,
-		0, 0, 435, 433, 1, 0, 0, 0, 436, 438, 5, 57, 0, 0, 437, 436, 1, 0, 0, 0,
-		438, 441, 1, 0, 0, 0, 439, 437, 1, 0, 0, 0, 439, 440, 1, 0, 0, 0, 440,
-		442, 1, 0, 0, 0, 441, 439, 1, 0, 0, 0, 442, 443, 5, 7, 0, 0, 443, 57, 1,
-		0, 0, 0, 444, 448, 5, 16, 0, 0, 445, 447, 5, 57, 0, 0, 446, 445, 1, 0,
-		0, 0, 447, 450, 1, 0, 0, 0, 448, 446, 1, 0, 0, 0, 448, 449, 1, 0, 0, 0,
-		449, 451, 1, 0, 0, 0, 450, 448, 1, 0, 0, 0, 451, 455, 5, 6, 0, 0, 452,
-		454, 5, 57, 0, 0, 453, 452, 1, 0, 0, 0, 454, 457, 1, 0, 0, 0, 455, 453,
-		1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 459, 1, 0, 0, 0, 457, 455, 1, 0,
-		0, 0, 458, 460, 3, 60, 30, 0, 459, 458, 1, 0, 0, 0, 459, 460, 1, 0, 0,
-		0, 460, 461, 1, 0, 0, 0, 461, 462, 5, 7, 0, 0, 462, 59, 1, 0, 0, 0, 463,
-		472, 3, 62, 31, 0, 464, 466, 5, 57, 0, 0, 465, 464, 1, 0, 0, 0, 466, 467,
-		1, 0, 0, 0, 467, 465, 1, 0, 0, 0, 467, 468, 1, 0, 0, 0, 468, 469, 1, 0,
-		0, 0, 469, 471, 3, 62, 31, 0, 470, 465, 1, 0, 0, 0, 471, 474, 1, 0, 0,
-		0, 472, 470, 1, 0, 0, 0, 472, 473, 1, 0, 0, 0, 473, 61, 1, 0, 0, 0, 474,
-		472, 1, 0, 0, 0, 475, 476, 5, 52, 0, 0, 476, 480, 3, 48, 24, 0, 477, 479,
-		5, 57, 0, 0, 478, 477, 1, 0, 0, 0, 479, 482, 1, 0, 0, 0, 480, 478, 1, 0,
-		0, 0, 480, 481, 1, 0, 0, 0, 481, 63, 1, 0, 0, 0, 482, 480, 1, 0, 0, 0,
-		483, 498, 3, 66, 33, 0, 484, 486, 5, 57, 0, 0, 485, 484, 1, 0, 0, 0, 486,
-		489, 1, 0, 0, 0, 487, 485, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 490,
-		1, 0, 0, 0, 489, 487, 1, 0, 0, 0, 490, 494, 5, 17, 0, 0, 491, 493, 5, 57,
-		0, 0, 492, 491, 1, 0, 0, 0, 493, 496, 1, 0, 0, 0, 494, 492, 1, 0, 0, 0,
-		494, 495, 1, 0, 0, 0, 495, 497, 1, 0, 0, 0, 496, 494, 1, 0, 0, 0, 497,
-		499, 3, 66, 33, 0, 498, 487, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 498,
-		1, 0, 0, 0, 500, 501, 1, 0, 0, 0, 501, 65, 1, 0, 0, 0, 502, 505, 3, 50,
-		25, 0, 503, 505, 3, 54, 27, 0, 504, 502, 1, 0, 0, 0, 504, 503, 1, 0, 0,
-		0, 505, 67, 1, 0, 0, 0, 506, 508, 5, 51, 0, 0, 507, 506, 1, 0, 0, 0, 507,
-		508, 1, 0, 0, 0, 508, 509, 1, 0, 0, 0, 509, 510, 5, 18, 0, 0, 510, 511,
-		3, 70, 35, 0, 511, 69, 1, 0, 0, 0, 512, 514, 5, 52, 0, 0, 513, 515, 3,
-		42, 21, 0, 514, 513, 1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515, 516, 1, 0,
-		0, 0, 516, 517, 3, 72, 36, 0, 517, 521, 3, 74, 37, 0, 518, 520, 5, 57,
-		0, 0, 519, 518, 1, 0, 0, 0, 520, 523, 1, 0, 0, 0, 521, 519, 1, 0, 0, 0,
-		521, 522, 1, 0, 0, 0, 522, 71, 1, 0, 0, 0, 523, 521, 1, 0, 0, 0, 524, 525,
-		3, 76, 38, 0, 525, 73, 1, 0, 0, 0, 526, 527, 3, 76, 38, 0, 527, 75, 1,
-		0, 0, 0, 528, 546, 5, 2, 0, 0, 529, 531, 5, 57, 0, 0, 530, 529, 1, 0, 0,
-		0, 531, 534, 1, 0, 0, 0, 532, 530, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533,
-		547, 1, 0, 0, 0, 534, 532, 1, 0, 0, 0, 535, 537, 3, 78, 39, 0, 536, 535,
-		1, 0, 0, 0, 536, 537, 1, 0, 0, 0, 537, 547, 1, 0, 0, 0, 538, 543, 3, 78,
-		39, 0, 539, 540, 5, 3, 0, 0, 540, 542, 3, 78, 39, 0, 541, 539, 1, 0, 0,
-		0, 542, 545, 1, 0, 0, 0, 543, 541, 1, 0, 0, 0, 543, 544, 1, 0, 0, 0, 544,
-		547, 1, 0, 0, 0, 545, 543, 1, 0, 0, 0, 546, 532, 1, 0, 0, 0, 546, 536,
-		1, 0, 0, 0, 546, 538, 1, 0, 0, 0, 547, 548, 1, 0, 0, 0, 548, 549, 5, 4,
-		0, 0, 549, 77, 1, 0, 0, 0, 550, 553, 3, 80, 40, 0, 551, 553, 3, 82, 41,
-		0, 552, 550, 1, 0, 0, 0, 552, 551, 1, 0, 0, 0, 553, 79, 1, 0, 0, 0, 554,
-		556, 5, 57, 0, 0, 555, 554, 1, 0, 0, 0, 556, 559, 1, 0, 0, 0, 557, 555,
-		1, 0, 0, 0, 557, 558, 1, 0, 0, 0, 558, 561, 1, 0, 0, 0, 559, 557, 1, 0,
-		0, 0, 560, 562, 5, 52, 0, 0, 561, 560, 1, 0, 0, 0, 561, 562, 1, 0, 0, 0,
-		562, 563, 1, 0, 0, 0, 563, 567, 3, 48, 24, 0, 564, 566, 5, 57, 0, 0, 565,
-		564, 1, 0, 0, 0, 566, 569, 1, 0, 0, 0, 567, 565, 1, 0, 0, 0, 567, 568,
-		1, 0, 0, 0, 568, 81, 1, 0, 0, 0, 569, 567, 1, 0, 0, 0, 570, 572, 5, 57,
-		0, 0, 571, 570, 1, 0, 0, 0, 572, 575, 1, 0, 0, 0, 573, 571, 1, 0, 0, 0,
-		573, 574, 1, 0, 0, 0, 574, 576, 1, 0, 0, 0, 575, 573, 1, 0, 0, 0, 576,
-		577, 5, 19, 0, 0, 577, 578, 5, 52, 0, 0, 578, 580, 5, 20, 0, 0, 579, 581,
-		3, 48, 24, 0, 580, 579, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 585, 1,
-		0, 0, 0, 582, 584, 5, 57, 0, 0, 583, 582, 1, 0, 0, 0, 584, 587, 1, 0, 0,
-		0, 585, 583, 1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 83, 1, 0, 0, 0, 587,
-		585, 1, 0, 0, 0, 588, 590, 5, 51, 0, 0, 589, 588, 1, 0, 0, 0, 589, 590,
-		1, 0, 0, 0, 590, 591, 1, 0, 0, 0, 591, 592, 5, 21, 0, 0, 592, 593, 3, 86,
-		43, 0, 593, 85, 1, 0, 0, 0, 594, 595, 5, 52, 0, 0, 595, 596, 3, 48, 24,
-		0, 596, 599, 5, 22, 0, 0, 597, 600, 3, 28, 14, 0, 598, 600, 3, 88, 44,
-		0, 599, 597, 1, 0, 0, 0, 599, 598, 1, 0, 0, 0, 600, 604, 1, 0, 0, 0, 601,
-		603, 5, 57, 0, 0, 602, 601, 1, 0, 0, 0, 603, 606, 1, 0, 0, 0, 604, 602,
-		1, 0, 0, 0, 604, 605, 1, 0, 0, 0, 605, 87, 1, 0, 0, 0, 606, 604, 1, 0,
-		0, 0, 607, 621, 3, 92, 46, 0, 608, 610, 5, 54, 0, 0, 609, 608, 1, 0, 0,
-		0, 609, 610, 1, 0, 0, 0, 610, 611, 1, 0, 0, 0, 611, 621, 5, 53, 0, 0, 612,
-		614, 5, 54, 0, 0, 613, 612, 1, 0, 0, 0, 613, 614, 1, 0, 0, 0, 614, 615,
-		1, 0, 0, 0, 615, 621, 5, 55, 0, 0, 616, 621, 5, 56, 0, 0, 617, 621, 3,
-		94, 47, 0, 618, 621, 3, 96, 48, 0, 619, 621, 3, 102, 51, 0, 620, 607, 1,
-		0, 0, 0, 620, 609, 1, 0, 0, 0, 620, 613, 1, 0, 0, 0, 620, 616, 1, 0, 0,
-		0, 620, 617, 1, 0, 0, 0, 620, 618, 1, 0, 0, 0, 620, 619, 1, 0, 0, 0, 621,
-		89, 1, 0, 0, 0, 622, 634, 3, 92, 46, 0, 623, 625, 5, 54, 0, 0, 624, 623,
-		1, 0, 0, 0, 624, 625, 1, 0, 0, 0, 625, 626, 1, 0, 0, 0, 626, 634, 5, 53,
-		0, 0, 627, 629, 5, 54, 0, 0, 628, 627, 1, 0, 0, 0, 628, 629, 1, 0, 0, 0,
-		629, 630, 1, 0, 0, 0, 630, 634, 5, 55, 0, 0, 631, 634, 5, 56, 0, 0, 632,
-		634, 3, 94, 47, 0, 633, 622, 1, 0, 0, 0, 633, 624, 1, 0, 0, 0, 633, 628,
-		1, 0, 0, 0, 633, 631, 1, 0, 0, 0, 633, 632, 1, 0, 0, 0, 634, 91, 1, 0,
-		0, 0, 635, 636, 7, 1, 0, 0, 636, 93, 1, 0, 0, 0, 637, 638, 3, 28, 14, 0,
-		638, 639, 5, 25, 0, 0, 639, 640, 5, 52, 0, 0, 640, 95, 1, 0, 0, 0, 641,
-		645, 5, 19, 0, 0, 642, 644, 5, 57, 0, 0, 643, 642, 1, 0, 0, 0, 644, 647,
-		1, 0, 0, 0, 645, 643, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646, 649, 1, 0,
-		0, 0, 647, 645, 1, 0, 0, 0, 648, 650, 3, 98, 49, 0, 649, 648, 1, 0, 0,
-		0, 649, 650, 1, 0, 0, 0, 650, 651, 1, 0, 0, 0, 651, 652, 5, 20, 0, 0, 652,
-		97, 1, 0, 0, 0, 653, 675, 3, 100, 50, 0, 654, 671, 3, 100, 50, 0, 655,
-		659, 5, 3, 0, 0, 656, 658, 5, 57, 0, 0, 657, 656, 1, 0, 0, 0, 658, 661,
-		1, 0, 0, 0, 659, 657, 1, 0, 0, 0, 659, 660, 1, 0, 0, 0, 660, 662, 1, 0,
-		0, 0, 661, 659, 1, 0, 0, 0, 662, 666, 3, 100, 50, 0, 663, 665, 5, 57, 0,
-		0, 664, 663, 1, 0, 0, 0, 665, 668, 1, 0, 0, 0, 666, 664, 1, 0, 0, 0, 666,
-		667, 1, 0, 0, 0, 667, 670, 1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 669, 655,
-		1, 0, 0, 0, 670, 673, 1, 0, 0, 0, 671, 669, 1, 0, 0, 0, 671, 672, 1, 0,
-		0, 0, 672, 675, 1, 0, 0, 0, 673, 671, 1, 0, 0, 0, 674, 653, 1, 0, 0, 0,
-		674, 654, 1, 0, 0, 0, 675, 99, 1, 0, 0, 0, 676, 679, 3, 28, 14, 0, 677,
-		679, 3, 88, 44, 0, 678, 676, 1, 0, 0, 0, 678, 677, 1, 0, 0, 0, 679, 101,
-		1, 0, 0, 0, 680, 684, 5, 6, 0, 0, 681, 683, 5, 57, 0, 0, 682, 681, 1, 0,
-		0, 0, 683, 686, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 684, 685, 1, 0, 0, 0,
-		685, 688, 1, 0, 0, 0, 686, 684, 1, 0, 0, 0, 687, 689, 3, 104, 52, 0, 688,
-		687, 1, 0, 0, 0, 688, 689, 1, 0, 0, 0, 689, 690, 1, 0, 0, 0, 690, 691,
-		5, 7, 0, 0, 691, 103, 1, 0, 0, 0, 692, 703, 3, 106, 53, 0, 693, 697, 5,
-		3, 0, 0, 694, 696, 5, 57, 0, 0, 695, 694, 1, 0, 0, 0, 696, 699, 1, 0, 0,
-		0, 697, 695, 1, 0, 0, 0, 697, 698, 1, 0, 0, 0, 698, 700, 1, 0, 0, 0, 699,
-		697, 1, 0, 0, 0, 700, 702, 3, 106, 53, 0, 701, 693, 1, 0, 0, 0, 702, 705,
-		1, 0, 0, 0, 703, 701, 1, 0, 0, 0, 703, 704, 1, 0, 0, 0, 704, 105, 1, 0,
-		0, 0, 705, 703, 1, 0, 0, 0, 706, 707, 5, 52, 0, 0, 707, 708, 5, 8, 0, 0,
-		708, 712, 3, 100, 50, 0, 709, 711, 5, 57, 0, 0, 710, 709, 1, 0, 0, 0, 711,
-		714, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0, 712, 713, 1, 0, 0, 0, 713, 107,
-		1, 0, 0, 0, 714, 712, 1, 0, 0, 0, 715, 717, 3, 4, 2, 0, 716, 715, 1, 0,
-		0, 0, 716, 717, 1, 0, 0, 0, 717, 719, 1, 0, 0, 0, 718, 720, 5, 51, 0, 0,
-		719, 718, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 721, 1, 0, 0, 0, 721,
-		722, 5, 26, 0, 0, 722, 723, 3, 110, 55, 0, 723, 109, 1, 0, 0, 0, 724, 726,
-		3, 70, 35, 0, 725, 727, 3, 112, 56, 0, 726, 725, 1, 0, 0, 0, 726, 727,
-		1, 0, 0, 0, 727, 731, 1, 0, 0, 0, 728, 730, 5, 57, 0, 0, 729, 728, 1, 0,
-		0, 0, 730, 733, 1, 0, 0, 0, 731, 729, 1, 0, 0, 0, 731, 732, 1, 0, 0, 0,
-		732, 111, 1, 0, 0, 0, 733, 731, 1, 0, 0, 0, 734, 738, 5, 6, 0, 0, 735,
-		737, 5, 57, 0, 0, 736, 735, 1, 0, 0, 0, 737, 740, 1, 0, 0, 0, 738, 736,
-		1, 0, 0, 0, 738, 739, 1, 0, 0, 0, 739, 750, 1, 0, 0, 0, 740, 738, 1, 0,
-		0, 0, 741, 745, 5, 50, 0, 0, 742, 744, 5, 57, 0, 0, 743, 742, 1, 0, 0,
-		0, 744, 747, 1, 0, 0, 0, 745, 743, 1, 0, 0, 0, 745, 746, 1, 0, 0, 0, 746,
-		749, 1, 0, 0, 0, 747, 745, 1, 0, 0, 0, 748, 741, 1, 0, 0, 0, 749, 752,
-		1, 0, 0, 0, 750, 748, 1, 0, 0, 0, 750, 751, 1, 0, 0, 0, 751, 760, 1, 0,
-		0, 0, 752, 750, 1, 0, 0, 0, 753, 757, 3, 114, 57, 0, 754, 756, 5, 57, 0,
-		0, 755, 754, 1, 0, 0, 0, 756, 759, 1, 0, 0, 0, 757, 755, 1, 0, 0, 0, 757,
-		758, 1, 0, 0, 0, 758, 761, 1, 0, 0, 0, 759, 757, 1, 0, 0, 0, 760, 753,
-		1, 0, 0, 0, 760, 761, 1, 0, 0, 0, 761, 771, 1, 0, 0, 0, 762, 766, 5, 50,
-		0, 0, 763, 765, 5, 57, 0, 0, 764, 763, 1, 0, 0, 0, 765, 768, 1, 0, 0, 0,
-		766, 764, 1, 0, 0, 0, 766, 767, 1, 0, 0, 0, 767, 770, 1, 0, 0, 0, 768,
-		766, 1, 0, 0, 0, 769, 762, 1, 0, 0, 0, 770, 773, 1, 0, 0, 0, 771, 769,
-		1, 0, 0, 0, 771, 772, 1, 0, 0, 0, 772, 781, 1, 0, 0, 0, 773, 771, 1, 0,
-		0, 0, 774, 778, 3, 126, 63, 0, 775, 777, 5, 57, 0, 0, 776, 775, 1, 0, 0,
-		0, 777, 780, 1, 0, 0, 0, 778, 776, 1, 0, 0, 0, 778, 779, 1, 0, 0, 0, 779,
-		782, 1, 0, 0, 0, 780, 778, 1, 0, 0, 0, 781, 774, 1, 0, 0, 0, 781, 782,
-		1, 0, 0, 0, 782, 792, 1, 0, 0, 0, 783, 787, 5, 50, 0, 0, 784, 786, 5, 57,
-		0, 0, 785, 784, 1, 0, 0, 0, 786, 789, 1, 0, 0, 0, 787, 785, 1, 0, 0, 0,
-		787, 788, 1, 0, 0, 0, 788, 791, 1, 0, 0, 0, 789, 787, 1, 0, 0, 0, 790,
-		783, 1, 0, 0, 0, 791, 794, 1, 0, 0, 0, 792, 790, 1, 0, 0, 0, 792, 793,
-		1, 0, 0, 0, 793, 795, 1, 0, 0, 0, 794, 792, 1, 0, 0, 0, 795, 796, 5, 7,
-		0, 0, 796, 113, 1, 0, 0, 0, 797, 799, 3, 116, 58, 0, 798, 800, 5, 57, 0,
-		0, 799, 798, 1, 0, 0, 0, 800, 801, 1, 0, 0, 0, 801, 799, 1, 0, 0, 0, 801,
-		802, 1, 0, 0, 0, 802, 803, 1, 0, 0, 0, 803, 804, 5, 27, 0, 0, 804, 115,
-		1, 0, 0, 0, 805, 807, 3, 118, 59, 0, 806, 808, 5, 3, 0, 0, 807, 806, 1,
-		0, 0, 0, 807, 808, 1, 0, 0, 0, 808, 811, 1, 0, 0, 0, 809, 811, 5, 50, 0,
-		0, 810, 805, 1, 0, 0, 0, 810, 809, 1, 0, 0, 0, 811, 815, 1, 0, 0, 0, 812,
-		814, 5, 57, 0, 0, 813, 812, 1, 0, 0, 0, 814, 817, 1, 0, 0, 0, 815, 813,
-		1, 0, 0, 0, 815, 816, 1, 0, 0, 0, 816, 819, 1, 0, 0, 0, 817, 815, 1, 0,
-		0, 0, 818, 810, 1, 0, 0, 0, 819, 820, 1, 0, 0, 0, 820, 818, 1, 0, 0, 0,
-		820, 821, 1, 0, 0, 0, 821, 117, 1, 0, 0, 0, 822, 824, 3, 4, 2, 0, 823,
-		822, 1, 0, 0, 0, 823, 824, 1, 0, 0, 0, 824, 826, 1, 0, 0, 0, 825, 827,
-		5, 52, 0, 0, 826, 825, 1, 0, 0, 0, 826, 827, 1, 0, 0, 0, 827, 828, 1, 0,
-		0, 0, 828, 829, 3, 120, 60, 0, 829, 119, 1, 0, 0, 0, 830, 834, 3, 28, 14,
-		0, 831, 833, 5, 57, 0, 0, 832, 831, 1, 0, 0, 0, 833, 836, 1, 0, 0, 0, 834,
-		832, 1, 0, 0, 0, 834, 835, 1, 0, 0, 0, 835, 838, 1, 0, 0, 0, 836, 834,
-		1, 0, 0, 0, 837, 839, 3, 52, 26, 0, 838, 837, 1, 0, 0, 0, 838, 839, 1,
-		0, 0, 0, 839, 843, 1, 0, 0, 0, 840, 842, 5, 57, 0, 0, 841, 840, 1, 0, 0,
-		0, 842, 845, 1, 0, 0, 0, 843, 841, 1, 0, 0, 0, 843, 844, 1, 0, 0, 0, 844,
-		847, 1, 0, 0, 0, 845, 843, 1, 0, 0, 0, 846, 848, 3, 124, 62, 0, 847, 846,
-		1, 0, 0, 0, 847, 848, 1, 0, 0, 0, 848, 850, 1, 0, 0, 0, 849, 851, 3, 122,
-		61, 0, 850, 849, 1, 0, 0, 0, 850, 851, 1, 0, 0, 0, 851, 121, 1, 0, 0, 0,
-		852, 853, 5, 28, 0, 0, 853, 123, 1, 0, 0, 0, 854, 858, 5, 6, 0, 0, 855,
-		857, 5, 57, 0, 0, 856, 855, 1, 0, 0, 0, 857, 860, 1, 0, 0, 0, 858, 856,
-		1, 0, 0, 0, 858, 859, 1, 0, 0, 0, 859, 861, 1, 0, 0, 0, 860, 858, 1, 0,
-		0, 0, 861, 862, 3, 116, 58, 0, 862, 863, 5, 7, 0, 0, 863, 125, 1, 0, 0,
-		0, 864, 867, 3, 128, 64, 0, 865, 867, 5, 50, 0, 0, 866, 864, 1, 0, 0, 0,
-		866, 865, 1, 0, 0, 0, 867, 880, 1, 0, 0, 0, 868, 870, 5, 57, 0, 0, 869,
-		868, 1, 0, 0, 0, 870, 873, 1, 0, 0, 0, 871, 869, 1, 0, 0, 0, 871, 872,
-		1, 0, 0, 0, 872, 876, 1, 0, 0, 0, 873, 871, 1, 0, 0, 0, 874, 877, 3, 128,
-		64, 0, 875, 877, 5, 50, 0, 0, 876, 874, 1, 0, 0, 0, 876, 875, 1, 0, 0,
-		0, 877, 879, 1, 0, 0, 0, 878, 871, 1, 0, 0, 0, 879, 882, 1, 0, 0, 0, 880,
-		878, 1, 0, 0, 0, 880, 881, 1, 0, 0, 0, 881, 127, 1, 0, 0, 0, 882, 880,
-		1, 0, 0, 0, 883, 886, 3, 130, 65, 0, 884, 886, 3, 136, 68, 0, 885, 883,
-		1, 0, 0, 0, 885, 884, 1, 0, 0, 0, 886, 129, 1, 0, 0, 0, 887, 888, 3, 132,
-		66, 0, 888, 889, 5, 29, 0, 0, 889, 890, 3, 150, 75, 0, 890, 131, 1, 0,
-		0, 0, 891, 894, 3, 138, 69, 0, 892, 894, 3, 134, 67, 0, 893, 891, 1, 0,
-		0, 0, 893, 892, 1, 0, 0, 0, 894, 133, 1, 0, 0, 0, 895, 899, 5, 19, 0, 0,
-		896, 898, 5, 57, 0, 0, 897, 896, 1, 0, 0, 0, 898, 901, 1, 0, 0, 0, 899,
-		897, 1, 0, 0, 0, 899, 900, 1, 0, 0, 0, 900, 902, 1, 0, 0, 0, 901, 899,
-		1, 0, 0, 0, 902, 919, 3, 138, 69, 0, 903, 907, 5, 3, 0, 0, 904, 906, 5,
-		57, 0, 0, 905, 904, 1, 0, 0, 0, 906, 909, 1, 0, 0, 0, 907, 905, 1, 0, 0,
-		0, 907, 908, 1, 0, 0, 0, 908, 910, 1, 0, 0, 0, 909, 907, 1, 0, 0, 0, 910,
-		914, 3, 138, 69, 0, 911, 913, 5, 57, 0, 0, 912, 911, 1, 0, 0, 0, 913, 916,
-		1, 0, 0, 0, 914, 912, 1, 0, 0, 0, 914, 915, 1, 0, 0, 0, 915, 918, 1, 0,
-		0, 0, 916, 914, 1, 0, 0, 0, 917, 903, 1, 0, 0, 0, 918, 921, 1, 0, 0, 0,
-		919, 917, 1, 0, 0, 0, 919, 920, 1, 0, 0, 0, 920, 922, 1, 0, 0, 0, 921,
-		919, 1, 0, 0, 0, 922, 923, 5, 20, 0, 0, 923, 135, 1, 0, 0, 0, 924, 925,
-		3, 168, 84, 0, 925, 926, 5, 30, 0, 0, 926, 927, 3, 168, 84, 0, 927, 137,
-		1, 0, 0, 0, 928, 937, 3, 162, 81, 0, 929, 937, 3, 156, 78, 0, 930, 937,
-		3, 90, 45, 0, 931, 937, 3, 158, 79, 0, 932, 937, 3, 178, 89, 0, 933, 937,
-		3, 140, 70, 0, 934, 937, 3, 146, 73, 0, 935, 937, 3, 144, 72, 0, 936, 928,
-		1, 0, 0, 0, 936, 929, 1, 0, 0, 0, 936, 930, 1, 0, 0, 0, 936, 931, 1, 0,
-		0, 0, 936, 932, 1, 0, 0, 0, 936, 933, 1, 0, 0, 0, 936, 934, 1, 0, 0, 0,
-		936, 935, 1, 0, 0, 0, 937, 139, 1, 0, 0, 0, 938, 939, 3, 142, 71, 0, 939,
-		940, 3, 138, 69, 0, 940, 141, 1, 0, 0, 0, 941, 942, 7, 2, 0, 0, 942, 143,
-		1, 0, 0, 0, 943, 944, 5, 2, 0, 0, 944, 945, 3, 138, 69, 0, 945, 946, 5,
-		28, 0, 0, 946, 947, 3, 138, 69, 0, 947, 948, 5, 8, 0, 0, 948, 949, 3, 138,
-		69, 0, 949, 950, 5, 4, 0, 0, 950, 145, 1, 0, 0, 0, 951, 952, 5, 2, 0, 0,
-		952, 953, 3, 138, 69, 0, 953, 954, 3, 148, 74, 0, 954, 955, 3, 138, 69,
-		0, 955, 956, 5, 4, 0, 0, 956, 147, 1, 0, 0, 0, 957, 958, 7, 3, 0, 0, 958,
-		149, 1, 0, 0, 0, 959, 962, 3, 180, 90, 0, 960, 962, 3, 182, 91, 0, 961,
-		959, 1, 0, 0, 0, 961, 960, 1, 0, 0, 0, 962, 151, 1, 0, 0, 0, 963, 964,
-		3, 130, 65, 0, 964, 153, 1, 0, 0, 0, 965, 969, 5, 6, 0, 0, 966, 968, 5,
-		57, 0, 0, 967, 966, 1, 0, 0, 0, 968, 971, 1, 0, 0, 0, 969, 967, 1, 0, 0,
-		0, 969, 970, 1, 0, 0, 0, 970, 972, 1, 0, 0, 0, 971, 969, 1, 0, 0, 0, 972,
-		976, 3, 128, 64, 0, 973, 975, 5, 57, 0, 0, 974, 973, 1, 0, 0, 0, 975, 978,
-		1, 0, 0, 0, 976, 974, 1, 0, 0, 0, 976, 977, 1, 0, 0, 0, 977, 979, 1, 0,
-		0, 0, 978, 976, 1, 0, 0, 0, 979, 980, 5, 7, 0, 0, 980, 155, 1, 0, 0, 0,
-		981, 982, 5, 46, 0, 0, 982, 983, 3, 28, 14, 0, 983, 157, 1, 0, 0, 0, 984,
-		985, 3, 160, 80, 0, 985, 986, 5, 47, 0, 0, 986, 987, 3, 160, 80, 0, 987,
-		159, 1, 0, 0, 0, 988, 990, 5, 54, 0, 0, 989, 988, 1, 0, 0, 0, 989, 990,
-		1, 0, 0, 0, 990, 991, 1, 0, 0, 0, 991, 992, 5, 53, 0, 0, 992, 161, 1, 0,
-		0, 0, 993, 998, 3, 168, 84, 0, 994, 998, 3, 170, 85, 0, 995, 998, 3, 164,
-		82, 0, 996, 998, 3, 166, 83, 0, 997, 993, 1, 0, 0, 0, 997, 994, 1, 0, 0,
-		0, 997, 995, 1, 0, 0, 0, 997, 996, 1, 0, 0, 0, 998, 163, 1, 0, 0, 0, 999,
-		1000, 3, 172, 86, 0, 1000, 165, 1, 0, 0, 0, 1001, 1002, 3, 172, 86, 0,
-		1002, 1003, 3, 176, 88, 0, 1003, 167, 1, 0, 0, 0, 1004, 1006, 3, 172, 86,
-		0, 1005, 1004, 1, 0, 0, 0, 1005, 1006, 1, 0, 0, 0, 1006, 1007, 1, 0, 0,
-		0, 1007, 1008, 5, 8, 0, 0, 1008, 1009, 3, 174, 87, 0, 1009, 169, 1, 0,
-		0, 0, 1010, 1012, 3, 172, 86, 0, 1011, 1010, 1, 0, 0, 0, 1011, 1012, 1,
-		0, 0, 0, 1012, 1013, 1, 0, 0, 0, 1013, 1014, 5, 8, 0, 0, 1014, 1015, 3,
-		174, 87, 0, 1015, 1016, 3, 176, 88, 0, 1016, 171, 1, 0, 0, 0, 1017, 1018,
-		5, 52, 0, 0, 1018, 173, 1, 0, 0, 0, 1019, 1020, 5, 52, 0, 0, 1020, 175,
-		1, 0, 0, 0, 1021, 1022, 5, 19, 0, 0, 1022, 1023, 5, 53, 0, 0, 1023, 1024,
-		5, 20, 0, 0, 1024, 177, 1, 0, 0, 0, 1025, 1026, 5, 11, 0, 0, 1026, 1031,
-		5, 52, 0, 0, 1027, 1028, 5, 11, 0, 0, 1028, 1030, 5, 52, 0, 0, 1029, 1027,
-		1, 0, 0, 0, 1030, 1033, 1, 0, 0, 0, 1031, 1029, 1, 0, 0, 0, 1031, 1032,
-		1, 0, 0, 0, 1032, 179, 1, 0, 0, 0, 1033, 1031, 1, 0, 0, 0, 1034, 1039,
-		3, 152, 76, 0, 1035, 1039, 3, 162, 81, 0, 1036, 1039, 3, 154, 77, 0, 1037,
-		1039, 3, 184, 92, 0, 1038, 1034, 1, 0, 0, 0, 1038, 1035, 1, 0, 0, 0, 1038,
-		1036, 1, 0, 0, 0, 1038, 1037, 1, 0, 0, 0, 1039, 181, 1, 0, 0, 0, 1040,
-		1044, 5, 19, 0, 0, 1041, 1043, 5, 57, 0, 0, 1042, 1041, 1, 0, 0, 0, 1043,
-		1046, 1, 0, 0, 0, 1044, 1042, 1, 0, 0, 0, 1044, 1045, 1, 0, 0, 0, 1045,
-		1047, 1, 0, 0, 0, 1046, 1044, 1, 0, 0, 0, 1047, 1064, 3, 180, 90, 0, 1048,
-		1052, 5, 3, 0, 0, 1049, 1051, 5, 57, 0, 0, 1050, 1049, 1, 0, 0, 0, 1051,
-		1054, 1, 0, 0, 0, 1052, 1050, 1, 0, 0, 0, 1052, 1053, 1, 0, 0, 0, 1053,
-		1055, 1, 0, 0, 0, 1054, 1052, 1, 0, 0, 0, 1055, 1059, 3, 180, 90, 0, 1056,
-		1058, 5, 57, 0, 0, 1057, 1056, 1, 0, 0, 0, 1058, 1061, 1, 0, 0, 0, 1059,
-		1057, 1, 0, 0, 0, 1059, 1060, 1, 0, 0, 0, 1060, 1063, 1, 0, 0, 0, 1061,
-		1059, 1, 0, 0, 0, 1062, 1048, 1, 0, 0, 0, 1063, 1066, 1, 0, 0, 0, 1064,
-		1062, 1, 0, 0, 0, 1064, 1065, 1, 0, 0, 0, 1065, 1067, 1, 0, 0, 0, 1066,
-		1064, 1, 0, 0, 0, 1067, 1068, 5, 20, 0, 0, 1068, 183, 1, 0, 0, 0, 1069,
-		1073, 5, 48, 0, 0, 1070, 1072, 5, 57, 0, 0, 1071, 1070, 1, 0, 0, 0, 1072,
-		1075, 1, 0, 0, 0, 1073, 1071, 1, 0, 0, 0, 1073, 1074, 1, 0, 0, 0, 1074,
-		1076, 1, 0, 0, 0, 1075, 1073, 1, 0, 0, 0, 1076, 1080, 5, 6, 0, 0, 1077,
-		1079, 5, 57, 0, 0, 1078, 1077, 1, 0, 0, 0, 1079, 1082, 1, 0, 0, 0, 1080,
-		1078, 1, 0, 0, 0, 1080, 1081, 1, 0, 0, 0, 1081, 1083, 1, 0, 0, 0, 1082,
-		1080, 1, 0, 0, 0, 1083, 1092, 3, 130, 65, 0, 1084, 1086, 5, 57, 0, 0, 1085,
-		1084, 1, 0, 0, 0, 1086, 1087, 1, 0, 0, 0, 1087, 1085, 1, 0, 0, 0, 1087,
-		1088, 1, 0, 0, 0, 1088, 1089, 1, 0, 0, 0, 1089, 1091, 3, 130, 65, 0, 1090,
-		1085, 1, 0, 0, 0, 1091, 1094, 1, 0, 0, 0, 1092, 1090, 1, 0, 0, 0, 1092,
-		1093, 1, 0, 0, 0, 1093, 1101, 1, 0, 0, 0, 1094, 1092, 1, 0, 0, 0, 1095,
-		1097, 5, 57, 0, 0, 1096, 1095, 1, 0, 0, 0, 1097, 1098, 1, 0, 0, 0, 1098,
-		1096, 1, 0, 0, 0, 1098, 1099, 1, 0, 0, 0, 1099, 1100, 1, 0, 0, 0, 1100,
-		1102, 3, 186, 93, 0, 1101, 1096, 1, 0, 0, 0, 1101, 1102, 1, 0, 0, 0, 1102,
-		1106, 1, 0, 0, 0, 1103, 1105, 5, 57, 0, 0, 1104, 1103, 1, 0, 0, 0, 1105,
-		1108, 1, 0, 0, 0, 1106, 1104, 1, 0, 0, 0, 1106, 1107, 1, 0, 0, 0, 1107,
-		1109, 1, 0, 0, 0, 1108, 1106, 1, 0, 0, 0, 1109, 1110, 5, 7, 0, 0, 1110,
-		185, 1, 0, 0, 0, 1111, 1112, 5, 49, 0, 0, 1112, 1113, 5, 29, 0, 0, 1113,
-		1114, 3, 150, 75, 0, 1114, 187, 1, 0, 0, 0, 144, 191, 193, 203, 210, 215,
-		223, 231, 237, 244, 250, 256, 260, 265, 273, 279, 287, 297, 302, 315, 322,
-		325, 328, 334, 338, 347, 353, 358, 363, 369, 373, 379, 387, 393, 399, 406,
-		412, 419, 427, 433, 439, 448, 455, 459, 467, 472, 480, 487, 494, 500, 504,
-		507, 514, 521, 532, 536, 543, 546, 552, 557, 561, 567, 573, 580, 585, 589,
-		599, 604, 609, 613, 620, 624, 628, 633, 645, 649, 659, 666, 671, 674, 678,
-		684, 688, 697, 703, 712, 716, 719, 726, 731, 738, 745, 750, 757, 760, 766,
-		771, 778, 781, 787, 792, 801, 807, 810, 815, 820, 823, 826, 834, 838, 843,
-		847, 850, 858, 866, 871, 876, 880, 885, 893, 899, 907, 914, 919, 936, 961,
-		969, 976, 989, 997, 1005, 1011, 1031, 1038, 1044, 1052, 1059, 1064, 1073,
-		1080, 1087, 1092, 1098, 1101, 1106,
+		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
+		94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99,
+		2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 1, 0, 1, 0, 1, 0, 5, 0,
+		210, 8, 0, 10, 0, 12, 0, 213, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 3, 1, 222, 8, 1, 1, 2, 1, 2, 1, 2, 4, 2, 227, 8, 2, 11, 2, 12, 2,
+		228, 1, 3, 1, 3, 1, 3, 3, 3, 234, 8, 3, 1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 240,
+		8, 4, 10, 4, 12, 4, 243, 9, 4, 1, 4, 1, 4, 1, 5, 4, 5, 248, 8, 5, 11, 5,
+		12, 5, 249, 1, 5, 3, 5, 253, 8, 5, 1, 6, 1, 6, 5, 6, 257, 8, 6, 10, 6,
+		12, 6, 260, 9, 6, 1, 6, 1, 6, 5, 6, 264, 8, 6, 10, 6, 12, 6, 267, 9, 6,
+		1, 6, 5, 6, 270, 8, 6, 10, 6, 12, 6, 273, 9, 6, 1, 6, 1, 6, 1, 7, 3, 7,
+		278, 8, 7, 1, 7, 1, 7, 3, 7, 282, 8, 7, 1, 7, 5, 7, 285, 8, 7, 10, 7, 12,
+		7, 288, 9, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 3, 9, 295, 8, 9, 1, 9, 1, 9,
+		1, 10, 1, 10, 3, 10, 301, 8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 307,
+		8, 11, 10, 11, 12, 11, 310, 9, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 5,
+		13, 317, 8, 13, 10, 13, 12, 13, 320, 9, 13, 1, 14, 1, 14, 3, 14, 324, 8,
+		14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18,
+		1, 19, 3, 19, 337, 8, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 3, 20, 344,
+		8, 20, 1, 20, 3, 20, 347, 8, 20, 1, 20, 3, 20, 350, 8, 20, 1, 21, 1, 21,
+		5, 21, 354, 8, 21, 10, 21, 12, 21, 357, 9, 21, 1, 21, 3, 21, 360, 8, 21,
+		1, 21, 1, 21, 1, 21, 5, 21, 365, 8, 21, 10, 21, 12, 21, 368, 9, 21, 1,
+		21, 1, 21, 1, 21, 5, 21, 373, 8, 21, 10, 21, 12, 21, 376, 9, 21, 5, 21,
+		378, 8, 21, 10, 21, 12, 21, 381, 9, 21, 1, 21, 1, 21, 1, 21, 5, 21, 386,
+		8, 21, 10, 21, 12, 21, 389, 9, 21, 1, 21, 1, 21, 3, 21, 393, 8, 21, 1,
+		22, 1, 22, 1, 22, 5, 22, 398, 8, 22, 10, 22, 12, 22, 401, 9, 22, 1, 22,
+		5, 22, 404, 8, 22, 10, 22, 12, 22, 407, 9, 22, 1, 23, 1, 23, 3, 23, 411,
+		8, 23, 1, 23, 5, 23, 414, 8, 23, 10, 23, 12, 23, 417, 9, 23, 1, 24, 1,
+		24, 1, 24, 3, 24, 422, 8, 24, 1, 25, 1, 25, 3, 25, 426, 8, 25, 1, 26, 1,
+		26, 5, 26, 430, 8, 26, 10, 26, 12, 26, 433, 9, 26, 1, 26, 1, 26, 1, 26,
+		5, 26, 438, 8, 26, 10, 26, 12, 26, 441, 9, 26, 1, 26, 5, 26, 444, 8, 26,
+		10, 26, 12, 26, 447, 9, 26, 1, 26, 5, 26, 450, 8, 26, 10, 26, 12, 26, 453,
+		9, 26, 1, 26, 1, 26, 1, 26, 1, 26, 5, 26, 459, 8, 26, 10, 26, 12, 26, 462,
+		9, 26, 1, 26, 1, 26, 1, 26, 5, 26, 467, 8, 26, 10, 26, 12, 26, 470, 9,
+		26, 5, 26, 472, 8, 26, 10, 26, 12, 26, 475, 9, 26, 1, 26, 1, 26, 5, 26,
+		479, 8, 26, 10, 26, 12, 26, 482, 9, 26, 1, 26, 1, 26, 3, 26, 486, 8, 26,
+		1, 27, 1, 27, 5, 27, 490, 8, 27, 10, 27, 12, 27, 493, 9, 27, 1, 27, 1,
+		27, 5, 27, 497, 8, 27, 10, 27, 12, 27, 500, 9, 27, 5, 27, 502, 8, 27, 10,
+		27, 12, 27, 505, 9, 27, 1, 27, 1, 27, 1, 27, 5, 27, 510, 8, 27, 10, 27,
+		12, 27, 513, 9, 27, 1, 27, 1, 27, 1, 27, 5, 27, 518, 8, 27, 10, 27, 12,
+		27, 521, 9, 27, 1, 27, 5, 27, 524, 8, 27, 10, 27, 12, 27, 527, 9, 27, 1,
+		28, 1, 28, 1, 28, 3, 28, 532, 8, 28, 1, 29, 1, 29, 5, 29, 536, 8, 29, 10,
+		29, 12, 29, 539, 9, 29, 1, 29, 1, 29, 5, 29, 543, 8, 29, 10, 29, 12, 29,
+		546, 9, 29, 1, 29, 1, 29, 1, 29, 5, 29, 551, 8, 29, 10, 29, 12, 29, 554,
+		9, 29, 1, 29, 5, 29, 557, 8, 29, 10, 29, 12, 29, 560, 9, 29, 1, 29, 5,
+		29, 563, 8, 29, 10, 29, 12, 29, 566, 9, 29, 1, 29, 1, 29, 1, 30, 1, 30,
+		5, 30, 572, 8, 30, 10, 30, 12, 30, 575, 9, 30, 1, 30, 1, 30, 5, 30, 579,
+		8, 30, 10, 30, 12, 30, 582, 9, 30, 1, 30, 3, 30, 585, 8, 30, 1, 30, 1,
+		30, 1, 31, 1, 31, 4, 31, 591, 8, 31, 11, 31, 12, 31, 592, 1, 31, 5, 31,
+		596, 8, 31, 10, 31, 12, 31, 599, 9, 31, 1, 32, 1, 32, 1, 32, 5, 32, 604,
+		8, 32, 10, 32, 12, 32, 607, 9, 32, 1, 33, 1, 33, 5, 33, 611, 8, 33, 10,
+		33, 12, 33, 614, 9, 33, 1, 33, 1, 33, 5, 33, 618, 8, 33, 10, 33, 12, 33,
+		621, 9, 33, 1, 33, 3, 33, 624, 8, 33, 1, 33, 1, 33, 1, 34, 1, 34, 4, 34,
+		630, 8, 34, 11, 34, 12, 34, 631, 1, 34, 5, 34, 635, 8, 34, 10, 34, 12,
+		34, 638, 9, 34, 1, 35, 1, 35, 3, 35, 642, 8, 35, 1, 35, 5, 35, 645, 8,
+		35, 10, 35, 12, 35, 648, 9, 35, 1, 36, 1, 36, 5, 36, 652, 8, 36, 10, 36,
+		12, 36, 655, 9, 36, 1, 36, 1, 36, 5, 36, 659, 8, 36, 10, 36, 12, 36, 662,
+		9, 36, 1, 36, 4, 36, 665, 8, 36, 11, 36, 12, 36, 666, 1, 37, 1, 37, 3,
+		37, 671, 8, 37, 1, 38, 3, 38, 674, 8, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1,
+		39, 3, 39, 681, 8, 39, 1, 39, 1, 39, 1, 39, 5, 39, 686, 8, 39, 10, 39,
+		12, 39, 689, 9, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 5, 42, 697,
+		8, 42, 10, 42, 12, 42, 700, 9, 42, 1, 42, 3, 42, 703, 8, 42, 1, 42, 1,
+		42, 1, 42, 5, 42, 708, 8, 42, 10, 42, 12, 42, 711, 9, 42, 3, 42, 713, 8,
+		42, 1, 42, 1, 42, 1, 43, 1, 43, 3, 43, 719, 8, 43, 1, 44, 5, 44, 722, 8,
+		44, 10, 44, 12, 44, 725, 9, 44, 1, 44, 3, 44, 728, 8, 44, 1, 44, 1, 44,
+		5, 44, 732, 8, 44, 10, 44, 12, 44, 735, 9, 44, 1, 45, 5, 45, 738, 8, 45,
+		10, 45, 12, 45, 741, 9, 45, 1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 747, 8,
+		45, 1, 45, 5, 45, 750, 8, 45, 10, 45, 12, 45, 753, 9, 45, 1, 46, 3, 46,
+		756, 8, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 5,
+		47, 766, 8, 47, 10, 47, 12, 47, 769, 9, 47, 1, 48, 1, 48, 1, 48, 1, 48,
+		5, 48, 775, 8, 48, 10, 48, 12, 48, 778, 9, 48, 1, 49, 1, 49, 1, 49, 1,
+		49, 1, 49, 1, 49, 3, 49, 786, 8, 49, 1, 50, 1, 50, 3, 50, 790, 8, 50, 1,
+		50, 1, 50, 3, 50, 794, 8, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 3, 50,
+		801, 8, 50, 1, 51, 1, 51, 3, 51, 805, 8, 51, 1, 51, 1, 51, 3, 51, 809,
+		8, 51, 1, 51, 1, 51, 1, 51, 3, 51, 814, 8, 51, 1, 52, 1, 52, 1, 53, 1,
+		53, 1, 53, 1, 53, 1, 54, 1, 54, 5, 54, 824, 8, 54, 10, 54, 12, 54, 827,
+		9, 54, 1, 54, 3, 54, 830, 8, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1,
+		55, 5, 55, 838, 8, 55, 10, 55, 12, 55, 841, 9, 55, 1, 55, 1, 55, 5, 55,
+		845, 8, 55, 10, 55, 12, 55, 848, 9, 55, 5, 55, 850, 8, 55, 10, 55, 12,
+		55, 853, 9, 55, 3, 55, 855, 8, 55, 1, 56, 1, 56, 3, 56, 859, 8, 56, 1,
+		57, 1, 57, 5, 57, 863, 8, 57, 10, 57, 12, 57, 866, 9, 57, 1, 57, 3, 57,
+		869, 8, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 5, 58, 876, 8, 58, 10, 58,
+		12, 58, 879, 9, 58, 1, 58, 5, 58, 882, 8, 58, 10, 58, 12, 58, 885, 9, 58,
+		1, 59, 1, 59, 1, 59, 1, 59, 5, 59, 891, 8, 59, 10, 59, 12, 59, 894, 9,
+		59, 1, 60, 3, 60, 897, 8, 60, 1, 60, 3, 60, 900, 8, 60, 1, 60, 1, 60, 1,
+		60, 1, 61, 1, 61, 3, 61, 907, 8, 61, 1, 61, 5, 61, 910, 8, 61, 10, 61,
+		12, 61, 913, 9, 61, 1, 62, 1, 62, 5, 62, 917, 8, 62, 10, 62, 12, 62, 920,
+		9, 62, 1, 62, 1, 62, 5, 62, 924, 8, 62, 10, 62, 12, 62, 927, 9, 62, 5,
+		62, 929, 8, 62, 10, 62, 12, 62, 932, 9, 62, 1, 62, 1, 62, 5, 62, 936, 8,
+		62, 10, 62, 12, 62, 939, 9, 62, 3, 62, 941, 8, 62, 1, 62, 1, 62, 5, 62,
+		945, 8, 62, 10, 62, 12, 62, 948, 9, 62, 5, 62, 950, 8, 62, 10, 62, 12,
+		62, 953, 9, 62, 1, 62, 1, 62, 5, 62, 957, 8, 62, 10, 62, 12, 62, 960, 9,
+		62, 3, 62, 962, 8, 62, 1, 62, 1, 62, 5, 62, 966, 8, 62, 10, 62, 12, 62,
+		969, 9, 62, 5, 62, 971, 8, 62, 10, 62, 12, 62, 974, 9, 62, 1, 62, 1, 62,
+		1, 63, 1, 63, 4, 63, 980, 8, 63, 11, 63, 12, 63, 981, 1, 63, 1, 63, 1,
+		64, 1, 64, 3, 64, 988, 8, 64, 1, 64, 3, 64, 991, 8, 64, 1, 64, 5, 64, 994,
+		8, 64, 10, 64, 12, 64, 997, 9, 64, 4, 64, 999, 8, 64, 11, 64, 12, 64, 1000,
+		1, 65, 3, 65, 1004, 8, 65, 1, 65, 3, 65, 1007, 8, 65, 1, 65, 1, 65, 3,
+		65, 1011, 8, 65, 1, 66, 1, 66, 5, 66, 1015, 8, 66, 10, 66, 12, 66, 1018,
+		9, 66, 1, 66, 3, 66, 1021, 8, 66, 1, 66, 5, 66, 1024, 8, 66, 10, 66, 12,
+		66, 1027, 9, 66, 1, 66, 3, 66, 1030, 8, 66, 1, 66, 3, 66, 1033, 8, 66,
+		1, 67, 1, 67, 1, 68, 1, 68, 5, 68, 1039, 8, 68, 10, 68, 12, 68, 1042, 9,
+		68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 5, 69, 1051, 8, 69,
+		10, 69, 12, 69, 1054, 9, 69, 1, 69, 1, 69, 1, 70, 1, 70, 3, 70, 1060, 8,
+		70, 1, 70, 5, 70, 1063, 8, 70, 10, 70, 12, 70, 1066, 9, 70, 1, 70, 1, 70,
+		3, 70, 1070, 8, 70, 5, 70, 1072, 8, 70, 10, 70, 12, 70, 1075, 9, 70, 1,
+		71, 1, 71, 3, 71, 1079, 8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73,
+		3, 73, 1087, 8, 73, 1, 74, 1, 74, 5, 74, 1091, 8, 74, 10, 74, 12, 74, 1094,
+		9, 74, 1, 74, 1, 74, 1, 74, 5, 74, 1099, 8, 74, 10, 74, 12, 74, 1102, 9,
+		74, 1, 74, 1, 74, 5, 74, 1106, 8, 74, 10, 74, 12, 74, 1109, 9, 74, 5, 74,
+		1111, 8, 74, 10, 74, 12, 74, 1114, 9, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1,
+		75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76,
+		1, 76, 1, 76, 3, 76, 1133, 8, 76, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1,
+		79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80,
+		1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82, 3, 82, 1158, 8, 82, 1,
+		83, 1, 83, 1, 84, 1, 84, 5, 84, 1164, 8, 84, 10, 84, 12, 84, 1167, 9, 84,
+		1, 84, 1, 84, 5, 84, 1171, 8, 84, 10, 84, 12, 84, 1174, 9, 84, 1, 84, 1,
+		84, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 3, 86, 1186,
+		8, 86, 1, 87, 3, 87, 1189, 8, 87, 1, 87, 1, 87, 1, 87, 3, 87, 1194, 8,
+		87, 1, 88, 1, 88, 1, 88, 1, 88, 3, 88, 1200, 8, 88, 1, 89, 1, 89, 1, 90,
+		1, 90, 1, 90, 1, 91, 3, 91, 1208, 8, 91, 1, 91, 1, 91, 1, 91, 1, 92, 3,
+		92, 1214, 8, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94, 1, 94,
+		1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 5, 96, 1232, 8,
+		96, 10, 96, 12, 96, 1235, 9, 96, 1, 97, 1, 97, 1, 97, 1, 97, 3, 97, 1241,
+		8, 97, 1, 98, 1, 98, 5, 98, 1245, 8, 98, 10, 98, 12, 98, 1248, 9, 98, 1,
+		98, 1, 98, 1, 98, 5, 98, 1253, 8, 98, 10, 98, 12, 98, 1256, 9, 98, 1, 98,
+		1, 98, 5, 98, 1260, 8, 98, 10, 98, 12, 98, 1263, 9, 98, 5, 98, 1265, 8,
+		98, 10, 98, 12, 98, 1268, 9, 98, 1, 98, 1, 98, 1, 99, 1, 99, 5, 99, 1274,
+		8, 99, 10, 99, 12, 99, 1277, 9, 99, 1, 99, 1, 99, 5, 99, 1281, 8, 99, 10,
+		99, 12, 99, 1284, 9, 99, 1, 99, 1, 99, 4, 99, 1288, 8, 99, 11, 99, 12,
+		99, 1289, 1, 99, 5, 99, 1293, 8, 99, 10, 99, 12, 99, 1296, 9, 99, 1, 99,
+		4, 99, 1299, 8, 99, 11, 99, 12, 99, 1300, 1, 99, 3, 99, 1304, 8, 99, 1,
+		99, 5, 99, 1307, 8, 99, 10, 99, 12, 99, 1310, 9, 99, 1, 99, 1, 99, 1, 100,
+		1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 5, 101, 1320, 8, 101, 10, 101,
+		12, 101, 1323, 9, 101, 1, 101, 1, 101, 1, 101, 5, 101, 1328, 8, 101, 10,
+		101, 12, 101, 1331, 9, 101, 1, 101, 1, 101, 5, 101, 1335, 8, 101, 10, 101,
+		12, 101, 1338, 9, 101, 5, 101, 1340, 8, 101, 10, 101, 12, 101, 1343, 9,
+		101, 3, 101, 1345, 8, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1, 102,
+		1, 102, 5, 102, 1354, 8, 102, 10, 102, 12, 102, 1357, 9, 102, 1, 102, 1,
+		102, 5, 102, 1361, 8, 102, 10, 102, 12, 102, 1364, 9, 102, 1, 102, 1, 102,
+		1, 102, 0, 0, 103, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
+		30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
+		66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100,
+		102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130,
+		132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160,
+		162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190,
+		192, 194, 196, 198, 200, 202, 204, 0, 6, 1, 0, 10, 11, 1, 0, 25, 26, 2,
+		0, 55, 55, 59, 59, 2, 0, 33, 35, 57, 57, 4, 0, 10, 10, 13, 16, 36, 48,
+		57, 57, 2, 0, 56, 56, 58, 58, 1474, 0, 211, 1, 0, 0, 0, 2, 221, 1, 0, 0,
+		0, 4, 226, 1, 0, 0, 0, 6, 230, 1, 0, 0, 0, 8, 235, 1, 0, 0, 0, 10, 252,
+		1, 0, 0, 0, 12, 254, 1, 0, 0, 0, 14, 277, 1, 0, 0, 0, 16, 289, 1, 0, 0,
+		0, 18, 294, 1, 0, 0, 0, 20, 300, 1, 0, 0, 0, 22, 302, 1, 0, 0, 0, 24, 311,
+		1, 0, 0, 0, 26, 313, 1, 0, 0, 0, 28, 323, 1, 0, 0, 0, 30, 325, 1, 0, 0,
+		0, 32, 327, 1, 0, 0, 0, 34, 331, 1, 0, 0, 0, 36, 333, 1, 0, 0, 0, 38, 336,
+		1, 0, 0, 0, 40, 341, 1, 0, 0, 0, 42, 392, 1, 0, 0, 0, 44, 394, 1, 0, 0,
+		0, 46, 408, 1, 0, 0, 0, 48, 421, 1, 0, 0, 0, 50, 423, 1, 0, 0, 0, 52, 485,
+		1, 0, 0, 0, 54, 503, 1, 0, 0, 0, 56, 531, 1, 0, 0, 0, 58, 533, 1, 0, 0,
+		0, 60, 569, 1, 0, 0, 0, 62, 588, 1, 0, 0, 0, 64, 600, 1, 0, 0, 0, 66, 608,
+		1, 0, 0, 0, 68, 627, 1, 0, 0, 0, 70, 639, 1, 0, 0, 0, 72, 649, 1, 0, 0,
+		0, 74, 670, 1, 0, 0, 0, 76, 673, 1, 0, 0, 0, 78, 678, 1, 0, 0, 0, 80, 690,
+		1, 0, 0, 0, 82, 692, 1, 0, 0, 0, 84, 694, 1, 0, 0, 0, 86, 718, 1, 0, 0,
+		0, 88, 723, 1, 0, 0, 0, 90, 739, 1, 0, 0, 0, 92, 755, 1, 0, 0, 0, 94, 760,
+		1, 0, 0, 0, 96, 770, 1, 0, 0, 0, 98, 785, 1, 0, 0, 0, 100, 800, 1, 0, 0,
+		0, 102, 813, 1, 0, 0, 0, 104, 815, 1, 0, 0, 0, 106, 817, 1, 0, 0, 0, 108,
+		821, 1, 0, 0, 0, 110, 854, 1, 0, 0, 0, 112, 858, 1, 0, 0, 0, 114, 860,
+		1, 0, 0, 0, 116, 872, 1, 0, 0, 0, 118, 886, 1, 0, 0, 0, 120, 896, 1, 0,
+		0, 0, 122, 904, 1, 0, 0, 0, 124, 914, 1, 0, 0, 0, 126, 977, 1, 0, 0, 0,
+		128, 998, 1, 0, 0, 0, 130, 1003, 1, 0, 0, 0, 132, 1012, 1, 0, 0, 0, 134,
+		1034, 1, 0, 0, 0, 136, 1036, 1, 0, 0, 0, 138, 1046, 1, 0, 0, 0, 140, 1059,
+		1, 0, 0, 0, 142, 1078, 1, 0, 0, 0, 144, 1080, 1, 0, 0, 0, 146, 1086, 1,
+		0, 0, 0, 148, 1088, 1, 0, 0, 0, 150, 1117, 1, 0, 0, 0, 152, 1132, 1, 0,
+		0, 0, 154, 1134, 1, 0, 0, 0, 156, 1137, 1, 0, 0, 0, 158, 1139, 1, 0, 0,
+		0, 160, 1147, 1, 0, 0, 0, 162, 1153, 1, 0, 0, 0, 164, 1157, 1, 0, 0, 0,
+		166, 1159, 1, 0, 0, 0, 168, 1161, 1, 0, 0, 0, 170, 1177, 1, 0, 0, 0, 172,
+		1180, 1, 0, 0, 0, 174, 1193, 1, 0, 0, 0, 176, 1199, 1, 0, 0, 0, 178, 1201,
+		1, 0, 0, 0, 180, 1203, 1, 0, 0, 0, 182, 1207, 1, 0, 0, 0, 184, 1213, 1,
+		0, 0, 0, 186, 1219, 1, 0, 0, 0, 188, 1221, 1, 0, 0, 0, 190, 1223, 1, 0,
+		0, 0, 192, 1227, 1, 0, 0, 0, 194, 1240, 1, 0, 0, 0, 196, 1242, 1, 0, 0,
+		0, 198, 1271, 1, 0, 0, 0, 200, 1313, 1, 0, 0, 0, 202, 1317, 1, 0, 0, 0,
+		204, 1348, 1, 0, 0, 0, 206, 210, 5, 60, 0, 0, 207, 210, 5, 53, 0, 0, 208,
+		210, 3, 2, 1, 0, 209, 206, 1, 0, 0, 0, 209, 207, 1, 0, 0, 0, 209, 208,
+		1, 0, 0, 0, 210, 213, 1, 0, 0, 0, 211, 209, 1, 0, 0, 0, 211, 212, 1, 0,
+		0, 0, 212, 214, 1, 0, 0, 0, 213, 211, 1, 0, 0, 0, 214, 215, 5, 0, 0, 1,
+		215, 1, 1, 0, 0, 0, 216, 222, 3, 12, 6, 0, 217, 222, 3, 38, 19, 0, 218,
+		222, 3, 76, 38, 0, 219, 222, 3, 92, 46, 0, 220, 222, 3, 120, 60, 0, 221,
+		216, 1, 0, 0, 0, 221, 217, 1, 0, 0, 0, 221, 218, 1, 0, 0, 0, 221, 219,
+		1, 0, 0, 0, 221, 220, 1, 0, 0, 0, 222, 3, 1, 0, 0, 0, 223, 224, 3, 6, 3,
+		0, 224, 225, 5, 60, 0, 0, 225, 227, 1, 0, 0, 0, 226, 223, 1, 0, 0, 0, 227,
+		228, 1, 0, 0, 0, 228, 226, 1, 0, 0, 0, 228, 229, 1, 0, 0, 0, 229, 5, 1,
+		0, 0, 0, 230, 231, 5, 1, 0, 0, 231, 233, 5, 55, 0, 0, 232, 234, 3, 8, 4,
+		0, 233, 232, 1, 0, 0, 0, 233, 234, 1, 0, 0, 0, 234, 7, 1, 0, 0, 0, 235,
+		236, 5, 2, 0, 0, 236, 241, 3, 10, 5, 0, 237, 238, 5, 3, 0, 0, 238, 240,
+		3, 10, 5, 0, 239, 237, 1, 0, 0, 0, 240, 243, 1, 0, 0, 0, 241, 239, 1, 0,
+		0, 0, 241, 242, 1, 0, 0, 0, 242, 244, 1, 0, 0, 0, 243, 241, 1, 0, 0, 0,
+		244, 245, 5, 4, 0, 0, 245, 9, 1, 0, 0, 0, 246, 248, 5, 55, 0, 0, 247, 246,
+		1, 0, 0, 0, 248, 249, 1, 0, 0, 0, 249, 247, 1, 0, 0, 0, 249, 250, 1, 0,
+		0, 0, 250, 253, 1, 0, 0, 0, 251, 253, 5, 56, 0, 0, 252, 247, 1, 0, 0, 0,
+		252, 251, 1, 0, 0, 0, 253, 11, 1, 0, 0, 0, 254, 258, 5, 5, 0, 0, 255, 257,
+		5, 60, 0, 0, 256, 255, 1, 0, 0, 0, 257, 260, 1, 0, 0, 0, 258, 256, 1, 0,
+		0, 0, 258, 259, 1, 0, 0, 0, 259, 261, 1, 0, 0, 0, 260, 258, 1, 0, 0, 0,
+		261, 265, 5, 6, 0, 0, 262, 264, 5, 60, 0, 0, 263, 262, 1, 0, 0, 0, 264,
+		267, 1, 0, 0, 0, 265, 263, 1, 0, 0, 0, 265, 266, 1, 0, 0, 0, 266, 271,
+		1, 0, 0, 0, 267, 265, 1, 0, 0, 0, 268, 270, 3, 14, 7, 0, 269, 268, 1, 0,
+		0, 0, 270, 273, 1, 0, 0, 0, 271, 269, 1, 0, 0, 0, 271, 272, 1, 0, 0, 0,
+		272, 274, 1, 0, 0, 0, 273, 271, 1, 0, 0, 0, 274, 275, 5, 7, 0, 0, 275,
+		13, 1, 0, 0, 0, 276, 278, 3, 16, 8, 0, 277, 276, 1, 0, 0, 0, 277, 278,
+		1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 281, 3, 18, 9, 0, 280, 282, 5, 3,
+		0, 0, 281, 280, 1, 0, 0, 0, 281, 282, 1, 0, 0, 0, 282, 286, 1, 0, 0, 0,
+		283, 285, 5, 60, 0, 0, 284, 283, 1, 0, 0, 0, 285, 288, 1, 0, 0, 0, 286,
+		284, 1, 0, 0, 0, 286, 287, 1, 0, 0, 0, 287, 15, 1, 0, 0, 0, 288, 286, 1,
+		0, 0, 0, 289, 290, 5, 55, 0, 0, 290, 17, 1, 0, 0, 0, 291, 292, 3, 20, 10,
+		0, 292, 293, 5, 8, 0, 0, 293, 295, 1, 0, 0, 0, 294, 291, 1, 0, 0, 0, 294,
+		295, 1, 0, 0, 0, 295, 296, 1, 0, 0, 0, 296, 297, 3, 26, 13, 0, 297, 19,
+		1, 0, 0, 0, 298, 301, 5, 9, 0, 0, 299, 301, 3, 22, 11, 0, 300, 298, 1,
+		0, 0, 0, 300, 299, 1, 0, 0, 0, 301, 21, 1, 0, 0, 0, 302, 308, 5, 55, 0,
+		0, 303, 304, 3, 24, 12, 0, 304, 305, 5, 55, 0, 0, 305, 307, 1, 0, 0, 0,
+		306, 303, 1, 0, 0, 0, 307, 310, 1, 0, 0, 0, 308, 306, 1, 0, 0, 0, 308,
+		309, 1, 0, 0, 0, 309, 23, 1, 0, 0, 0, 310, 308, 1, 0, 0, 0, 311, 312, 7,
+		0, 0, 0, 312, 25, 1, 0, 0, 0, 313, 318, 5, 55, 0, 0, 314, 315, 5, 10, 0,
+		0, 315, 317, 5, 55, 0, 0, 316, 314, 1, 0, 0, 0, 317, 320, 1, 0, 0, 0, 318,
+		316, 1, 0, 0, 0, 318, 319, 1, 0, 0, 0, 319, 27, 1, 0, 0, 0, 320, 318, 1,
+		0, 0, 0, 321, 324, 3, 32, 16, 0, 322, 324, 3, 30, 15, 0, 323, 321, 1, 0,
+		0, 0, 323, 322, 1, 0, 0, 0, 324, 29, 1, 0, 0, 0, 325, 326, 5, 55, 0, 0,
+		326, 31, 1, 0, 0, 0, 327, 328, 3, 34, 17, 0, 328, 329, 5, 11, 0, 0, 329,
+		330, 3, 36, 18, 0, 330, 33, 1, 0, 0, 0, 331, 332, 5, 55, 0, 0, 332, 35,
+		1, 0, 0, 0, 333, 334, 5, 55, 0, 0, 334, 37, 1, 0, 0, 0, 335, 337, 5, 54,
+		0, 0, 336, 335, 1, 0, 0, 0, 336, 337, 1, 0, 0, 0, 337, 338, 1, 0, 0, 0,
+		338, 339, 5, 12, 0, 0, 339, 340, 3, 40, 20, 0, 340, 39, 1, 0, 0, 0, 341,
+		343, 5, 55, 0, 0, 342, 344, 3, 42, 21, 0, 343, 342, 1, 0, 0, 0, 343, 344,
+		1, 0, 0, 0, 344, 346, 1, 0, 0, 0, 345, 347, 3, 48, 24, 0, 346, 345, 1,
+		0, 0, 0, 346, 347, 1, 0, 0, 0, 347, 349, 1, 0, 0, 0, 348, 350, 5, 53, 0,
+		0, 349, 348, 1, 0, 0, 0, 349, 350, 1, 0, 0, 0, 350, 41, 1, 0, 0, 0, 351,
+		355, 5, 13, 0, 0, 352, 354, 5, 60, 0, 0, 353, 352, 1, 0, 0, 0, 354, 357,
+		1, 0, 0, 0, 355, 353, 1, 0, 0, 0, 355, 356, 1, 0, 0, 0, 356, 359, 1, 0,
+		0, 0, 357, 355, 1, 0, 0, 0, 358, 360, 3, 44, 22, 0, 359, 358, 1, 0, 0,
+		0, 359, 360, 1, 0, 0, 0, 360, 361, 1, 0, 0, 0, 361, 393, 5, 14, 0, 0, 362,
+		366, 5, 13, 0, 0, 363, 365, 5, 60, 0, 0, 364, 363, 1, 0, 0, 0, 365, 368,
+		1, 0, 0, 0, 366, 364, 1, 0, 0, 0, 366, 367, 1, 0, 0, 0, 367, 379, 1, 0,
+		0, 0, 368, 366, 1, 0, 0, 0, 369, 370, 3, 46, 23, 0, 370, 374, 5, 3, 0,
+		0, 371, 373, 5, 60, 0, 0, 372, 371, 1, 0, 0, 0, 373, 376, 1, 0, 0, 0, 374,
+		372, 1, 0, 0, 0, 374, 375, 1, 0, 0, 0, 375, 378, 1, 0, 0, 0, 376, 374,
+		1, 0, 0, 0, 377, 369, 1, 0, 0, 0, 378, 381, 1, 0, 0, 0, 379, 377, 1, 0,
+		0, 0, 379, 380, 1, 0, 0, 0, 380, 382, 1, 0, 0, 0, 381, 379, 1, 0, 0, 0,
+		382, 383, 5, 55, 0, 0, 383, 387, 3, 54, 27, 0, 384, 386, 5, 60, 0, 0, 385,
+		384, 1, 0, 0, 0, 386, 389, 1, 0, 0, 0, 387, 385, 1, 0, 0, 0, 387, 388,
+		1, 0, 0, 0, 388, 390, 1, 0, 0, 0, 389, 387, 1, 0, 0, 0, 390, 391, 5, 15,
+		0, 0, 391, 393, 1, 0, 0, 0, 392, 351, 1, 0, 0, 0, 392, 362, 1, 0, 0, 0,
+		393, 43, 1, 0, 0, 0, 394, 405, 3, 46, 23, 0, 395, 399, 5, 3, 0, 0, 396,
+		398, 5, 60, 0, 0, 397, 396, 1, 0, 0, 0, 398, 401, 1, 0, 0, 0, 399, 397,
+		1, 0, 0, 0, 399, 400, 1, 0, 0, 0, 400, 402, 1, 0, 0, 0, 401, 399, 1, 0,
+		0, 0, 402, 404, 3, 46, 23, 0, 403, 395, 1, 0, 0, 0, 404, 407, 1, 0, 0,
+		0, 405, 403, 1, 0, 0, 0, 405, 406, 1, 0, 0, 0, 406, 45, 1, 0, 0, 0, 407,
+		405, 1, 0, 0, 0, 408, 410, 5, 55, 0, 0, 409, 411, 3, 48, 24, 0, 410, 409,
+		1, 0, 0, 0, 410, 411, 1, 0, 0, 0, 411, 415, 1, 0, 0, 0, 412, 414, 5, 60,
+		0, 0, 413, 412, 1, 0, 0, 0, 414, 417, 1, 0, 0, 0, 415, 413, 1, 0, 0, 0,
+		415, 416, 1, 0, 0, 0, 416, 47, 1, 0, 0, 0, 417, 415, 1, 0, 0, 0, 418, 422,
+		3, 50, 25, 0, 419, 422, 3, 56, 28, 0, 420, 422, 3, 72, 36, 0, 421, 418,
+		1, 0, 0, 0, 421, 419, 1, 0, 0, 0, 421, 420, 1, 0, 0, 0, 422, 49, 1, 0,
+		0, 0, 423, 425, 3, 28, 14, 0, 424, 426, 3, 52, 26, 0, 425, 424, 1, 0, 0,
+		0, 425, 426, 1, 0, 0, 0, 426, 51, 1, 0, 0, 0, 427, 431, 5, 13, 0, 0, 428,
+		430, 5, 60, 0, 0, 429, 428, 1, 0, 0, 0, 430, 433, 1, 0, 0, 0, 431, 429,
+		1, 0, 0, 0, 431, 432, 1, 0, 0, 0, 432, 434, 1, 0, 0, 0, 433, 431, 1, 0,
+		0, 0, 434, 445, 3, 48, 24, 0, 435, 439, 5, 3, 0, 0, 436, 438, 5, 60, 0,
+		0, 437, 436, 1, 0, 0, 0, 438, 441, 1, 0, 0, 0, 439, 437, 1, 0, 0, 0, 439,
+		440, 1, 0, 0, 0, 440, 442, 1, 0, 0, 0, 441, 439, 1, 0, 0, 0, 442, 444,
+		3, 48, 24, 0, 443, 435, 1, 0, 0, 0, 444, 447, 1, 0, 0, 0, 445, 443, 1,
+		0, 0, 0, 445, 446, 1, 0, 0, 0, 446, 451, 1, 0, 0, 0, 447, 445, 1, 0, 0,
+		0, 448, 450, 5, 60, 0, 0, 449, 448, 1, 0, 0, 0, 450, 453, 1, 0, 0, 0, 451,
+		449, 1, 0, 0, 0, 451, 452, 1, 0, 0, 0, 452, 454, 1, 0, 0, 0, 453, 451,
+		1, 0, 0, 0, 454, 455, 5, 14, 0, 0, 455, 486, 1, 0, 0, 0, 456, 460, 5, 13,
+		0, 0, 457, 459, 5, 60, 0, 0, 458, 457, 1, 0, 0, 0, 459, 462, 1, 0, 0, 0,
+		460, 458, 1, 0, 0, 0, 460, 461, 1, 0, 0, 0, 461, 473, 1, 0, 0, 0, 462,
+		460, 1, 0, 0, 0, 463, 464, 3, 48, 24, 0, 464, 468, 5, 3, 0, 0, 465, 467,
+		5, 60, 0, 0, 466, 465, 1, 0, 0, 0, 467, 470, 1, 0, 0, 0, 468, 466, 1, 0,
+		0, 0, 468, 469, 1, 0, 0, 0, 469, 472, 1, 0, 0, 0, 470, 468, 1, 0, 0, 0,
+		471, 463, 1, 0, 0, 0, 472, 475, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 473,
+		474, 1, 0, 0, 0, 474, 476, 1, 0, 0, 0, 475, 473, 1, 0, 0, 0, 476, 480,
+		3, 54, 27, 0, 477, 479, 5, 60, 0, 0, 478, 477, 1, 0, 0, 0, 479, 482, 1,
+		0, 0, 0, 480, 478, 1, 0, 0, 0, 480, 481, 1, 0, 0, 0, 481, 483, 1, 0, 0,
+		0, 482, 480, 1, 0, 0, 0, 483, 484, 5, 15, 0, 0, 484, 486, 1, 0, 0, 0, 485,
+		427, 1, 0, 0, 0, 485, 456, 1, 0, 0, 0, 486, 53, 1, 0, 0, 0, 487, 491, 3,
+		74, 37, 0, 488, 490, 5, 60, 0, 0, 489, 488, 1, 0, 0, 0, 490, 493, 1, 0,
+		0, 0, 491, 489, 1, 0, 0, 0, 491, 492, 1, 0, 0, 0, 492, 494, 1, 0, 0, 0,
+		493, 491, 1, 0, 0, 0, 494, 498, 5, 16, 0, 0, 495, 497, 5, 60, 0, 0, 496,
+		495, 1, 0, 0, 0, 497, 500, 1, 0, 0, 0, 498, 496, 1, 0, 0, 0, 498, 499,
+		1, 0, 0, 0, 499, 502, 1, 0, 0, 0, 500, 498, 1, 0, 0, 0, 501, 487, 1, 0,
+		0, 0, 502, 505, 1, 0, 0, 0, 503, 501, 1, 0, 0, 0, 503, 504, 1, 0, 0, 0,
+		504, 506, 1, 0, 0, 0, 505, 503, 1, 0, 0, 0, 506, 507, 3, 28, 14, 0, 507,
+		511, 5, 13, 0, 0, 508, 510, 5, 60, 0, 0, 509, 508, 1, 0, 0, 0, 510, 513,
+		1, 0, 0, 0, 511, 509, 1, 0, 0, 0, 511, 512, 1, 0, 0, 0, 512, 514, 1, 0,
+		0, 0, 513, 511, 1, 0, 0, 0, 514, 525, 3, 48, 24, 0, 515, 519, 5, 3, 0,
+		0, 516, 518, 5, 60, 0, 0, 517, 516, 1, 0, 0, 0, 518, 521, 1, 0, 0, 0, 519,
+		517, 1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 522, 1, 0, 0, 0, 521, 519,
+		1, 0, 0, 0, 522, 524, 3, 48, 24, 0, 523, 515, 1, 0, 0, 0, 524, 527, 1,
+		0, 0, 0, 525, 523, 1, 0, 0, 0, 525, 526, 1, 0, 0, 0, 526, 55, 1, 0, 0,
+		0, 527, 525, 1, 0, 0, 0, 528, 532, 3, 58, 29, 0, 529, 532, 3, 60, 30, 0,
+		530, 532, 3, 66, 33, 0, 531, 528, 1, 0, 0, 0, 531, 529, 1, 0, 0, 0, 531,
+		530, 1, 0, 0, 0, 532, 57, 1, 0, 0, 0, 533, 537, 5, 17, 0, 0, 534, 536,
+		5, 60, 0, 0, 535, 534, 1, 0, 0, 0, 536, 539, 1, 0, 0, 0, 537, 535, 1, 0,
+		0, 0, 537, 538, 1, 0, 0, 0, 538, 540, 1, 0, 0, 0, 539, 537, 1, 0, 0, 0,
+		540, 544, 5, 6, 0, 0, 541, 543, 5, 60, 0, 0, 542, 541, 1, 0, 0, 0, 543,
+		546, 1, 0, 0, 0, 544, 542, 1, 0, 0, 0, 544, 545, 1, 0, 0, 0, 545, 547,
+		1, 0, 0, 0, 546, 544, 1, 0, 0, 0, 547, 558, 5, 55, 0, 0, 548, 552, 5, 3,
+		0, 0, 549, 551, 5, 60, 0, 0, 550, 549, 1, 0, 0, 0, 551, 554, 1, 0, 0, 0,
+		552, 550, 1, 0, 0, 0, 552, 553, 1, 0, 0, 0, 553, 555, 1, 0, 0, 0, 554,
+		552, 1, 0, 0, 0, 555, 557, 5, 55, 0, 0, 556, 548, 1, 0, 0, 0, 557, 560,
+		1, 0, 0, 0, 558, 556, 1, 0, 0, 0, 558, 559, 1, 0, 0, 0, 559, 564, 1, 0,
+		0, 0, 560, 558, 1, 0, 0, 0, 561, 563, 5, 60, 0, 0, 562, 561, 1, 0, 0, 0,
+		563, 566, 1, 0, 0, 0, 564, 562, 1, 0, 0, 0, 564, 565, 1, 0, 0, 0, 565,
+		567, 1, 0, 0, 0, 566, 564, 1, 0, 0, 0, 567, 568, 5, 7, 0, 0, 568, 59, 1,
+		0, 0, 0, 569, 573, 5, 18, 0, 0, 570, 572, 5, 60, 0, 0, 571, 570, 1, 0,
+		0, 0, 572, 575, 1, 0, 0, 0, 573, 571, 1, 0, 0, 0, 573, 574, 1, 0, 0, 0,
+		574, 576, 1, 0, 0, 0, 575, 573, 1, 0, 0, 0, 576, 580, 5, 6, 0, 0, 577,
+		579, 5, 60, 0, 0, 578, 577, 1, 0, 0, 0, 579, 582, 1, 0, 0, 0, 580, 578,
+		1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 584, 1, 0, 0, 0, 582, 580, 1, 0,
+		0, 0, 583, 585, 3, 62, 31, 0, 584, 583, 1, 0, 0, 0, 584, 585, 1, 0, 0,
+		0, 585, 586, 1, 0, 0, 0, 586, 587, 5, 7, 0, 0, 587, 61, 1, 0, 0, 0, 588,
+		597, 3, 64, 32, 0, 589, 591, 5, 60, 0, 0, 590, 589, 1, 0, 0, 0, 591, 592,
+		1, 0, 0, 0, 592, 590, 1, 0, 0, 0, 592, 593, 1, 0, 0, 0, 593, 594, 1, 0,
+		0, 0, 594, 596, 3, 64, 32, 0, 595, 590, 1, 0, 0, 0, 596, 599, 1, 0, 0,
+		0, 597, 595, 1, 0, 0, 0, 597, 598, 1, 0, 0, 0, 598, 63, 1, 0, 0, 0, 599,
+		597, 1, 0, 0, 0, 600, 601, 5, 55, 0, 0, 601, 605, 3, 48, 24, 0, 602, 604,
+		5, 60, 0, 0, 603, 602, 1, 0, 0, 0, 604, 607, 1, 0, 0, 0, 605, 603, 1, 0,
+		0, 0, 605, 606, 1, 0, 0, 0, 606, 65, 1, 0, 0, 0, 607, 605, 1, 0, 0, 0,
+		608, 612, 5, 19, 0, 0, 609, 611, 5, 60, 0, 0, 610, 609, 1, 0, 0, 0, 611,
+		614, 1, 0, 0, 0, 612, 610, 1, 0, 0, 0, 612, 613, 1, 0, 0, 0, 613, 615,
+		1, 0, 0, 0, 614, 612, 1, 0, 0, 0, 615, 619, 5, 6, 0, 0, 616, 618, 5, 60,
+		0, 0, 617, 616, 1, 0, 0, 0, 618, 621, 1, 0, 0, 0, 619, 617, 1, 0, 0, 0,
+		619, 620, 1, 0, 0, 0, 620, 623, 1, 0, 0, 0, 621, 619, 1, 0, 0, 0, 622,
+		624, 3, 68, 34, 0, 623, 622, 1, 0, 0, 0, 623, 624, 1, 0, 0, 0, 624, 625,
+		1, 0, 0, 0, 625, 626, 5, 7, 0, 0, 626, 67, 1, 0, 0, 0, 627, 636, 3, 70,
+		35, 0, 628, 630, 5, 60, 0, 0, 629, 628, 1, 0, 0, 0, 630, 631, 1, 0, 0,
+		0, 631, 629, 1, 0, 0, 0, 631, 632, 1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633,
+		635, 3, 70, 35, 0, 634, 629, 1, 0, 0, 0, 635, 638, 1, 0, 0, 0, 636, 634,
+		1, 0, 0, 0, 636, 637, 1, 0, 0, 0, 637, 69, 1, 0, 0, 0, 638, 636, 1, 0,
+		0, 0, 639, 641, 5, 55, 0, 0, 640, 642, 3, 48, 24, 0, 641, 640, 1, 0, 0,
+		0, 641, 642, 1, 0, 0, 0, 642, 646, 1, 0, 0, 0, 643, 645, 5, 60, 0, 0, 644,
+		643, 1, 0, 0, 0, 645, 648, 1, 0, 0, 0, 646, 644, 1, 0, 0, 0, 646, 647,
+		1, 0, 0, 0, 647, 71, 1, 0, 0, 0, 648, 646, 1, 0, 0, 0, 649, 664, 3, 74,
+		37, 0, 650, 652, 5, 60, 0, 0, 651, 650, 1, 0, 0, 0, 652, 655, 1, 0, 0,
+		0, 653, 651, 1, 0, 0, 0, 653, 654, 1, 0, 0, 0, 654, 656, 1, 0, 0, 0, 655,
+		653, 1, 0, 0, 0, 656, 660, 5, 16, 0, 0, 657, 659, 5, 60, 0, 0, 658, 657,
+		1, 0, 0, 0, 659, 662, 1, 0, 0, 0, 660, 658, 1, 0, 0, 0, 660, 661, 1, 0,
+		0, 0, 661, 663, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 663, 665, 3, 74, 37,
+		0, 664, 653, 1, 0, 0, 0, 665, 666, 1, 0, 0, 0, 666, 664, 1, 0, 0, 0, 666,
+		667, 1, 0, 0, 0, 667, 73, 1, 0, 0, 0, 668, 671, 3, 50, 25, 0, 669, 671,
+		3, 56, 28, 0, 670, 668, 1, 0, 0, 0, 670, 669, 1, 0, 0, 0, 671, 75, 1, 0,
+		0, 0, 672, 674, 5, 54, 0, 0, 673, 672, 1, 0, 0, 0, 673, 674, 1, 0, 0, 0,
+		674, 675, 1, 0, 0, 0, 675, 676, 5, 20, 0, 0, 676, 677, 3, 78, 39, 0, 677,
+		77, 1, 0, 0, 0, 678, 680, 5, 55, 0, 0, 679, 681, 3, 42, 21, 0, 680, 679,
+		1, 0, 0, 0, 680, 681, 1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 683, 3, 80,
+		40, 0, 683, 687, 3, 82, 41, 0, 684, 686, 5, 60, 0, 0, 685, 684, 1, 0, 0,
+		0, 686, 689, 1, 0, 0, 0, 687, 685, 1, 0, 0, 0, 687, 688, 1, 0, 0, 0, 688,
+		79, 1, 0, 0, 0, 689, 687, 1, 0, 0, 0, 690, 691, 3, 84, 42, 0, 691, 81,
+		1, 0, 0, 0, 692, 693, 3, 84, 42, 0, 693, 83, 1, 0, 0, 0, 694, 712, 5, 2,
+		0, 0, 695, 697, 5, 60, 0, 0, 696, 695, 1, 0, 0, 0, 697, 700, 1, 0, 0, 0,
+		698, 696, 1, 0, 0, 0, 698, 699, 1, 0, 0, 0, 699, 713, 1, 0, 0, 0, 700,
+		698, 1, 0, 0, 0, 701, 703, 3, 86, 43, 0, 702, 701, 1, 0, 0, 0, 702, 703,
+		1, 0, 0, 0, 703, 713, 1, 0, 0, 0, 704, 709, 3, 86, 43, 0, 705, 706, 5,
+		3, 0, 0, 706, 708, 3, 86, 43, 0, 707, 705, 1, 0, 0, 0, 708, 711, 1, 0,
+		0, 0, 709, 707, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 710, 713, 1, 0, 0, 0,
+		711, 709, 1, 0, 0, 0, 712, 698, 1, 0, 0, 0, 712, 702, 1, 0, 0, 0, 712,
+		704, 1, 0, 0, 0, 713, 714, 1, 0, 0, 0, 714, 715, 5, 4, 0, 0, 715, 85, 1,
+		0, 0, 0, 716, 719, 3, 88, 44, 0, 717, 719, 3, 90, 45, 0, 718, 716, 1, 0,
+		0, 0, 718, 717, 1, 0, 0, 0, 719, 87, 1, 0, 0, 0, 720, 722, 5, 60, 0, 0,
+		721, 720, 1, 0, 0, 0, 722, 725, 1, 0, 0, 0, 723, 721, 1, 0, 0, 0, 723,
+		724, 1, 0, 0, 0, 724, 727, 1, 0, 0, 0, 725, 723, 1, 0, 0, 0, 726, 728,
+		5, 55, 0, 0, 727, 726, 1, 0, 0, 0, 727, 728, 1, 0, 0, 0, 728, 729, 1, 0,
+		0, 0, 729, 733, 3, 48, 24, 0, 730, 732, 5, 60, 0, 0, 731, 730, 1, 0, 0,
+		0, 732, 735, 1, 0, 0, 0, 733, 731, 1, 0, 0, 0, 733, 734, 1, 0, 0, 0, 734,
+		89, 1, 0, 0, 0, 735, 733, 1, 0, 0, 0, 736, 738, 5, 60, 0, 0, 737, 736,
+		1, 0, 0, 0, 738, 741, 1, 0, 0, 0, 739, 737, 1, 0, 0, 0, 739, 740, 1, 0,
+		0, 0, 740, 742, 1, 0, 0, 0, 741, 739, 1, 0, 0, 0, 742, 743, 5, 21, 0, 0,
+		743, 744, 5, 55, 0, 0, 744, 746, 5, 22, 0, 0, 745, 747, 3, 48, 24, 0, 746,
+		745, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 751, 1, 0, 0, 0, 748, 750,
+		5, 60, 0, 0, 749, 748, 1, 0, 0, 0, 750, 753, 1, 0, 0, 0, 751, 749, 1, 0,
+		0, 0, 751, 752, 1, 0, 0, 0, 752, 91, 1, 0, 0, 0, 753, 751, 1, 0, 0, 0,
+		754, 756, 5, 54, 0, 0, 755, 754, 1, 0, 0, 0, 755, 756, 1, 0, 0, 0, 756,
+		757, 1, 0, 0, 0, 757, 758, 5, 23, 0, 0, 758, 759, 3, 94, 47, 0, 759, 93,
+		1, 0, 0, 0, 760, 761, 5, 55, 0, 0, 761, 762, 3, 48, 24, 0, 762, 763, 5,
+		24, 0, 0, 763, 767, 3, 96, 48, 0, 764, 766, 5, 60, 0, 0, 765, 764, 1, 0,
+		0, 0, 766, 769, 1, 0, 0, 0, 767, 765, 1, 0, 0, 0, 767, 768, 1, 0, 0, 0,
+		768, 95, 1, 0, 0, 0, 769, 767, 1, 0, 0, 0, 770, 776, 3, 98, 49, 0, 771,
+		772, 3, 162, 81, 0, 772, 773, 3, 98, 49, 0, 773, 775, 1, 0, 0, 0, 774,
+		771, 1, 0, 0, 0, 775, 778, 1, 0, 0, 0, 776, 774, 1, 0, 0, 0, 776, 777,
+		1, 0, 0, 0, 777, 97, 1, 0, 0, 0, 778, 776, 1, 0, 0, 0, 779, 786, 3, 28,
+		14, 0, 780, 786, 3, 100, 50, 0, 781, 782, 5, 2, 0, 0, 782, 783, 3, 96,
+		48, 0, 783, 784, 5, 4, 0, 0, 784, 786, 1, 0, 0, 0, 785, 779, 1, 0, 0, 0,
+		785, 780, 1, 0, 0, 0, 785, 781, 1, 0, 0, 0, 786, 99, 1, 0, 0, 0, 787, 801,
+		3, 104, 52, 0, 788, 790, 5, 57, 0, 0, 789, 788, 1, 0, 0, 0, 789, 790, 1,
+		0, 0, 0, 790, 791, 1, 0, 0, 0, 791, 801, 5, 56, 0, 0, 792, 794, 5, 57,
+		0, 0, 793, 792, 1, 0, 0, 0, 793, 794, 1, 0, 0, 0, 794, 795, 1, 0, 0, 0,
+		795, 801, 5, 58, 0, 0, 796, 801, 5, 59, 0, 0, 797, 801, 3, 106, 53, 0,
+		798, 801, 3, 108, 54, 0, 799, 801, 3, 114, 57, 0, 800, 787, 1, 0, 0, 0,
+		800, 789, 1, 0, 0, 0, 800, 793, 1, 0, 0, 0, 800, 796, 1, 0, 0, 0, 800,
+		797, 1, 0, 0, 0, 800, 798, 1, 0, 0, 0, 800, 799, 1, 0, 0, 0, 801, 101,
+		1, 0, 0, 0, 802, 814, 3, 104, 52, 0, 803, 805, 5, 57, 0, 0, 804, 803, 1,
+		0, 0, 0, 804, 805, 1, 0, 0, 0, 805, 806, 1, 0, 0, 0, 806, 814, 5, 56, 0,
+		0, 807, 809, 5, 57, 0, 0, 808, 807, 1, 0, 0, 0, 808, 809, 1, 0, 0, 0, 809,
+		810, 1, 0, 0, 0, 810, 814, 5, 58, 0, 0, 811, 814, 5, 59, 0, 0, 812, 814,
+		3, 106, 53, 0, 813, 802, 1, 0, 0, 0, 813, 804, 1, 0, 0, 0, 813, 808, 1,
+		0, 0, 0, 813, 811, 1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814, 103, 1, 0, 0,
+		0, 815, 816, 7, 1, 0, 0, 816, 105, 1, 0, 0, 0, 817, 818, 3, 28, 14, 0,
+		818, 819, 5, 27, 0, 0, 819, 820, 5, 55, 0, 0, 820, 107, 1, 0, 0, 0, 821,
+		825, 5, 21, 0, 0, 822, 824, 5, 60, 0, 0, 823, 822, 1, 0, 0, 0, 824, 827,
+		1, 0, 0, 0, 825, 823, 1, 0, 0, 0, 825, 826, 1, 0, 0, 0, 826, 829, 1, 0,
+		0, 0, 827, 825, 1, 0, 0, 0, 828, 830, 3, 110, 55, 0, 829, 828, 1, 0, 0,
+		0, 829, 830, 1, 0, 0, 0, 830, 831, 1, 0, 0, 0, 831, 832, 5, 22, 0, 0, 832,
+		109, 1, 0, 0, 0, 833, 855, 3, 112, 56, 0, 834, 851, 3, 112, 56, 0, 835,
+		839, 5, 3, 0, 0, 836, 838, 5, 60, 0, 0, 837, 836, 1, 0, 0, 0, 838, 841,
+		1, 0, 0, 0, 839, 837, 1, 0, 0, 0, 839, 840, 1, 0, 0, 0, 840, 842, 1, 0,
+		0, 0, 841, 839, 1, 0, 0, 0, 842, 846, 3, 112, 56, 0, 843, 845, 5, 60, 0,
+		0, 844, 843, 1, 0, 0, 0, 845, 848, 1, 0, 0, 0, 846, 844, 1, 0, 0, 0, 846,
+		847, 1, 0, 0, 0, 847, 850, 1, 0, 0, 0, 848, 846, 1, 0, 0, 0, 849, 835,
+		1, 0, 0, 0, 850, 853, 1, 0, 0, 0, 851, 849, 1, 0, 0, 0, 851, 852, 1, 0,
+		0, 0, 852, 855, 1, 0, 0, 0, 853, 851, 1, 0, 0, 0, 854, 833, 1, 0, 0, 0,
+		854, 834, 1, 0, 0, 0, 855, 111, 1, 0, 0, 0, 856, 859, 3, 28, 14, 0, 857,
+		859, 3, 100, 50, 0, 858, 856, 1, 0, 0, 0, 858, 857, 1, 0, 0, 0, 859, 113,
+		1, 0, 0, 0, 860, 864, 5, 6, 0, 0, 861, 863, 5, 60, 0, 0, 862, 861, 1, 0,
+		0, 0, 863, 866, 1, 0, 0, 0, 864, 862, 1, 0, 0, 0, 864, 865, 1, 0, 0, 0,
+		865, 868, 1, 0, 0, 0, 866, 864, 1, 0, 0, 0, 867, 869, 3, 116, 58, 0, 868,
+		867, 1, 0, 0, 0, 868, 869, 1, 0, 0, 0, 869, 870, 1, 0, 0, 0, 870, 871,
+		5, 7, 0, 0, 871, 115, 1, 0, 0, 0, 872, 883, 3, 118, 59, 0, 873, 877, 5,
+		3, 0, 0, 874, 876, 5, 60, 0, 0, 875, 874, 1, 0, 0, 0, 876, 879, 1, 0, 0,
+		0, 877, 875, 1, 0, 0, 0, 877, 878, 1, 0, 0, 0, 878, 880, 1, 0, 0, 0, 879,
+		877, 1, 0, 0, 0, 880, 882, 3, 118, 59, 0, 881, 873, 1, 0, 0, 0, 882, 885,
+		1, 0, 0, 0, 883, 881, 1, 0, 0, 0, 883, 884, 1, 0, 0, 0, 884, 117, 1, 0,
+		0, 0, 885, 883, 1, 0, 0, 0, 886, 887, 7, 2, 0, 0, 887, 888, 5, 8, 0, 0,
+		888, 892, 3, 112, 56, 0, 889, 891, 5, 60, 0, 0, 890, 889, 1, 0, 0, 0, 891,
+		894, 1, 0, 0, 0, 892, 890, 1, 0, 0, 0, 892, 893, 1, 0, 0, 0, 893, 119,
+		1, 0, 0, 0, 894, 892, 1, 0, 0, 0, 895, 897, 3, 4, 2, 0, 896, 895, 1, 0,
+		0, 0, 896, 897, 1, 0, 0, 0, 897, 899, 1, 0, 0, 0, 898, 900, 5, 54, 0, 0,
+		899, 898, 1, 0, 0, 0, 899, 900, 1, 0, 0, 0, 900, 901, 1, 0, 0, 0, 901,
+		902, 5, 28, 0, 0, 902, 903, 3, 122, 61, 0, 903, 121, 1, 0, 0, 0, 904, 906,
+		3, 78, 39, 0, 905, 907, 3, 124, 62, 0, 906, 905, 1, 0, 0, 0, 906, 907,
+		1, 0, 0, 0, 907, 911, 1, 0, 0, 0, 908, 910, 5, 60, 0, 0, 909, 908, 1, 0,
+		0, 0, 910, 913, 1, 0, 0, 0, 911, 909, 1, 0, 0, 0, 911, 912, 1, 0, 0, 0,
+		912, 123, 1, 0, 0, 0, 913, 911, 1, 0, 0, 0, 914, 918, 5, 6, 0, 0, 915,
+		917, 5, 60, 0, 0, 916, 915, 1, 0, 0, 0, 917, 920, 1, 0, 0, 0, 918, 916,
+		1, 0, 0, 0, 918, 919, 1, 0, 0, 0, 919, 930, 1, 0, 0, 0, 920, 918, 1, 0,
+		0, 0, 921, 925, 5, 53, 0, 0, 922, 924, 5, 60, 0, 0, 923, 922, 1, 0, 0,
+		0, 924, 927, 1, 0, 0, 0, 925, 923, 1, 0, 0, 0, 925, 926, 1, 0, 0, 0, 926,
+		929, 1, 0, 0, 0, 927, 925, 1, 0, 0, 0, 928, 921, 1, 0, 0, 0, 929, 932,
+		1, 0, 0, 0, 930, 928, 1, 0, 0, 0, 930, 931, 1, 0, 0, 0, 931, 940, 1, 0,
+		0, 0, 932, 930, 1, 0, 0, 0, 933, 937, 3, 126, 63, 0, 934, 936, 5, 60, 0,
+		0, 935, 934, 1, 0, 0, 0, 936, 939, 1, 0, 0, 0, 937, 935, 1, 0, 0, 0, 937,
+		938, 1, 0, 0, 0, 938, 941, 1, 0, 0, 0, 939, 937, 1, 0, 0, 0, 940, 933,
+		1, 0, 0, 0, 940, 941, 1, 0, 0, 0, 941, 951, 1, 0, 0, 0, 942, 946, 5, 53,
+		0, 0, 943, 945, 5, 60, 0, 0, 944, 943, 1, 0, 0, 0, 945, 948, 1, 0, 0, 0,
+		946, 944, 1, 0, 0, 0, 946, 947, 1, 0, 0, 0, 947, 950, 1, 0, 0, 0, 948,
+		946, 1, 0, 0, 0, 949, 942, 1, 0, 0, 0, 950, 953, 1, 0, 0, 0, 951, 949,
+		1, 0, 0, 0, 951, 952, 1, 0, 0, 0, 952, 961, 1, 0, 0, 0, 953, 951, 1, 0,
+		0, 0, 954, 958, 3, 140, 70, 0, 955, 957, 5, 60, 0, 0, 956, 955, 1, 0, 0,
+		0, 957, 960, 1, 0, 0, 0, 958, 956, 1, 0, 0, 0, 958, 959, 1, 0, 0, 0, 959,
+		962, 1, 0, 0, 0, 960, 958, 1, 0, 0, 0, 961, 954, 1, 0, 0, 0, 961, 962,
+		1, 0, 0, 0, 962, 972, 1, 0, 0, 0, 963, 967, 5, 53, 0, 0, 964, 966, 5, 60,
+		0, 0, 965, 964, 1, 0, 0, 0, 966, 969, 1, 0, 0, 0, 967, 965, 1, 0, 0, 0,
+		967, 968, 1, 0, 0, 0, 968, 971, 1, 0, 0, 0, 969, 967, 1, 0, 0, 0, 970,
+		963, 1, 0, 0, 0, 971, 974, 1, 0, 0, 0, 972, 970, 1, 0, 0, 0, 972, 973,
+		1, 0, 0, 0, 973, 975, 1, 0, 0, 0, 974, 972, 1, 0, 0, 0, 975, 976, 5, 7,
+		0, 0, 976, 125, 1, 0, 0, 0, 977, 979, 3, 128, 64, 0, 978, 980, 5, 60, 0,
+		0, 979, 978, 1, 0, 0, 0, 980, 981, 1, 0, 0, 0, 981, 979, 1, 0, 0, 0, 981,
+		982, 1, 0, 0, 0, 982, 983, 1, 0, 0, 0, 983, 984, 5, 29, 0, 0, 984, 127,
+		1, 0, 0, 0, 985, 987, 3, 130, 65, 0, 986, 988, 5, 3, 0, 0, 987, 986, 1,
+		0, 0, 0, 987, 988, 1, 0, 0, 0, 988, 991, 1, 0, 0, 0, 989, 991, 5, 53, 0,
+		0, 990, 985, 1, 0, 0, 0, 990, 989, 1, 0, 0, 0, 991, 995, 1, 0, 0, 0, 992,
+		994, 5, 60, 0, 0, 993, 992, 1, 0, 0, 0, 994, 997, 1, 0, 0, 0, 995, 993,
+		1, 0, 0, 0, 995, 996, 1, 0, 0, 0, 996, 999, 1, 0, 0, 0, 997, 995, 1, 0,
+		0, 0, 998, 990, 1, 0, 0, 0, 999, 1000, 1, 0, 0, 0, 1000, 998, 1, 0, 0,
+		0, 1000, 1001, 1, 0, 0, 0, 1001, 129, 1, 0, 0, 0, 1002, 1004, 3, 4, 2,
+		0, 1003, 1002, 1, 0, 0, 0, 1003, 1004, 1, 0, 0, 0, 1004, 1006, 1, 0, 0,
+		0, 1005, 1007, 5, 55, 0, 0, 1006, 1005, 1, 0, 0, 0, 1006, 1007, 1, 0, 0,
+		0, 1007, 1010, 1, 0, 0, 0, 1008, 1011, 3, 132, 66, 0, 1009, 1011, 3, 138,
+		69, 0, 1010, 1008, 1, 0, 0, 0, 1010, 1009, 1, 0, 0, 0, 1011, 131, 1, 0,
+		0, 0, 1012, 1016, 3, 28, 14, 0, 1013, 1015, 5, 60, 0, 0, 1014, 1013, 1,
+		0, 0, 0, 1015, 1018, 1, 0, 0, 0, 1016, 1014, 1, 0, 0, 0, 1016, 1017, 1,
+		0, 0, 0, 1017, 1020, 1, 0, 0, 0, 1018, 1016, 1, 0, 0, 0, 1019, 1021, 3,
+		52, 26, 0, 1020, 1019, 1, 0, 0, 0, 1020, 1021, 1, 0, 0, 0, 1021, 1025,
+		1, 0, 0, 0, 1022, 1024, 5, 60, 0, 0, 1023, 1022, 1, 0, 0, 0, 1024, 1027,
+		1, 0, 0, 0, 1025, 1023, 1, 0, 0, 0, 1025, 1026, 1, 0, 0, 0, 1026, 1029,
+		1, 0, 0, 0, 1027, 1025, 1, 0, 0, 0, 1028, 1030, 3, 136, 68, 0, 1029, 1028,
+		1, 0, 0, 0, 1029, 1030, 1, 0, 0, 0, 1030, 1032, 1, 0, 0, 0, 1031, 1033,
+		3, 134, 67, 0, 1032, 1031, 1, 0, 0, 0, 1032, 1033, 1, 0, 0, 0, 1033, 133,
+		1, 0, 0, 0, 1034, 1035, 5, 30, 0, 0, 1035, 135, 1, 0, 0, 0, 1036, 1040,
+		5, 6, 0, 0, 1037, 1039, 5, 60, 0, 0, 1038, 1037, 1, 0, 0, 0, 1039, 1042,
+		1, 0, 0, 0, 1040, 1038, 1, 0, 0, 0, 1040, 1041, 1, 0, 0, 0, 1041, 1043,
+		1, 0, 0, 0, 1042, 1040, 1, 0, 0, 0, 1043, 1044, 3, 128, 64, 0, 1044, 1045,
+		5, 7, 0, 0, 1045, 137, 1, 0, 0, 0, 1046, 1047, 5, 28, 0, 0, 1047, 1048,
+		3, 80, 40, 0, 1048, 1052, 3, 82, 41, 0, 1049, 1051, 5, 60, 0, 0, 1050,
+		1049, 1, 0, 0, 0, 1051, 1054, 1, 0, 0, 0, 1052, 1050, 1, 0, 0, 0, 1052,
+		1053, 1, 0, 0, 0, 1053, 1055, 1, 0, 0, 0, 1054, 1052, 1, 0, 0, 0, 1055,
+		1056, 3, 124, 62, 0, 1056, 139, 1, 0, 0, 0, 1057, 1060, 3, 142, 71, 0,
+		1058, 1060, 5, 53, 0, 0, 1059, 1057, 1, 0, 0, 0, 1059, 1058, 1, 0, 0, 0,
+		1060, 1073, 1, 0, 0, 0, 1061, 1063, 5, 60, 0, 0, 1062, 1061, 1, 0, 0, 0,
+		1063, 1066, 1, 0, 0, 0, 1064, 1062, 1, 0, 0, 0, 1064, 1065, 1, 0, 0, 0,
+		1065, 1069, 1, 0, 0, 0, 1066, 1064, 1, 0, 0, 0, 1067, 1070, 3, 142, 71,
+		0, 1068, 1070, 5, 53, 0, 0, 1069, 1067, 1, 0, 0, 0, 1069, 1068, 1, 0, 0,
+		0, 1070, 1072, 1, 0, 0, 0, 1071, 1064, 1, 0, 0, 0, 1072, 1075, 1, 0, 0,
+		0, 1073, 1071, 1, 0, 0, 0, 1073, 1074, 1, 0, 0, 0, 1074, 141, 1, 0, 0,
+		0, 1075, 1073, 1, 0, 0, 0, 1076, 1079, 3, 144, 72, 0, 1077, 1079, 3, 150,
+		75, 0, 1078, 1076, 1, 0, 0, 0, 1078, 1077, 1, 0, 0, 0, 1079, 143, 1, 0,
+		0, 0, 1080, 1081, 3, 146, 73, 0, 1081, 1082, 5, 31, 0, 0, 1082, 1083, 3,
+		164, 82, 0, 1083, 145, 1, 0, 0, 0, 1084, 1087, 3, 152, 76, 0, 1085, 1087,
+		3, 148, 74, 0, 1086, 1084, 1, 0, 0, 0, 1086, 1085, 1, 0, 0, 0, 1087, 147,
+		1, 0, 0, 0, 1088, 1092, 5, 21, 0, 0, 1089, 1091, 5, 60, 0, 0, 1090, 1089,
+		1, 0, 0, 0, 1091, 1094, 1, 0, 0, 0, 1092, 1090, 1, 0, 0, 0, 1092, 1093,
+		1, 0, 0, 0, 1093, 1095, 1, 0, 0, 0, 1094, 1092, 1, 0, 0, 0, 1095, 1112,
+		3, 152, 76, 0, 1096, 1100, 5, 3, 0, 0, 1097, 1099, 5, 60, 0, 0, 1098, 1097,
+		1, 0, 0, 0, 1099, 1102, 1, 0, 0, 0, 1100, 1098, 1, 0, 0, 0, 1100, 1101,
+		1, 0, 0, 0, 1101, 1103, 1, 0, 0, 0, 1102, 1100, 1, 0, 0, 0, 1103, 1107,
+		3, 152, 76, 0, 1104, 1106, 5, 60, 0, 0, 1105, 1104, 1, 0, 0, 0, 1106, 1109,
+		1, 0, 0, 0, 1107, 1105, 1, 0, 0, 0, 1107, 1108, 1, 0, 0, 0, 1108, 1111,
+		1, 0, 0, 0, 1109, 1107, 1, 0, 0, 0, 1110, 1096, 1, 0, 0, 0, 1111, 1114,
+		1, 0, 0, 0, 1112, 1110, 1, 0, 0, 0, 1112, 1113, 1, 0, 0, 0, 1113, 1115,
+		1, 0, 0, 0, 1114, 1112, 1, 0, 0, 0, 1115, 1116, 5, 22, 0, 0, 1116, 149,
+		1, 0, 0, 0, 1117, 1118, 3, 182, 91, 0, 1118, 1119, 5, 32, 0, 0, 1119, 1120,
+		3, 182, 91, 0, 1120, 151, 1, 0, 0, 0, 1121, 1133, 3, 176, 88, 0, 1122,
+		1133, 3, 170, 85, 0, 1123, 1133, 3, 102, 51, 0, 1124, 1133, 3, 172, 86,
+		0, 1125, 1133, 3, 192, 96, 0, 1126, 1133, 3, 154, 77, 0, 1127, 1133, 3,
+		160, 80, 0, 1128, 1133, 3, 158, 79, 0, 1129, 1133, 3, 114, 57, 0, 1130,
+		1133, 3, 202, 101, 0, 1131, 1133, 3, 204, 102, 0, 1132, 1121, 1, 0, 0,
+		0, 1132, 1122, 1, 0, 0, 0, 1132, 1123, 1, 0, 0, 0, 1132, 1124, 1, 0, 0,
+		0, 1132, 1125, 1, 0, 0, 0, 1132, 1126, 1, 0, 0, 0, 1132, 1127, 1, 0, 0,
+		0, 1132, 1128, 1, 0, 0, 0, 1132, 1129, 1, 0, 0, 0, 1132, 1130, 1, 0, 0,
+		0, 1132, 1131, 1, 0, 0, 0, 1133, 153, 1, 0, 0, 0, 1134, 1135, 3, 156, 78,
+		0, 1135, 1136, 3, 152, 76, 0, 1136, 155, 1, 0, 0, 0, 1137, 1138, 7, 3,
+		0, 0, 1138, 157, 1, 0, 0, 0, 1139, 1140, 5, 2, 0, 0, 1140, 1141, 3, 152,
+		76, 0, 1141, 1142, 5, 30, 0, 0, 1142, 1143, 3, 152, 76, 0, 1143, 1144,
+		5, 8, 0, 0, 1144, 1145, 3, 152, 76, 0, 1145, 1146, 5, 4, 0, 0, 1146, 159,
+		1, 0, 0, 0, 1147, 1148, 5, 2, 0, 0, 1148, 1149, 3, 152, 76, 0, 1149, 1150,
+		3, 162, 81, 0, 1150, 1151, 3, 152, 76, 0, 1151, 1152, 5, 4, 0, 0, 1152,
+		161, 1, 0, 0, 0, 1153, 1154, 7, 4, 0, 0, 1154, 163, 1, 0, 0, 0, 1155, 1158,
+		3, 194, 97, 0, 1156, 1158, 3, 196, 98, 0, 1157, 1155, 1, 0, 0, 0, 1157,
+		1156, 1, 0, 0, 0, 1158, 165, 1, 0, 0, 0, 1159, 1160, 3, 144, 72, 0, 1160,
+		167, 1, 0, 0, 0, 1161, 1165, 5, 6, 0, 0, 1162, 1164, 5, 60, 0, 0, 1163,
+		1162, 1, 0, 0, 0, 1164, 1167, 1, 0, 0, 0, 1165, 1163, 1, 0, 0, 0, 1165,
+		1166, 1, 0, 0, 0, 1166, 1168, 1, 0, 0, 0, 1167, 1165, 1, 0, 0, 0, 1168,
+		1172, 3, 142, 71, 0, 1169, 1171, 5, 60, 0, 0, 1170, 1169, 1, 0, 0, 0, 1171,
+		1174, 1, 0, 0, 0, 1172, 1170, 1, 0, 0, 0, 1172, 1173, 1, 0, 0, 0, 1173,
+		1175, 1, 0, 0, 0, 1174, 1172, 1, 0, 0, 0, 1175, 1176, 5, 7, 0, 0, 1176,
+		169, 1, 0, 0, 0, 1177, 1178, 5, 49, 0, 0, 1178, 1179, 3, 28, 14, 0, 1179,
+		171, 1, 0, 0, 0, 1180, 1181, 3, 174, 87, 0, 1181, 1182, 5, 50, 0, 0, 1182,
+		1185, 3, 174, 87, 0, 1183, 1184, 5, 50, 0, 0, 1184, 1186, 3, 174, 87, 0,
+		1185, 1183, 1, 0, 0, 0, 1185, 1186, 1, 0, 0, 0, 1186, 173, 1, 0, 0, 0,
+		1187, 1189, 5, 57, 0, 0, 1188, 1187, 1, 0, 0, 0, 1188, 1189, 1, 0, 0, 0,
+		1189, 1190, 1, 0, 0, 0, 1190, 1194, 7, 5, 0, 0, 1191, 1194, 3, 170, 85,
+		0, 1192, 1194, 3, 176, 88, 0, 1193, 1188, 1, 0, 0, 0, 1193, 1191, 1, 0,
+		0, 0, 1193, 1192, 1, 0, 0, 0, 1194, 175, 1, 0, 0, 0, 1195, 1200, 3, 182,
+		91, 0, 1196, 1200, 3, 184, 92, 0, 1197, 1200, 3, 178, 89, 0, 1198, 1200,
+		3, 180, 90, 0, 1199, 1195, 1, 0, 0, 0, 1199, 1196, 1, 0, 0, 0, 1199, 1197,
+		1, 0, 0, 0, 1199, 1198, 1, 0, 0, 0, 1200, 177, 1, 0, 0, 0, 1201, 1202,
+		3, 186, 93, 0, 1202, 179, 1, 0, 0, 0, 1203, 1204, 3, 186, 93, 0, 1204,
+		1205, 3, 190, 95, 0, 1205, 181, 1, 0, 0, 0, 1206, 1208, 3, 186, 93, 0,
+		1207, 1206, 1, 0, 0, 0, 1207, 1208, 1, 0, 0, 0, 1208, 1209, 1, 0, 0, 0,
+		1209, 1210, 5, 8, 0, 0, 1210, 1211, 3, 188, 94, 0, 1211, 183, 1, 0, 0,
+		0, 1212, 1214, 3, 186, 93, 0, 1213, 1212, 1, 0, 0, 0, 1213, 1214, 1, 0,
+		0, 0, 1214, 1215, 1, 0, 0, 0, 1215, 1216, 5, 8, 0, 0, 1216, 1217, 3, 188,
+		94, 0, 1217, 1218, 3, 190, 95, 0, 1218, 185, 1, 0, 0, 0, 1219, 1220, 5,
+		55, 0, 0, 1220, 187, 1, 0, 0, 0, 1221, 1222, 5, 55, 0, 0, 1222, 189, 1,
+		0, 0, 0, 1223, 1224, 5, 21, 0, 0, 1224, 1225, 5, 56, 0, 0, 1225, 1226,
+		5, 22, 0, 0, 1226, 191, 1, 0, 0, 0, 1227, 1228, 5, 11, 0, 0, 1228, 1233,
+		5, 55, 0, 0, 1229, 1230, 5, 11, 0, 0, 1230, 1232, 5, 55, 0, 0, 1231, 1229,
+		1, 0, 0, 0, 1232, 1235, 1, 0, 0, 0, 1233, 1231, 1, 0, 0, 0, 1233, 1234,
+		1, 0, 0, 0, 1234, 193, 1, 0, 0, 0, 1235, 1233, 1, 0, 0, 0, 1236, 1241,
+		3, 166, 83, 0, 1237, 1241, 3, 176, 88, 0, 1238, 1241, 3, 168, 84, 0, 1239,
+		1241, 3, 198, 99, 0, 1240, 1236, 1, 0, 0, 0, 1240, 1237, 1, 0, 0, 0, 1240,
+		1238, 1, 0, 0, 0, 1240, 1239, 1, 0, 0, 0, 1241, 195, 1, 0, 0, 0, 1242,
+		1246, 5, 21, 0, 0, 1243, 1245, 5, 60, 0, 0, 1244, 1243, 1, 0, 0, 0, 1245,
+		1248, 1, 0, 0, 0, 1246, 1244, 1, 0, 0, 0, 1246, 1247, 1, 0, 0, 0, 1247,
+		1249, 1, 0, 0, 0, 1248, 1246, 1, 0, 0, 0, 1249, 1266, 3, 194, 97, 0, 1250,
+		1254, 5, 3, 0, 0, 1251, 1253, 5, 60, 0, 0, 1252, 1251, 1, 0, 0, 0, 1253,
+		1256, 1, 0, 0, 0, 1254, 1252, 1, 0, 0, 0, 1254, 1255, 1, 0, 0, 0, 1255,
+		1257, 1, 0, 0, 0, 1256, 1254, 1, 0, 0, 0, 1257, 1261, 3, 194, 97, 0, 1258,
+		1260, 5, 60, 0, 0, 1259, 1258, 1, 0, 0, 0, 1260, 1263, 1, 0, 0, 0, 1261,
+		1259, 1, 0, 0, 0, 1261, 1262, 1, 0, 0, 0, 1262, 1265, 1, 0, 0, 0, 1263,
+		1261, 1, 0, 0, 0, 1264, 1250, 1, 0, 0, 0, 1265, 1268, 1, 0, 0, 0, 1266,
+		1264, 1, 0, 0, 0, 1266, 1267, 1, 0, 0, 0, 1267, 1269, 1, 0, 0, 0, 1268,
+		1266, 1, 0, 0, 0, 1269, 1270, 5, 22, 0, 0, 1270, 197, 1, 0, 0, 0, 1271,
+		1275, 5, 51, 0, 0, 1272, 1274, 5, 60, 0, 0, 1273, 1272, 1, 0, 0, 0, 1274,
+		1277, 1, 0, 0, 0, 1275, 1273, 1, 0, 0, 0, 1275, 1276, 1, 0, 0, 0, 1276,
+		1278, 1, 0, 0, 0, 1277, 1275, 1, 0, 0, 0, 1278, 1282, 5, 6, 0, 0, 1279,
+		1281, 5, 60, 0, 0, 1280, 1279, 1, 0, 0, 0, 1281, 1284, 1, 0, 0, 0, 1282,
+		1280, 1, 0, 0, 0, 1282, 1283, 1, 0, 0, 0, 1283, 1285, 1, 0, 0, 0, 1284,
+		1282, 1, 0, 0, 0, 1285, 1294, 3, 144, 72, 0, 1286, 1288, 5, 60, 0, 0, 1287,
+		1286, 1, 0, 0, 0, 1288, 1289, 1, 0, 0, 0, 1289, 1287, 1, 0, 0, 0, 1289,
+		1290, 1, 0, 0, 0, 1290, 1291, 1, 0, 0, 0, 1291, 1293, 3, 144, 72, 0, 1292,
+		1287, 1, 0, 0, 0, 1293, 1296, 1, 0, 0, 0, 1294, 1292, 1, 0, 0, 0, 1294,
+		1295, 1, 0, 0, 0, 1295, 1303, 1, 0, 0, 0, 1296, 1294, 1, 0, 0, 0, 1297,
+		1299, 5, 60, 0, 0, 1298, 1297, 1, 0, 0, 0, 1299, 1300, 1, 0, 0, 0, 1300,
+		1298, 1, 0, 0, 0, 1300, 1301, 1, 0, 0, 0, 1301, 1302, 1, 0, 0, 0, 1302,
+		1304, 3, 200, 100, 0, 1303, 1298, 1, 0, 0, 0, 1303, 1304, 1, 0, 0, 0, 1304,
+		1308, 1, 0, 0, 0, 1305, 1307, 5, 60, 0, 0, 1306, 1305, 1, 0, 0, 0, 1307,
+		1310, 1, 0, 0, 0, 1308, 1306, 1, 0, 0, 0, 1308, 1309, 1, 0, 0, 0, 1309,
+		1311, 1, 0, 0, 0, 1310, 1308, 1, 0, 0, 0, 1311, 1312, 5, 7, 0, 0, 1312,
+		199, 1, 0, 0, 0, 1313, 1314, 5, 52, 0, 0, 1314, 1315, 5, 31, 0, 0, 1315,
+		1316, 3, 164, 82, 0, 1316, 201, 1, 0, 0, 0, 1317, 1321, 5, 21, 0, 0, 1318,
+		1320, 5, 60, 0, 0, 1319, 1318, 1, 0, 0, 0, 1320, 1323, 1, 0, 0, 0, 1321,
+		1319, 1, 0, 0, 0, 1321, 1322, 1, 0, 0, 0, 1322, 1344, 1, 0, 0, 0, 1323,
+		1321, 1, 0, 0, 0, 1324, 1341, 3, 100, 50, 0, 1325, 1329, 5, 3, 0, 0, 1326,
+		1328, 5, 60, 0, 0, 1327, 1326, 1, 0, 0, 0, 1328, 1331, 1, 0, 0, 0, 1329,
+		1327, 1, 0, 0, 0, 1329, 1330, 1, 0, 0, 0, 1330, 1332, 1, 0, 0, 0, 1331,
+		1329, 1, 0, 0, 0, 1332, 1336, 3, 100, 50, 0, 1333, 1335, 5, 60, 0, 0, 1334,
+		1333, 1, 0, 0, 0, 1335, 1338, 1, 0, 0, 0, 1336, 1334, 1, 0, 0, 0, 1336,
+		1337, 1, 0, 0, 0, 1337, 1340, 1, 0, 0, 0, 1338, 1336, 1, 0, 0, 0, 1339,
+		1325, 1, 0, 0, 0, 1340, 1343, 1, 0, 0, 0, 1341, 1339, 1, 0, 0, 0, 1341,
+		1342, 1, 0, 0, 0, 1342, 1345, 1, 0, 0, 0, 1343, 1341, 1, 0, 0, 0, 1344,
+		1324, 1, 0, 0, 0, 1344, 1345, 1, 0, 0, 0, 1345, 1346, 1, 0, 0, 0, 1346,
+		1347, 5, 22, 0, 0, 1347, 203, 1, 0, 0, 0, 1348, 1349, 3, 28, 14, 0, 1349,
+		1350, 5, 27, 0, 0, 1350, 1351, 5, 55, 0, 0, 1351, 1355, 5, 2, 0, 0, 1352,
+		1354, 5, 60, 0, 0, 1353, 1352, 1, 0, 0, 0, 1354, 1357, 1, 0, 0, 0, 1355,
+		1353, 1, 0, 0, 0, 1355, 1356, 1, 0, 0, 0, 1356, 1358, 1, 0, 0, 0, 1357,
+		1355, 1, 0, 0, 0, 1358, 1362, 3, 152, 76, 0, 1359, 1361, 5, 60, 0, 0, 1360,
+		1359, 1, 0, 0, 0, 1361, 1364, 1, 0, 0, 0, 1362, 1360, 1, 0, 0, 0, 1362,
+		1363, 1, 0, 0, 0, 1363, 1365, 1, 0, 0, 0, 1364, 1362, 1, 0, 0, 0, 1365,
+		1366, 5, 4, 0, 0, 1366, 205, 1, 0, 0, 0, 180, 209, 211, 221, 228, 233,
+		241, 249, 252, 258, 265, 271, 277, 281, 286, 294, 300, 308, 318, 323, 336,
+		343, 346, 349, 355, 359, 366, 374, 379, 387, 392, 399, 405, 410, 415, 421,
+		425, 431, 439, 445, 451, 460, 468, 473, 480, 485, 491, 498, 503, 511, 519,
+		525, 531, 537, 544, 552, 558, 564, 573, 580, 584, 592, 597, 605, 612, 619,
+		623, 631, 636, 641, 646, 653, 660, 666, 670, 673, 680, 687, 698, 702, 709,
+		712, 718, 723, 727, 733, 739, 746, 751, 755, 767, 776, 785, 789, 793, 800,
+		804, 808, 813, 825, 829, 839, 846, 851, 854, 858, 864, 868, 877, 883, 892,
+		896, 899, 906, 911, 918, 925, 930, 937, 940, 946, 951, 958, 961, 967, 972,
+		981, 987, 990, 995, 1000, 1003, 1006, 1010, 1016, 1020, 1025, 1029, 1032,
+		1040, 1052, 1059, 1064, 1069, 1073, 1078, 1086, 1092, 1100, 1107, 1112,
+		1132, 1157, 1165, 1172, 1185, 1188, 1193, 1199, 1207, 1213, 1233, 1240,
+		1246, 1254, 1261, 1266, 1275, 1282, 1289, 1294, 1300, 1303, 1308, 1321,
+		1329, 1336, 1341, 1344, 1355, 1362,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -669,15 +803,18 @@ const (
 	nevaParserT__46      = 47
 	nevaParserT__47      = 48
 	nevaParserT__48      = 49
-	nevaParserCOMMENT    = 50
-	nevaParserPUB_KW     = 51
-	nevaParserIDENTIFIER = 52
-	nevaParserINT        = 53
-	nevaParserMINUS      = 54
-	nevaParserFLOAT      = 55
-	nevaParserSTRING     = 56
-	nevaParserNEWLINE    = 57
-	nevaParserWS         = 58
+	nevaParserT__49      = 50
+	nevaParserT__50      = 51
+	nevaParserT__51      = 52
+	nevaParserCOMMENT    = 53
+	nevaParserPUB_KW     = 54
+	nevaParserIDENTIFIER = 55
+	nevaParserINT        = 56
+	nevaParserMINUS      = 57
+	nevaParserFLOAT      = 58
+	nevaParserSTRING     = 59
+	nevaParserNEWLINE    = 60
+	nevaParserWS         = 61
 )
 
 // nevaParser rules.
@@ -709,73 +846,82 @@ const (
 	nevaParserRULE_typeExpr               = 24
 	nevaParserRULE_typeInstExpr           = 25
 	nevaParserRULE_typeArgs               = 26
-	nevaParserRULE_typeLitExpr            = 27
-	nevaParserRULE_enumTypeExpr           = 28
-	nevaParserRULE_structTypeExpr         = 29
-	nevaParserRULE_structFields           = 30
-	nevaParserRULE_structField            = 31
-	nevaParserRULE_unionTypeExpr          = 32
-	nevaParserRULE_nonUnionTypeExpr       = 33
-	nevaParserRULE_interfaceStmt          = 34
-	nevaParserRULE_interfaceDef           = 35
-	nevaParserRULE_inPortsDef             = 36
-	nevaParserRULE_outPortsDef            = 37
-	nevaParserRULE_portsDef               = 38
-	nevaParserRULE_portDef                = 39
-	nevaParserRULE_singlePortDef          = 40
-	nevaParserRULE_arrayPortDef           = 41
-	nevaParserRULE_constStmt              = 42
-	nevaParserRULE_constDef               = 43
-	nevaParserRULE_constLit               = 44
-	nevaParserRULE_primitiveConstLit      = 45
-	nevaParserRULE_bool                   = 46
-	nevaParserRULE_enumLit                = 47
-	nevaParserRULE_listLit                = 48
-	nevaParserRULE_listItems              = 49
-	nevaParserRULE_compositeItem          = 50
-	nevaParserRULE_structLit              = 51
-	nevaParserRULE_structValueFields      = 52
-	nevaParserRULE_structValueField       = 53
-	nevaParserRULE_compStmt               = 54
-	nevaParserRULE_compDef                = 55
-	nevaParserRULE_compBody               = 56
-	nevaParserRULE_compNodesDef           = 57
-	nevaParserRULE_compNodesDefBody       = 58
-	nevaParserRULE_compNodeDef            = 59
-	nevaParserRULE_nodeInst               = 60
-	nevaParserRULE_errGuard               = 61
-	nevaParserRULE_nodeDIArgs             = 62
-	nevaParserRULE_connDefList            = 63
-	nevaParserRULE_connDef                = 64
-	nevaParserRULE_normConnDef            = 65
-	nevaParserRULE_senderSide             = 66
-	nevaParserRULE_multipleSenderSide     = 67
-	nevaParserRULE_arrBypassConnDef       = 68
-	nevaParserRULE_singleSenderSide       = 69
-	nevaParserRULE_unaryExpr              = 70
-	nevaParserRULE_unaryOp                = 71
-	nevaParserRULE_ternaryExpr            = 72
-	nevaParserRULE_binaryExpr             = 73
-	nevaParserRULE_binaryOp               = 74
-	nevaParserRULE_receiverSide           = 75
-	nevaParserRULE_chainedNormConn        = 76
-	nevaParserRULE_deferredConn           = 77
-	nevaParserRULE_senderConstRef         = 78
-	nevaParserRULE_rangeExpr              = 79
-	nevaParserRULE_rangeMember            = 80
-	nevaParserRULE_portAddr               = 81
-	nevaParserRULE_lonelySinglePortAddr   = 82
-	nevaParserRULE_lonelyArrPortAddr      = 83
-	nevaParserRULE_singlePortAddr         = 84
-	nevaParserRULE_arrPortAddr            = 85
-	nevaParserRULE_portAddrNode           = 86
-	nevaParserRULE_portAddrPort           = 87
-	nevaParserRULE_portAddrIdx            = 88
-	nevaParserRULE_structSelectors        = 89
-	nevaParserRULE_singleReceiverSide     = 90
-	nevaParserRULE_multipleReceiverSide   = 91
-	nevaParserRULE_switchStmt             = 92
-	nevaParserRULE_defaultCase            = 93
+	nevaParserRULE_openTypeExpr           = 27
+	nevaParserRULE_typeLitExpr            = 28
+	nevaParserRULE_enumTypeExpr           = 29
+	nevaParserRULE_structTypeExpr         = 30
+	nevaParserRULE_structFields           = 31
+	nevaParserRULE_structField            = 32
+	nevaParserRULE_taggedUnionTypeExpr    = 33
+	nevaParserRULE_unionTags              = 34
+	nevaParserRULE_unionTag               = 35
+	nevaParserRULE_unionTypeExpr          = 36
+	nevaParserRULE_nonUnionTypeExpr       = 37
+	nevaParserRULE_interfaceStmt          = 38
+	nevaParserRULE_interfaceDef           = 39
+	nevaParserRULE_inPortsDef             = 40
+	nevaParserRULE_outPortsDef            = 41
+	nevaParserRULE_portsDef               = 42
+	nevaParserRULE_portDef                = 43
+	nevaParserRULE_singlePortDef          = 44
+	nevaParserRULE_arrayPortDef           = 45
+	nevaParserRULE_constStmt              = 46
+	nevaParserRULE_constDef               = 47
+	nevaParserRULE_constExpr              = 48
+	nevaParserRULE_constOperand           = 49
+	nevaParserRULE_constLit               = 50
+	nevaParserRULE_primitiveConstLit      = 51
+	nevaParserRULE_bool                   = 52
+	nevaParserRULE_enumLit                = 53
+	nevaParserRULE_listLit                = 54
+	nevaParserRULE_listItems              = 55
+	nevaParserRULE_compositeItem          = 56
+	nevaParserRULE_structLit              = 57
+	nevaParserRULE_structValueFields      = 58
+	nevaParserRULE_structValueField       = 59
+	nevaParserRULE_compStmt               = 60
+	nevaParserRULE_compDef                = 61
+	nevaParserRULE_compBody               = 62
+	nevaParserRULE_compNodesDef           = 63
+	nevaParserRULE_compNodesDefBody       = 64
+	nevaParserRULE_compNodeDef            = 65
+	nevaParserRULE_nodeInst               = 66
+	nevaParserRULE_errGuard               = 67
+	nevaParserRULE_nodeDIArgs             = 68
+	nevaParserRULE_anonCompDef            = 69
+	nevaParserRULE_connDefList            = 70
+	nevaParserRULE_connDef                = 71
+	nevaParserRULE_normConnDef            = 72
+	nevaParserRULE_senderSide             = 73
+	nevaParserRULE_multipleSenderSide     = 74
+	nevaParserRULE_arrBypassConnDef       = 75
+	nevaParserRULE_singleSenderSide       = 76
+	nevaParserRULE_unaryExpr              = 77
+	nevaParserRULE_unaryOp                = 78
+	nevaParserRULE_ternaryExpr            = 79
+	nevaParserRULE_binaryExpr             = 80
+	nevaParserRULE_binaryOp               = 81
+	nevaParserRULE_receiverSide           = 82
+	nevaParserRULE_chainedNormConn        = 83
+	nevaParserRULE_deferredConn           = 84
+	nevaParserRULE_senderConstRef         = 85
+	nevaParserRULE_rangeExpr              = 86
+	nevaParserRULE_rangeMember            = 87
+	nevaParserRULE_portAddr               = 88
+	nevaParserRULE_lonelySinglePortAddr   = 89
+	nevaParserRULE_lonelyArrPortAddr      = 90
+	nevaParserRULE_singlePortAddr         = 91
+	nevaParserRULE_arrPortAddr            = 92
+	nevaParserRULE_portAddrNode           = 93
+	nevaParserRULE_portAddrPort           = 94
+	nevaParserRULE_portAddrIdx            = 95
+	nevaParserRULE_structSelectors        = 96
+	nevaParserRULE_singleReceiverSide     = 97
+	nevaParserRULE_multipleReceiverSide   = 98
+	nevaParserRULE_switchStmt             = 99
+	nevaParserRULE_defaultCase            = 100
+	nevaParserRULE_listSenderLit          = 101
+	nevaParserRULE_unionSender            = 102
 )
 
 // IProgContext is an interface to support dynamic dispatch.
@@ -917,15 +1063,15 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(193)
+	p.SetState(211)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&147492887865856034) != 0 {
-		p.SetState(191)
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1179943102648946722) != 0 {
+		p.SetState(209)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -934,7 +1080,7 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 		switch p.GetTokenStream().LA(1) {
 		case nevaParserNEWLINE:
 			{
-				p.SetState(188)
+				p.SetState(206)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -944,7 +1090,7 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 
 		case nevaParserCOMMENT:
 			{
-				p.SetState(189)
+				p.SetState(207)
 				p.Match(nevaParserCOMMENT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -952,9 +1098,9 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 				}
 			}
 
-		case nevaParserT__0, nevaParserT__4, nevaParserT__11, nevaParserT__17, nevaParserT__20, nevaParserT__25, nevaParserPUB_KW:
+		case nevaParserT__0, nevaParserT__4, nevaParserT__11, nevaParserT__19, nevaParserT__22, nevaParserT__27, nevaParserPUB_KW:
 			{
-				p.SetState(190)
+				p.SetState(208)
 				p.Stmt()
 			}
 
@@ -963,7 +1109,7 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 			goto errorExit
 		}
 
-		p.SetState(195)
+		p.SetState(213)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -971,7 +1117,7 @@ func (p *nevaParser) Prog() (localctx IProgContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(196)
+		p.SetState(214)
 		p.Match(nevaParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1145,7 +1291,7 @@ func (s *StmtContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *nevaParser) Stmt() (localctx IStmtContext) {
 	localctx = NewStmtContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 2, nevaParserRULE_stmt)
-	p.SetState(203)
+	p.SetState(221)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1155,35 +1301,35 @@ func (p *nevaParser) Stmt() (localctx IStmtContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(198)
+			p.SetState(216)
 			p.ImportStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(199)
+			p.SetState(217)
 			p.TypeStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(200)
+			p.SetState(218)
 			p.InterfaceStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(201)
+			p.SetState(219)
 			p.ConstStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(202)
+			p.SetState(220)
 			p.CompStmt()
 		}
 
@@ -1328,7 +1474,7 @@ func (p *nevaParser) CompilerDirectives() (localctx ICompilerDirectivesContext)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(208)
+	p.SetState(226)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1337,11 +1483,11 @@ func (p *nevaParser) CompilerDirectives() (localctx ICompilerDirectivesContext)
 
 	for ok := true; ok; ok = _la == nevaParserT__0 {
 		{
-			p.SetState(205)
+			p.SetState(223)
 			p.CompilerDirective()
 		}
 		{
-			p.SetState(206)
+			p.SetState(224)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1349,7 +1495,7 @@ func (p *nevaParser) CompilerDirectives() (localctx ICompilerDirectivesContext)
 			}
 		}
 
-		p.SetState(210)
+		p.SetState(228)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1464,7 +1610,7 @@ func (p *nevaParser) CompilerDirective() (localctx ICompilerDirectiveContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(212)
+		p.SetState(230)
 		p.Match(nevaParserT__0)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1472,14 +1618,14 @@ func (p *nevaParser) CompilerDirective() (localctx ICompilerDirectiveContext) {
 		}
 	}
 	{
-		p.SetState(213)
+		p.SetState(231)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(215)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1488,7 +1634,7 @@ func (p *nevaParser) CompilerDirective() (localctx ICompilerDirectiveContext) {
 
 	if _la == nevaParserT__1 {
 		{
-			p.SetState(214)
+			p.SetState(232)
 			p.CompilerDirectivesArgs()
 		}
 
@@ -1622,7 +1768,7 @@ func (p *nevaParser) CompilerDirectivesArgs() (localctx ICompilerDirectivesArgsC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
+		p.SetState(235)
 		p.Match(nevaParserT__1)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1630,10 +1776,10 @@ func (p *nevaParser) CompilerDirectivesArgs() (localctx ICompilerDirectivesArgsC
 		}
 	}
 	{
-		p.SetState(218)
+		p.SetState(236)
 		p.Compiler_directive_arg()
 	}
-	p.SetState(223)
+	p.SetState(241)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1642,7 +1788,7 @@ func (p *nevaParser) CompilerDirectivesArgs() (localctx ICompilerDirectivesArgsC
 
 	for _la == nevaParserT__2 {
 		{
-			p.SetState(219)
+			p.SetState(237)
 			p.Match(nevaParserT__2)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1650,11 +1796,11 @@ func (p *nevaParser) CompilerDirectivesArgs() (localctx ICompilerDirectivesArgsC
 			}
 		}
 		{
-			p.SetState(220)
+			p.SetState(238)
 			p.Compiler_directive_arg()
 		}
 
-		p.SetState(225)
+		p.SetState(243)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1662,7 +1808,7 @@ func (p *nevaParser) CompilerDirectivesArgs() (localctx ICompilerDirectivesArgsC
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(226)
+		p.SetState(244)
 		p.Match(nevaParserT__3)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1693,6 +1839,7 @@ type ICompiler_directive_argContext interface {
 	// Getter signatures
 	AllIDENTIFIER() []antlr.TerminalNode
 	IDENTIFIER(i int) antlr.TerminalNode
+	INT() antlr.TerminalNode
 
 	// IsCompiler_directive_argContext differentiates from other interfaces.
 	IsCompiler_directive_argContext()
@@ -1738,6 +1885,10 @@ func (s *Compiler_directive_argContext) IDENTIFIER(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserIDENTIFIER, i)
 }
 
+func (s *Compiler_directive_argContext) INT() antlr.TerminalNode {
+	return s.GetToken(nevaParserINT, 0)
+}
+
 func (s *Compiler_directive_argContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1763,30 +1914,54 @@ func (p *nevaParser) Compiler_directive_arg() (localctx ICompiler_directive_argC
 	p.EnterRule(localctx, 10, nevaParserRULE_compiler_directive_arg)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(229)
+	p.SetState(252)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = _la == nevaParserIDENTIFIER {
+	switch p.GetTokenStream().LA(1) {
+	case nevaParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(247)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == nevaParserIDENTIFIER {
+			{
+				p.SetState(246)
+				p.Match(nevaParserIDENTIFIER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(249)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case nevaParserINT:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(228)
-			p.Match(nevaParserIDENTIFIER)
+			p.SetState(251)
+			p.Match(nevaParserINT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-		p.SetState(231)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -1927,14 +2102,14 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(233)
+		p.SetState(254)
 		p.Match(nevaParserT__4)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(237)
+	p.SetState(258)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1943,7 +2118,7 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(234)
+			p.SetState(255)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1951,7 +2126,7 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 			}
 		}
 
-		p.SetState(239)
+		p.SetState(260)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1959,14 +2134,14 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(240)
+		p.SetState(261)
 		p.Match(nevaParserT__5)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(244)
+	p.SetState(265)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1975,7 +2150,7 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(241)
+			p.SetState(262)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1983,14 +2158,14 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 			}
 		}
 
-		p.SetState(246)
+		p.SetState(267)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(250)
+	p.SetState(271)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1999,11 +2174,11 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 
 	for _la == nevaParserT__8 || _la == nevaParserIDENTIFIER {
 		{
-			p.SetState(247)
+			p.SetState(268)
 			p.ImportDef()
 		}
 
-		p.SetState(252)
+		p.SetState(273)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2011,7 +2186,7 @@ func (p *nevaParser) ImportStmt() (localctx IImportStmtContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(253)
+		p.SetState(274)
 		p.Match(nevaParserT__6)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2147,12 +2322,12 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(256)
+	p.SetState(277)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(255)
+			p.SetState(276)
 			p.ImportAlias()
 		}
 
@@ -2160,10 +2335,10 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(258)
+		p.SetState(279)
 		p.ImportPath()
 	}
-	p.SetState(260)
+	p.SetState(281)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2172,7 +2347,7 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 
 	if _la == nevaParserT__2 {
 		{
-			p.SetState(259)
+			p.SetState(280)
 			p.Match(nevaParserT__2)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2181,7 +2356,7 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 		}
 
 	}
-	p.SetState(265)
+	p.SetState(286)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2190,7 +2365,7 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(262)
+			p.SetState(283)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2198,7 +2373,7 @@ func (p *nevaParser) ImportDef() (localctx IImportDefContext) {
 			}
 		}
 
-		p.SetState(267)
+		p.SetState(288)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2294,7 +2469,7 @@ func (p *nevaParser) ImportAlias() (localctx IImportAliasContext) {
 	p.EnterRule(localctx, 16, nevaParserRULE_importAlias)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(268)
+		p.SetState(289)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2418,16 +2593,16 @@ func (p *nevaParser) ImportPath() (localctx IImportPathContext) {
 	localctx = NewImportPathContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 18, nevaParserRULE_importPath)
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(273)
+	p.SetState(294)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(270)
+			p.SetState(291)
 			p.ImportPathMod()
 		}
 		{
-			p.SetState(271)
+			p.SetState(292)
 			p.Match(nevaParserT__7)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2439,7 +2614,7 @@ func (p *nevaParser) ImportPath() (localctx IImportPathContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(275)
+		p.SetState(296)
 		p.ImportPathPkg()
 	}
 
@@ -2541,7 +2716,7 @@ func (s *ImportPathModContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *nevaParser) ImportPathMod() (localctx IImportPathModContext) {
 	localctx = NewImportPathModContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 20, nevaParserRULE_importPathMod)
-	p.SetState(279)
+	p.SetState(300)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2551,7 +2726,7 @@ func (p *nevaParser) ImportPathMod() (localctx IImportPathModContext) {
 	case nevaParserT__8:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(277)
+			p.SetState(298)
 			p.Match(nevaParserT__8)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2562,7 +2737,7 @@ func (p *nevaParser) ImportPathMod() (localctx IImportPathModContext) {
 	case nevaParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(278)
+			p.SetState(299)
 			p.ImportMod()
 		}
 
@@ -2709,14 +2884,14 @@ func (p *nevaParser) ImportMod() (localctx IImportModContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(281)
+		p.SetState(302)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(287)
+	p.SetState(308)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2725,11 +2900,11 @@ func (p *nevaParser) ImportMod() (localctx IImportModContext) {
 
 	for _la == nevaParserT__9 || _la == nevaParserT__10 {
 		{
-			p.SetState(282)
+			p.SetState(303)
 			p.ImportModeDelim()
 		}
 		{
-			p.SetState(283)
+			p.SetState(304)
 			p.Match(nevaParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2737,7 +2912,7 @@ func (p *nevaParser) ImportMod() (localctx IImportModContext) {
 			}
 		}
 
-		p.SetState(289)
+		p.SetState(310)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2826,7 +3001,7 @@ func (p *nevaParser) ImportModeDelim() (localctx IImportModeDelimContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(290)
+		p.SetState(311)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == nevaParserT__9 || _la == nevaParserT__10) {
@@ -2932,14 +3107,14 @@ func (p *nevaParser) ImportPathPkg() (localctx IImportPathPkgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(292)
+		p.SetState(313)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(297)
+	p.SetState(318)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2948,7 +3123,7 @@ func (p *nevaParser) ImportPathPkg() (localctx IImportPathPkgContext) {
 
 	for _la == nevaParserT__9 {
 		{
-			p.SetState(293)
+			p.SetState(314)
 			p.Match(nevaParserT__9)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2956,7 +3131,7 @@ func (p *nevaParser) ImportPathPkg() (localctx IImportPathPkgContext) {
 			}
 		}
 		{
-			p.SetState(294)
+			p.SetState(315)
 			p.Match(nevaParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2964,7 +3139,7 @@ func (p *nevaParser) ImportPathPkg() (localctx IImportPathPkgContext) {
 			}
 		}
 
-		p.SetState(299)
+		p.SetState(320)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3087,24 +3262,24 @@ func (s *EntityRefContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *nevaParser) EntityRef() (localctx IEntityRefContext) {
 	localctx = NewEntityRefContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 28, nevaParserRULE_entityRef)
-	p.SetState(302)
+	p.SetState(323)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(300)
+			p.SetState(321)
 			p.ImportedEntityRef()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(301)
+			p.SetState(322)
 			p.LocalEntityRef()
 		}
 
@@ -3200,7 +3375,7 @@ func (p *nevaParser) LocalEntityRef() (localctx ILocalEntityRefContext) {
 	p.EnterRule(localctx, 30, nevaParserRULE_localEntityRef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(304)
+		p.SetState(325)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3325,11 +3500,11 @@ func (p *nevaParser) ImportedEntityRef() (localctx IImportedEntityRefContext) {
 	p.EnterRule(localctx, 32, nevaParserRULE_importedEntityRef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(306)
+		p.SetState(327)
 		p.PkgRef()
 	}
 	{
-		p.SetState(307)
+		p.SetState(328)
 		p.Match(nevaParserT__10)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3337,7 +3512,7 @@ func (p *nevaParser) ImportedEntityRef() (localctx IImportedEntityRefContext) {
 		}
 	}
 	{
-		p.SetState(308)
+		p.SetState(329)
 		p.EntityName()
 	}
 
@@ -3429,7 +3604,7 @@ func (p *nevaParser) PkgRef() (localctx IPkgRefContext) {
 	p.EnterRule(localctx, 34, nevaParserRULE_pkgRef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(310)
+		p.SetState(331)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3525,7 +3700,7 @@ func (p *nevaParser) EntityName() (localctx IEntityNameContext) {
 	p.EnterRule(localctx, 36, nevaParserRULE_entityName)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(312)
+		p.SetState(333)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3639,7 +3814,7 @@ func (p *nevaParser) TypeStmt() (localctx ITypeStmtContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(315)
+	p.SetState(336)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3648,7 +3823,7 @@ func (p *nevaParser) TypeStmt() (localctx ITypeStmtContext) {
 
 	if _la == nevaParserPUB_KW {
 		{
-			p.SetState(314)
+			p.SetState(335)
 			p.Match(nevaParserPUB_KW)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3658,7 +3833,7 @@ func (p *nevaParser) TypeStmt() (localctx ITypeStmtContext) {
 
 	}
 	{
-		p.SetState(317)
+		p.SetState(338)
 		p.Match(nevaParserT__11)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3666,7 +3841,7 @@ func (p *nevaParser) TypeStmt() (localctx ITypeStmtContext) {
 		}
 	}
 	{
-		p.SetState(318)
+		p.SetState(339)
 		p.TypeDef()
 	}
 
@@ -3799,14 +3974,14 @@ func (p *nevaParser) TypeDef() (localctx ITypeDefContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(320)
+		p.SetState(341)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(322)
+	p.SetState(343)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3815,31 +3990,31 @@ func (p *nevaParser) TypeDef() (localctx ITypeDefContext) {
 
 	if _la == nevaParserT__12 {
 		{
-			p.SetState(321)
+			p.SetState(342)
 			p.TypeParams()
 		}
 
 	}
-	p.SetState(325)
+	p.SetState(346)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4503599627468800) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&36028797019881472) != 0 {
 		{
-			p.SetState(324)
+			p.SetState(345)
 			p.TypeExpr()
 		}
 
 	}
-	p.SetState(328)
+	p.SetState(349)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(327)
+			p.SetState(348)
 			p.Match(nevaParserCOMMENT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3875,6 +4050,10 @@ type ITypeParamsContext interface {
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 	TypeParamList() ITypeParamListContext
+	IDENTIFIER() antlr.TerminalNode
+	OpenTypeExpr() IOpenTypeExprContext
+	AllTypeParam() []ITypeParamContext
+	TypeParam(i int) ITypeParamContext
 
 	// IsTypeParamsContext differentiates from other interfaces.
 	IsTypeParamsContext()
@@ -3936,150 +4115,27 @@ func (s *TypeParamsContext) TypeParamList() ITypeParamListContext {
 	return t.(ITypeParamListContext)
 }
 
-func (s *TypeParamsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *TypeParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *TypeParamsContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterTypeParams(s)
-	}
-}
-
-func (s *TypeParamsContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitTypeParams(s)
-	}
+func (s *TypeParamsContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
 }
 
-func (p *nevaParser) TypeParams() (localctx ITypeParamsContext) {
-	localctx = NewTypeParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, nevaParserRULE_typeParams)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(330)
-		p.Match(nevaParserT__12)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(334)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(331)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(336)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(338)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserIDENTIFIER {
-		{
-			p.SetState(337)
-			p.TypeParamList()
-		}
-
-	}
-	{
-		p.SetState(340)
-		p.Match(nevaParserT__13)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *TypeParamsContext) OpenTypeExpr() IOpenTypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOpenTypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// ITypeParamListContext is an interface to support dynamic dispatch.
-type ITypeParamListContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllTypeParam() []ITypeParamContext
-	TypeParam(i int) ITypeParamContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-
-	// IsTypeParamListContext differentiates from other interfaces.
-	IsTypeParamListContext()
-}
-
-type TypeParamListContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyTypeParamListContext() *TypeParamListContext {
-	var p = new(TypeParamListContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeParamList
-	return p
-}
-
-func InitEmptyTypeParamListContext(p *TypeParamListContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeParamList
-}
-
-func (*TypeParamListContext) IsTypeParamListContext() {}
-
-func NewTypeParamListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParamListContext {
-	var p = new(TypeParamListContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_typeParamList
 
-	return p
+	return t.(IOpenTypeExprContext)
 }
 
-func (s *TypeParamListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeParamListContext) AllTypeParam() []ITypeParamContext {
+func (s *TypeParamsContext) AllTypeParam() []ITypeParamContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4100,7 +4156,7 @@ func (s *TypeParamListContext) AllTypeParam() []ITypeParamContext {
 	return tst
 }
 
-func (s *TypeParamListContext) TypeParam(i int) ITypeParamContext {
+func (s *TypeParamsContext) TypeParam(i int) ITypeParamContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4120,61 +4176,51 @@ func (s *TypeParamListContext) TypeParam(i int) ITypeParamContext {
 	return t.(ITypeParamContext)
 }
 
-func (s *TypeParamListContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *TypeParamListContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
-}
-
-func (s *TypeParamListContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeParamsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeParamListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeParamListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeParamsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterTypeParamList(s)
+		listenerT.EnterTypeParams(s)
 	}
 }
 
-func (s *TypeParamListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeParamsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitTypeParamList(s)
+		listenerT.ExitTypeParams(s)
 	}
 }
 
-func (p *nevaParser) TypeParamList() (localctx ITypeParamListContext) {
-	localctx = NewTypeParamListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, nevaParserRULE_typeParamList)
+func (p *nevaParser) TypeParams() (localctx ITypeParamsContext) {
+	localctx = NewTypeParamsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, nevaParserRULE_typeParams)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(342)
-		p.TypeParam()
-	}
-	p.SetState(353)
+	var _alt int
+
+	p.SetState(392)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserT__2 {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(343)
-			p.Match(nevaParserT__2)
+			p.SetState(351)
+			p.Match(nevaParserT__12)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(347)
+		p.SetState(355)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4183,7 +4229,7 @@ func (p *nevaParser) TypeParamList() (localctx ITypeParamListContext) {
 
 		for _la == nevaParserNEWLINE {
 			{
-				p.SetState(344)
+				p.SetState(352)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -4191,31 +4237,183 @@ func (p *nevaParser) TypeParamList() (localctx ITypeParamListContext) {
 				}
 			}
 
-			p.SetState(349)
+			p.SetState(357)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
 		}
-		{
-			p.SetState(350)
-			p.TypeParam()
-		}
-
-		p.SetState(355)
+		p.SetState(359)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
+		if _la == nevaParserIDENTIFIER {
+			{
+				p.SetState(358)
+				p.TypeParamList()
+			}
+
+		}
+		{
+			p.SetState(361)
+			p.Match(nevaParserT__13)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(362)
+			p.Match(nevaParserT__12)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(366)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(363)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(368)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(379)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 27, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(369)
+					p.TypeParam()
+				}
+				{
+					p.SetState(370)
+					p.Match(nevaParserT__2)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				p.SetState(374)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				for _la == nevaParserNEWLINE {
+					{
+						p.SetState(371)
+						p.Match(nevaParserNEWLINE)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+					p.SetState(376)
+					p.GetErrorHandler().Sync(p)
+					if p.HasError() {
+						goto errorExit
+					}
+					_la = p.GetTokenStream().LA(1)
+				}
+
+			}
+			p.SetState(381)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 27, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(382)
+			p.Match(nevaParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(383)
+			p.OpenTypeExpr()
+		}
+		p.SetState(387)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(384)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(389)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(390)
+			p.Match(nevaParserT__14)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
@@ -4224,65 +4422,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeParamContext is an interface to support dynamic dispatch.
-type ITypeParamContext interface {
+// ITypeParamListContext is an interface to support dynamic dispatch.
+type ITypeParamListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	TypeExpr() ITypeExprContext
+	AllTypeParam() []ITypeParamContext
+	TypeParam(i int) ITypeParamContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsTypeParamContext differentiates from other interfaces.
-	IsTypeParamContext()
+	// IsTypeParamListContext differentiates from other interfaces.
+	IsTypeParamListContext()
 }
 
-type TypeParamContext struct {
+type TypeParamListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeParamContext() *TypeParamContext {
-	var p = new(TypeParamContext)
+func NewEmptyTypeParamListContext() *TypeParamListContext {
+	var p = new(TypeParamListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeParam
+	p.RuleIndex = nevaParserRULE_typeParamList
 	return p
 }
 
-func InitEmptyTypeParamContext(p *TypeParamContext) {
+func InitEmptyTypeParamListContext(p *TypeParamListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeParam
+	p.RuleIndex = nevaParserRULE_typeParamList
 }
 
-func (*TypeParamContext) IsTypeParamContext() {}
+func (*TypeParamListContext) IsTypeParamListContext() {}
 
-func NewTypeParamContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParamContext {
-	var p = new(TypeParamContext)
+func NewTypeParamListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParamListContext {
+	var p = new(TypeParamListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_typeParam
+	p.RuleIndex = nevaParserRULE_typeParamList
 
 	return p
 }
 
-func (s *TypeParamContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeParamListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeParamContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *TypeParamListContext) AllTypeParam() []ITypeParamContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeParamContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeParamContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeParamContext); ok {
+			tst[i] = t.(ITypeParamContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *TypeParamContext) TypeExpr() ITypeExprContext {
+func (s *TypeParamListContext) TypeParam(i int) ITypeParamContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITypeParamContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -4290,99 +4509,272 @@ func (s *TypeParamContext) TypeExpr() ITypeExprContext {
 		return nil
 	}
 
-	return t.(ITypeExprContext)
+	return t.(ITypeParamContext)
 }
 
-func (s *TypeParamContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *TypeParamListContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *TypeParamContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *TypeParamListContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *TypeParamContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeParamListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeParamContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeParamListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeParamContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeParamListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterTypeParam(s)
+		listenerT.EnterTypeParamList(s)
 	}
 }
 
-func (s *TypeParamContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeParamListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitTypeParam(s)
+		listenerT.ExitTypeParamList(s)
 	}
 }
 
-func (p *nevaParser) TypeParam() (localctx ITypeParamContext) {
-	localctx = NewTypeParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, nevaParserRULE_typeParam)
+func (p *nevaParser) TypeParamList() (localctx ITypeParamListContext) {
+	localctx = NewTypeParamListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, nevaParserRULE_typeParamList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(356)
-		p.Match(nevaParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(358)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4503599627468800) != 0 {
-		{
-			p.SetState(357)
-			p.TypeExpr()
-		}
-
+		p.SetState(394)
+		p.TypeParam()
 	}
-	p.SetState(363)
+	p.SetState(405)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	for _la == nevaParserT__2 {
 		{
-			p.SetState(360)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(395)
+			p.Match(nevaParserT__2)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-		p.SetState(365)
+		p.SetState(399)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(396)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(401)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(402)
+			p.TypeParam()
+		}
+
+		p.SetState(407)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITypeParamContext is an interface to support dynamic dispatch.
+type ITypeParamContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	TypeExpr() ITypeExprContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsTypeParamContext differentiates from other interfaces.
+	IsTypeParamContext()
+}
+
+type TypeParamContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeParamContext() *TypeParamContext {
+	var p = new(TypeParamContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_typeParam
+	return p
+}
+
+func InitEmptyTypeParamContext(p *TypeParamContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_typeParam
+}
+
+func (*TypeParamContext) IsTypeParamContext() {}
+
+func NewTypeParamContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParamContext {
+	var p = new(TypeParamContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_typeParam
+
+	return p
+}
+
+func (s *TypeParamContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeParamContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *TypeParamContext) TypeExpr() ITypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeExprContext)
+}
+
+func (s *TypeParamContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *TypeParamContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *TypeParamContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeParamContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeParamContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterTypeParam(s)
+	}
+}
+
+func (s *TypeParamContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitTypeParam(s)
+	}
+}
+
+func (p *nevaParser) TypeParam() (localctx ITypeParamContext) {
+	localctx = NewTypeParamContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, nevaParserRULE_typeParam)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(408)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(410)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&36028797019881472) != 0 {
+		{
+			p.SetState(409)
+			p.TypeExpr()
+		}
+
+	}
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(412)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(417)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
@@ -4506,31 +4898,31 @@ func (s *TypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *nevaParser) TypeExpr() (localctx ITypeExprContext) {
 	localctx = NewTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 48, nevaParserRULE_typeExpr)
-	p.SetState(369)
+	p.SetState(421)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(366)
+			p.SetState(418)
 			p.TypeInstExpr()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(367)
+			p.SetState(419)
 			p.TypeLitExpr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(368)
+			p.SetState(420)
 			p.UnionTypeExpr()
 		}
 
@@ -4657,10 +5049,10 @@ func (p *nevaParser) TypeInstExpr() (localctx ITypeInstExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(371)
+		p.SetState(423)
 		p.EntityRef()
 	}
-	p.SetState(373)
+	p.SetState(425)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4669,7 +5061,7 @@ func (p *nevaParser) TypeInstExpr() (localctx ITypeInstExprContext) {
 
 	if _la == nevaParserT__12 {
 		{
-			p.SetState(372)
+			p.SetState(424)
 			p.TypeArgs()
 		}
 
@@ -4700,6 +5092,7 @@ type ITypeArgsContext interface {
 	TypeExpr(i int) ITypeExprContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
+	OpenTypeExpr() IOpenTypeExprContext
 
 	// IsTypeArgsContext differentiates from other interfaces.
 	IsTypeArgsContext()
@@ -4786,6 +5179,22 @@ func (s *TypeArgsContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
+func (s *TypeArgsContext) OpenTypeExpr() IOpenTypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOpenTypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOpenTypeExprContext)
+}
+
 func (s *TypeArgsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4811,60 +5220,26 @@ func (p *nevaParser) TypeArgs() (localctx ITypeArgsContext) {
 	p.EnterRule(localctx, 52, nevaParserRULE_typeArgs)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(375)
-		p.Match(nevaParserT__12)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(379)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(376)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	var _alt int
 
-		p.SetState(381)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(382)
-		p.TypeExpr()
-	}
-	p.SetState(393)
+	p.SetState(485)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserT__2 {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 44, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(383)
-			p.Match(nevaParserT__2)
+			p.SetState(427)
+			p.Match(nevaParserT__12)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(387)
+		p.SetState(431)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4873,7 +5248,7 @@ func (p *nevaParser) TypeArgs() (localctx ITypeArgsContext) {
 
 		for _la == nevaParserNEWLINE {
 			{
-				p.SetState(384)
+				p.SetState(428)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -4881,7 +5256,7 @@ func (p *nevaParser) TypeArgs() (localctx ITypeArgsContext) {
 				}
 			}
 
-			p.SetState(389)
+			p.SetState(433)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -4889,48 +5264,226 @@ func (p *nevaParser) TypeArgs() (localctx ITypeArgsContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(390)
+			p.SetState(434)
 			p.TypeExpr()
 		}
-
-		p.SetState(395)
+		p.SetState(445)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(399)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+		for _la == nevaParserT__2 {
+			{
+				p.SetState(435)
+				p.Match(nevaParserT__2)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(439)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(436)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(441)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(442)
+				p.TypeExpr()
+			}
+
+			p.SetState(447)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(451)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(448)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(453)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
 		{
-			p.SetState(396)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(454)
+			p.Match(nevaParserT__13)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-		p.SetState(401)
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(456)
+			p.Match(nevaParserT__12)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(460)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(402)
-		p.Match(nevaParserT__13)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(457)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(462)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(473)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(463)
+					p.TypeExpr()
+				}
+				{
+					p.SetState(464)
+					p.Match(nevaParserT__2)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				p.SetState(468)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				for _la == nevaParserNEWLINE {
+					{
+						p.SetState(465)
+						p.Match(nevaParserNEWLINE)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+					p.SetState(470)
+					p.GetErrorHandler().Sync(p)
+					if p.HasError() {
+						goto errorExit
+					}
+					_la = p.GetTokenStream().LA(1)
+				}
+
+			}
+			p.SetState(475)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(476)
+			p.OpenTypeExpr()
+		}
+		p.SetState(480)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(477)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(482)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(483)
+			p.Match(nevaParserT__14)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -4946,57 +5499,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeLitExprContext is an interface to support dynamic dispatch.
-type ITypeLitExprContext interface {
+// IOpenTypeExprContext is an interface to support dynamic dispatch.
+type IOpenTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EnumTypeExpr() IEnumTypeExprContext
-	StructTypeExpr() IStructTypeExprContext
+	EntityRef() IEntityRefContext
+	AllTypeExpr() []ITypeExprContext
+	TypeExpr(i int) ITypeExprContext
+	AllNonUnionTypeExpr() []INonUnionTypeExprContext
+	NonUnionTypeExpr(i int) INonUnionTypeExprContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsTypeLitExprContext differentiates from other interfaces.
-	IsTypeLitExprContext()
+	// IsOpenTypeExprContext differentiates from other interfaces.
+	IsOpenTypeExprContext()
 }
 
-type TypeLitExprContext struct {
+type OpenTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeLitExprContext() *TypeLitExprContext {
-	var p = new(TypeLitExprContext)
+func NewEmptyOpenTypeExprContext() *OpenTypeExprContext {
+	var p = new(OpenTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeLitExpr
+	p.RuleIndex = nevaParserRULE_openTypeExpr
 	return p
 }
 
-func InitEmptyTypeLitExprContext(p *TypeLitExprContext) {
+func InitEmptyOpenTypeExprContext(p *OpenTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_typeLitExpr
+	p.RuleIndex = nevaParserRULE_openTypeExpr
 }
 
-func (*TypeLitExprContext) IsTypeLitExprContext() {}
+func (*OpenTypeExprContext) IsOpenTypeExprContext() {}
 
-func NewTypeLitExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitExprContext {
-	var p = new(TypeLitExprContext)
+func NewOpenTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OpenTypeExprContext {
+	var p = new(OpenTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_typeLitExpr
+	p.RuleIndex = nevaParserRULE_openTypeExpr
 
 	return p
 }
 
-func (s *TypeLitExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *OpenTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeLitExprContext) EnumTypeExpr() IEnumTypeExprContext {
+func (s *OpenTypeExprContext) EntityRef() IEntityRefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEnumTypeExprContext); ok {
+		if _, ok := ctx.(IEntityRefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5006,15 +5564,40 @@ func (s *TypeLitExprContext) EnumTypeExpr() IEnumTypeExprContext {
 		return nil
 	}
 
-	return t.(IEnumTypeExprContext)
+	return t.(IEntityRefContext)
 }
 
-func (s *TypeLitExprContext) StructTypeExpr() IStructTypeExprContext {
+func (s *OpenTypeExprContext) AllTypeExpr() []ITypeExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeExprContext); ok {
+			tst[i] = t.(ITypeExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OpenTypeExprContext) TypeExpr(i int) ITypeExprContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructTypeExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITypeExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -5022,203 +5605,182 @@ func (s *TypeLitExprContext) StructTypeExpr() IStructTypeExprContext {
 		return nil
 	}
 
-	return t.(IStructTypeExprContext)
-}
-
-func (s *TypeLitExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *TypeLitExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return t.(ITypeExprContext)
 }
 
-func (s *TypeLitExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterTypeLitExpr(s)
+func (s *OpenTypeExprContext) AllNonUnionTypeExpr() []INonUnionTypeExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INonUnionTypeExprContext); ok {
+			len++
+		}
 	}
-}
 
-func (s *TypeLitExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitTypeLitExpr(s)
+	tst := make([]INonUnionTypeExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INonUnionTypeExprContext); ok {
+			tst[i] = t.(INonUnionTypeExprContext)
+			i++
+		}
 	}
+
+	return tst
 }
 
-func (p *nevaParser) TypeLitExpr() (localctx ITypeLitExprContext) {
-	localctx = NewTypeLitExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, nevaParserRULE_typeLitExpr)
-	p.SetState(406)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case nevaParserT__14:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(404)
-			p.EnumTypeExpr()
-		}
-
-	case nevaParserT__15:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(405)
-			p.StructTypeExpr()
+func (s *OpenTypeExprContext) NonUnionTypeExpr(i int) INonUnionTypeExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INonUnionTypeExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IEnumTypeExprContext is an interface to support dynamic dispatch.
-type IEnumTypeExprContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllIDENTIFIER() []antlr.TerminalNode
-	IDENTIFIER(i int) antlr.TerminalNode
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-
-	// IsEnumTypeExprContext differentiates from other interfaces.
-	IsEnumTypeExprContext()
-}
-
-type EnumTypeExprContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyEnumTypeExprContext() *EnumTypeExprContext {
-	var p = new(EnumTypeExprContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_enumTypeExpr
-	return p
-}
-
-func InitEmptyEnumTypeExprContext(p *EnumTypeExprContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_enumTypeExpr
-}
-
-func (*EnumTypeExprContext) IsEnumTypeExprContext() {}
-
-func NewEnumTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumTypeExprContext {
-	var p = new(EnumTypeExprContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_enumTypeExpr
-
-	return p
-}
-
-func (s *EnumTypeExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *EnumTypeExprContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserIDENTIFIER)
-}
 
-func (s *EnumTypeExprContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, i)
+	return t.(INonUnionTypeExprContext)
 }
 
-func (s *EnumTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *OpenTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *EnumTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *OpenTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *EnumTypeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *OpenTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EnumTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OpenTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EnumTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OpenTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterEnumTypeExpr(s)
+		listenerT.EnterOpenTypeExpr(s)
 	}
 }
 
-func (s *EnumTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OpenTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitEnumTypeExpr(s)
+		listenerT.ExitOpenTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
-	localctx = NewEnumTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, nevaParserRULE_enumTypeExpr)
+func (p *nevaParser) OpenTypeExpr() (localctx IOpenTypeExprContext) {
+	localctx = NewOpenTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, nevaParserRULE_openTypeExpr)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(408)
-		p.Match(nevaParserT__14)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(412)
+	p.SetState(503)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(487)
+				p.NonUnionTypeExpr()
+			}
+			p.SetState(491)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(409)
-			p.Match(nevaParserNEWLINE)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(488)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(493)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(494)
+				p.Match(nevaParserT__15)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(498)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(495)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(500)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
 
-		p.SetState(414)
+		}
+		p.SetState(505)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 	{
-		p.SetState(415)
-		p.Match(nevaParserT__5)
+		p.SetState(506)
+		p.EntityRef()
+	}
+	{
+		p.SetState(507)
+		p.Match(nevaParserT__12)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(419)
+	p.SetState(511)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5227,7 +5789,7 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(416)
+			p.SetState(508)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5235,7 +5797,7 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 			}
 		}
 
-		p.SetState(421)
+		p.SetState(513)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5243,14 +5805,10 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(422)
-		p.Match(nevaParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(514)
+		p.TypeExpr()
 	}
-	p.SetState(433)
+	p.SetState(525)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5259,14 +5817,14 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 
 	for _la == nevaParserT__2 {
 		{
-			p.SetState(423)
+			p.SetState(515)
 			p.Match(nevaParserT__2)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(427)
+		p.SetState(519)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5275,7 +5833,7 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 
 		for _la == nevaParserNEWLINE {
 			{
-				p.SetState(424)
+				p.SetState(516)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -5283,7 +5841,7 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 				}
 			}
 
-			p.SetState(429)
+			p.SetState(521)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -5291,53 +5849,17 @@ func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(430)
-			p.Match(nevaParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(435)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(439)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(436)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(522)
+			p.TypeExpr()
 		}
 
-		p.SetState(441)
+		p.SetState(527)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(442)
-		p.Match(nevaParserT__6)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
 
 errorExit:
 	if p.HasError() {
@@ -5352,66 +5874,90 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructTypeExprContext is an interface to support dynamic dispatch.
-type IStructTypeExprContext interface {
+// ITypeLitExprContext is an interface to support dynamic dispatch.
+type ITypeLitExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	StructFields() IStructFieldsContext
+	EnumTypeExpr() IEnumTypeExprContext
+	StructTypeExpr() IStructTypeExprContext
+	TaggedUnionTypeExpr() ITaggedUnionTypeExprContext
 
-	// IsStructTypeExprContext differentiates from other interfaces.
-	IsStructTypeExprContext()
+	// IsTypeLitExprContext differentiates from other interfaces.
+	IsTypeLitExprContext()
 }
 
-type StructTypeExprContext struct {
+type TypeLitExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructTypeExprContext() *StructTypeExprContext {
-	var p = new(StructTypeExprContext)
+func NewEmptyTypeLitExprContext() *TypeLitExprContext {
+	var p = new(TypeLitExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structTypeExpr
+	p.RuleIndex = nevaParserRULE_typeLitExpr
 	return p
 }
 
-func InitEmptyStructTypeExprContext(p *StructTypeExprContext) {
+func InitEmptyTypeLitExprContext(p *TypeLitExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structTypeExpr
+	p.RuleIndex = nevaParserRULE_typeLitExpr
 }
 
-func (*StructTypeExprContext) IsStructTypeExprContext() {}
+func (*TypeLitExprContext) IsTypeLitExprContext() {}
 
-func NewStructTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructTypeExprContext {
-	var p = new(StructTypeExprContext)
+func NewTypeLitExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitExprContext {
+	var p = new(TypeLitExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structTypeExpr
+	p.RuleIndex = nevaParserRULE_typeLitExpr
 
 	return p
 }
 
-func (s *StructTypeExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeLitExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+func (s *TypeLitExprContext) EnumTypeExpr() IEnumTypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumTypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumTypeExprContext)
 }
 
-func (s *StructTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *TypeLitExprContext) StructTypeExpr() IStructTypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructTypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructTypeExprContext)
 }
 
-func (s *StructTypeExprContext) StructFields() IStructFieldsContext {
+func (s *TypeLitExprContext) TaggedUnionTypeExpr() ITaggedUnionTypeExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructFieldsContext); ok {
+		if _, ok := ctx.(ITaggedUnionTypeExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5421,120 +5967,63 @@ func (s *StructTypeExprContext) StructFields() IStructFieldsContext {
 		return nil
 	}
 
-	return t.(IStructFieldsContext)
+	return t.(ITaggedUnionTypeExprContext)
 }
 
-func (s *StructTypeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeLitExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeLitExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeLitExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructTypeExpr(s)
+		listenerT.EnterTypeLitExpr(s)
 	}
 }
 
-func (s *StructTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeLitExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructTypeExpr(s)
+		listenerT.ExitTypeLitExpr(s)
 	}
 }
 
-func (p *nevaParser) StructTypeExpr() (localctx IStructTypeExprContext) {
-	localctx = NewStructTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, nevaParserRULE_structTypeExpr)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(444)
-		p.Match(nevaParserT__15)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(448)
+func (p *nevaParser) TypeLitExpr() (localctx ITypeLitExprContext) {
+	localctx = NewTypeLitExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, nevaParserRULE_typeLitExpr)
+	p.SetState(531)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	switch p.GetTokenStream().LA(1) {
+	case nevaParserT__16:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(445)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(450)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(451)
-		p.Match(nevaParserT__5)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+			p.SetState(528)
+			p.EnumTypeExpr()
 		}
-	}
-	p.SetState(455)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	case nevaParserT__17:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(452)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(457)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+			p.SetState(529)
+			p.StructTypeExpr()
 		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(459)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserIDENTIFIER {
+	case nevaParserT__18:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(458)
-			p.StructFields()
+			p.SetState(530)
+			p.TaggedUnionTypeExpr()
 		}
 
-	}
-	{
-		p.SetState(461)
-		p.Match(nevaParserT__6)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5550,135 +6039,106 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructFieldsContext is an interface to support dynamic dispatch.
-type IStructFieldsContext interface {
+// IEnumTypeExprContext is an interface to support dynamic dispatch.
+type IEnumTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllStructField() []IStructFieldContext
-	StructField(i int) IStructFieldContext
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsStructFieldsContext differentiates from other interfaces.
-	IsStructFieldsContext()
+	// IsEnumTypeExprContext differentiates from other interfaces.
+	IsEnumTypeExprContext()
 }
 
-type StructFieldsContext struct {
+type EnumTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructFieldsContext() *StructFieldsContext {
-	var p = new(StructFieldsContext)
+func NewEmptyEnumTypeExprContext() *EnumTypeExprContext {
+	var p = new(EnumTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structFields
+	p.RuleIndex = nevaParserRULE_enumTypeExpr
 	return p
 }
 
-func InitEmptyStructFieldsContext(p *StructFieldsContext) {
+func InitEmptyEnumTypeExprContext(p *EnumTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structFields
+	p.RuleIndex = nevaParserRULE_enumTypeExpr
 }
 
-func (*StructFieldsContext) IsStructFieldsContext() {}
+func (*EnumTypeExprContext) IsEnumTypeExprContext() {}
 
-func NewStructFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldsContext {
-	var p = new(StructFieldsContext)
+func NewEnumTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumTypeExprContext {
+	var p = new(EnumTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structFields
+	p.RuleIndex = nevaParserRULE_enumTypeExpr
 
 	return p
 }
 
-func (s *StructFieldsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StructFieldsContext) AllStructField() []IStructFieldContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStructFieldContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStructFieldContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStructFieldContext); ok {
-			tst[i] = t.(IStructFieldContext)
-			i++
-		}
-	}
+func (s *EnumTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *EnumTypeExprContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserIDENTIFIER)
 }
 
-func (s *StructFieldsContext) StructField(i int) IStructFieldContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructFieldContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStructFieldContext)
+func (s *EnumTypeExprContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, i)
 }
 
-func (s *StructFieldsContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *EnumTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *StructFieldsContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *EnumTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *StructFieldsContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructFieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructFieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructFields(s)
+		listenerT.EnterEnumTypeExpr(s)
 	}
 }
 
-func (s *StructFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructFields(s)
+		listenerT.ExitEnumTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) StructFields() (localctx IStructFieldsContext) {
-	localctx = NewStructFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, nevaParserRULE_structFields)
+func (p *nevaParser) EnumTypeExpr() (localctx IEnumTypeExprContext) {
+	localctx = NewEnumTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, nevaParserRULE_enumTypeExpr)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(463)
-		p.StructField()
+		p.SetState(533)
+		p.Match(nevaParserT__16)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(472)
+	p.SetState(537)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5686,113 +6146,224 @@ func (p *nevaParser) StructFields() (localctx IStructFieldsContext) {
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == nevaParserNEWLINE {
-		p.SetState(465)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for ok := true; ok; ok = _la == nevaParserNEWLINE {
-			{
-				p.SetState(464)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-			p.SetState(467)
-			p.GetErrorHandler().Sync(p)
+		{
+			p.SetState(534)
+			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(469)
-			p.StructField()
 		}
 
-		p.SetState(474)
+		p.SetState(539)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	{
+		p.SetState(540)
+		p.Match(nevaParserT__5)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(544)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(541)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(546)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(547)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(558)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserT__2 {
+		{
+			p.SetState(548)
+			p.Match(nevaParserT__2)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(552)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(549)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(554)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(555)
+			p.Match(nevaParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(560)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(564)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(561)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(566)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(567)
+		p.Match(nevaParserT__6)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructFieldContext is an interface to support dynamic dispatch.
-type IStructFieldContext interface {
+// IStructTypeExprContext is an interface to support dynamic dispatch.
+type IStructTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	TypeExpr() ITypeExprContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
+	StructFields() IStructFieldsContext
 
-	// IsStructFieldContext differentiates from other interfaces.
-	IsStructFieldContext()
+	// IsStructTypeExprContext differentiates from other interfaces.
+	IsStructTypeExprContext()
 }
 
-type StructFieldContext struct {
+type StructTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructFieldContext() *StructFieldContext {
-	var p = new(StructFieldContext)
+func NewEmptyStructTypeExprContext() *StructTypeExprContext {
+	var p = new(StructTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structField
+	p.RuleIndex = nevaParserRULE_structTypeExpr
 	return p
 }
 
-func InitEmptyStructFieldContext(p *StructFieldContext) {
+func InitEmptyStructTypeExprContext(p *StructTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structField
+	p.RuleIndex = nevaParserRULE_structTypeExpr
 }
 
-func (*StructFieldContext) IsStructFieldContext() {}
+func (*StructTypeExprContext) IsStructTypeExprContext() {}
 
-func NewStructFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldContext {
-	var p = new(StructFieldContext)
+func NewStructTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructTypeExprContext {
+	var p = new(StructTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structField
+	p.RuleIndex = nevaParserRULE_structTypeExpr
 
 	return p
 }
 
-func (s *StructFieldContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructFieldContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *StructTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *StructFieldContext) TypeExpr() ITypeExprContext {
+func (s *StructTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *StructTypeExprContext) StructFields() IStructFieldsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeExprContext); ok {
+		if _, ok := ctx.(IStructFieldsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5802,163 +6373,198 @@ func (s *StructFieldContext) TypeExpr() ITypeExprContext {
 		return nil
 	}
 
-	return t.(ITypeExprContext)
-}
-
-func (s *StructFieldContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *StructFieldContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IStructFieldsContext)
 }
 
-func (s *StructFieldContext) GetRuleContext() antlr.RuleContext {
+func (s *StructTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructFieldContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructField(s)
+		listenerT.EnterStructTypeExpr(s)
 	}
 }
 
-func (s *StructFieldContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructField(s)
+		listenerT.ExitStructTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) StructField() (localctx IStructFieldContext) {
-	localctx = NewStructFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, nevaParserRULE_structField)
-	var _alt int
+func (p *nevaParser) StructTypeExpr() (localctx IStructTypeExprContext) {
+	localctx = NewStructTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, nevaParserRULE_structTypeExpr)
+	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(475)
-		p.Match(nevaParserIDENTIFIER)
+		p.SetState(569)
+		p.Match(nevaParserT__17)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(476)
-		p.TypeExpr()
-	}
-	p.SetState(480)
+	p.SetState(573)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(477)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(570)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(482)
+
+		p.SetState(575)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(576)
+		p.Match(nevaParserT__5)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-
-errorExit:
+	p.SetState(580)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+		goto errorExit
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IUnionTypeExprContext is an interface to support dynamic dispatch.
-type IUnionTypeExprContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	_la = p.GetTokenStream().LA(1)
 
-	// Getter signatures
-	AllNonUnionTypeExpr() []INonUnionTypeExprContext
-	NonUnionTypeExpr(i int) INonUnionTypeExprContext
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(577)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(582)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(584)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserIDENTIFIER {
+		{
+			p.SetState(583)
+			p.StructFields()
+		}
+
+	}
+	{
+		p.SetState(586)
+		p.Match(nevaParserT__6)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStructFieldsContext is an interface to support dynamic dispatch.
+type IStructFieldsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllStructField() []IStructFieldContext
+	StructField(i int) IStructFieldContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsUnionTypeExprContext differentiates from other interfaces.
-	IsUnionTypeExprContext()
+	// IsStructFieldsContext differentiates from other interfaces.
+	IsStructFieldsContext()
 }
 
-type UnionTypeExprContext struct {
+type StructFieldsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnionTypeExprContext() *UnionTypeExprContext {
-	var p = new(UnionTypeExprContext)
+func NewEmptyStructFieldsContext() *StructFieldsContext {
+	var p = new(StructFieldsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unionTypeExpr
+	p.RuleIndex = nevaParserRULE_structFields
 	return p
 }
 
-func InitEmptyUnionTypeExprContext(p *UnionTypeExprContext) {
+func InitEmptyStructFieldsContext(p *StructFieldsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unionTypeExpr
+	p.RuleIndex = nevaParserRULE_structFields
 }
 
-func (*UnionTypeExprContext) IsUnionTypeExprContext() {}
+func (*StructFieldsContext) IsStructFieldsContext() {}
 
-func NewUnionTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionTypeExprContext {
-	var p = new(UnionTypeExprContext)
+func NewStructFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldsContext {
+	var p = new(StructFieldsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_unionTypeExpr
+	p.RuleIndex = nevaParserRULE_structFields
 
 	return p
 }
 
-func (s *UnionTypeExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructFieldsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UnionTypeExprContext) AllNonUnionTypeExpr() []INonUnionTypeExprContext {
+func (s *StructFieldsContext) AllStructField() []IStructFieldContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(INonUnionTypeExprContext); ok {
+		if _, ok := ctx.(IStructFieldContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]INonUnionTypeExprContext, len)
+	tst := make([]IStructFieldContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(INonUnionTypeExprContext); ok {
-			tst[i] = t.(INonUnionTypeExprContext)
+		if t, ok := ctx.(IStructFieldContext); ok {
+			tst[i] = t.(IStructFieldContext)
 			i++
 		}
 	}
@@ -5966,11 +6572,11 @@ func (s *UnionTypeExprContext) AllNonUnionTypeExpr() []INonUnionTypeExprContext
 	return tst
 }
 
-func (s *UnionTypeExprContext) NonUnionTypeExpr(i int) INonUnionTypeExprContext {
+func (s *StructFieldsContext) StructField(i int) IStructFieldContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INonUnionTypeExprContext); ok {
+		if _, ok := ctx.(IStructFieldContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -5983,130 +6589,90 @@ func (s *UnionTypeExprContext) NonUnionTypeExpr(i int) INonUnionTypeExprContext
 		return nil
 	}
 
-	return t.(INonUnionTypeExprContext)
+	return t.(IStructFieldContext)
 }
 
-func (s *UnionTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *StructFieldsContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *UnionTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *StructFieldsContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *UnionTypeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *StructFieldsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnionTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructFieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UnionTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterUnionTypeExpr(s)
+		listenerT.EnterStructFields(s)
 	}
 }
 
-func (s *UnionTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitUnionTypeExpr(s)
+		listenerT.ExitStructFields(s)
 	}
 }
 
-func (p *nevaParser) UnionTypeExpr() (localctx IUnionTypeExprContext) {
-	localctx = NewUnionTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, nevaParserRULE_unionTypeExpr)
+func (p *nevaParser) StructFields() (localctx IStructFieldsContext) {
+	localctx = NewStructFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, nevaParserRULE_structFields)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(483)
-		p.NonUnionTypeExpr()
+		p.SetState(588)
+		p.StructField()
 	}
-	p.SetState(498)
+	p.SetState(597)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-			p.SetState(487)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
+	_la = p.GetTokenStream().LA(1)
 
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(484)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
+	for _la == nevaParserNEWLINE {
+		p.SetState(590)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-				p.SetState(489)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
+		for ok := true; ok; ok = _la == nevaParserNEWLINE {
 			{
-				p.SetState(490)
-				p.Match(nevaParserT__16)
+				p.SetState(589)
+				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(494)
+
+			p.SetState(592)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
-
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(491)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-				p.SetState(496)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
-			{
-				p.SetState(497)
-				p.NonUnionTypeExpr()
-			}
-
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
+		}
+		{
+			p.SetState(594)
+			p.StructField()
 		}
 
-		p.SetState(500)
+		p.SetState(599)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 48, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -6122,57 +6688,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INonUnionTypeExprContext is an interface to support dynamic dispatch.
-type INonUnionTypeExprContext interface {
+// IStructFieldContext is an interface to support dynamic dispatch.
+type IStructFieldContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TypeInstExpr() ITypeInstExprContext
-	TypeLitExpr() ITypeLitExprContext
+	IDENTIFIER() antlr.TerminalNode
+	TypeExpr() ITypeExprContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsNonUnionTypeExprContext differentiates from other interfaces.
-	IsNonUnionTypeExprContext()
+	// IsStructFieldContext differentiates from other interfaces.
+	IsStructFieldContext()
 }
 
-type NonUnionTypeExprContext struct {
+type StructFieldContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNonUnionTypeExprContext() *NonUnionTypeExprContext {
-	var p = new(NonUnionTypeExprContext)
+func NewEmptyStructFieldContext() *StructFieldContext {
+	var p = new(StructFieldContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
+	p.RuleIndex = nevaParserRULE_structField
 	return p
 }
 
-func InitEmptyNonUnionTypeExprContext(p *NonUnionTypeExprContext) {
+func InitEmptyStructFieldContext(p *StructFieldContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
+	p.RuleIndex = nevaParserRULE_structField
 }
 
-func (*NonUnionTypeExprContext) IsNonUnionTypeExprContext() {}
+func (*StructFieldContext) IsStructFieldContext() {}
 
-func NewNonUnionTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NonUnionTypeExprContext {
-	var p = new(NonUnionTypeExprContext)
+func NewStructFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldContext {
+	var p = new(StructFieldContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
+	p.RuleIndex = nevaParserRULE_structField
 
 	return p
 }
 
-func (s *NonUnionTypeExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructFieldContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NonUnionTypeExprContext) TypeInstExpr() ITypeInstExprContext {
+func (s *StructFieldContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *StructFieldContext) TypeExpr() ITypeExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeInstExprContext); ok {
+		if _, ok := ctx.(ITypeExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6182,72 +6754,85 @@ func (s *NonUnionTypeExprContext) TypeInstExpr() ITypeInstExprContext {
 		return nil
 	}
 
-	return t.(ITypeInstExprContext)
+	return t.(ITypeExprContext)
 }
 
-func (s *NonUnionTypeExprContext) TypeLitExpr() ITypeLitExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeLitExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *StructFieldContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
 
-	return t.(ITypeLitExprContext)
+func (s *StructFieldContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *NonUnionTypeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *StructFieldContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NonUnionTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NonUnionTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterNonUnionTypeExpr(s)
+		listenerT.EnterStructField(s)
 	}
 }
 
-func (s *NonUnionTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitNonUnionTypeExpr(s)
+		listenerT.ExitStructField(s)
 	}
 }
 
-func (p *nevaParser) NonUnionTypeExpr() (localctx INonUnionTypeExprContext) {
-	localctx = NewNonUnionTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, nevaParserRULE_nonUnionTypeExpr)
-	p.SetState(504)
+func (p *nevaParser) StructField() (localctx IStructFieldContext) {
+	localctx = NewStructFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, nevaParserRULE_structField)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(600)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(601)
+		p.TypeExpr()
+	}
+	p.SetState(605)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(602)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-	switch p.GetTokenStream().LA(1) {
-	case nevaParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(502)
-			p.TypeInstExpr()
 		}
-
-	case nevaParserT__14, nevaParserT__15:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(503)
-			p.TypeLitExpr()
+		p.SetState(607)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -6263,57 +6848,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IInterfaceStmtContext is an interface to support dynamic dispatch.
-type IInterfaceStmtContext interface {
+// ITaggedUnionTypeExprContext is an interface to support dynamic dispatch.
+type ITaggedUnionTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	InterfaceDef() IInterfaceDefContext
-	PUB_KW() antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	UnionTags() IUnionTagsContext
 
-	// IsInterfaceStmtContext differentiates from other interfaces.
-	IsInterfaceStmtContext()
+	// IsTaggedUnionTypeExprContext differentiates from other interfaces.
+	IsTaggedUnionTypeExprContext()
 }
 
-type InterfaceStmtContext struct {
+type TaggedUnionTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInterfaceStmtContext() *InterfaceStmtContext {
-	var p = new(InterfaceStmtContext)
+func NewEmptyTaggedUnionTypeExprContext() *TaggedUnionTypeExprContext {
+	var p = new(TaggedUnionTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_interfaceStmt
+	p.RuleIndex = nevaParserRULE_taggedUnionTypeExpr
 	return p
 }
 
-func InitEmptyInterfaceStmtContext(p *InterfaceStmtContext) {
+func InitEmptyTaggedUnionTypeExprContext(p *TaggedUnionTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_interfaceStmt
+	p.RuleIndex = nevaParserRULE_taggedUnionTypeExpr
 }
 
-func (*InterfaceStmtContext) IsInterfaceStmtContext() {}
+func (*TaggedUnionTypeExprContext) IsTaggedUnionTypeExprContext() {}
 
-func NewInterfaceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceStmtContext {
-	var p = new(InterfaceStmtContext)
+func NewTaggedUnionTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TaggedUnionTypeExprContext {
+	var p = new(TaggedUnionTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_interfaceStmt
+	p.RuleIndex = nevaParserRULE_taggedUnionTypeExpr
 
 	return p
 }
 
-func (s *InterfaceStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *TaggedUnionTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InterfaceStmtContext) InterfaceDef() IInterfaceDefContext {
+func (s *TaggedUnionTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *TaggedUnionTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *TaggedUnionTypeExprContext) UnionTags() IUnionTagsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IInterfaceDefContext); ok {
+		if _, ok := ctx.(IUnionTagsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6323,68 +6917,120 @@ func (s *InterfaceStmtContext) InterfaceDef() IInterfaceDefContext {
 		return nil
 	}
 
-	return t.(IInterfaceDefContext)
-}
-
-func (s *InterfaceStmtContext) PUB_KW() antlr.TerminalNode {
-	return s.GetToken(nevaParserPUB_KW, 0)
+	return t.(IUnionTagsContext)
 }
 
-func (s *InterfaceStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TaggedUnionTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InterfaceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TaggedUnionTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InterfaceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TaggedUnionTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterInterfaceStmt(s)
+		listenerT.EnterTaggedUnionTypeExpr(s)
 	}
 }
 
-func (s *InterfaceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TaggedUnionTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitInterfaceStmt(s)
+		listenerT.ExitTaggedUnionTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) InterfaceStmt() (localctx IInterfaceStmtContext) {
-	localctx = NewInterfaceStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, nevaParserRULE_interfaceStmt)
+func (p *nevaParser) TaggedUnionTypeExpr() (localctx ITaggedUnionTypeExprContext) {
+	localctx = NewTaggedUnionTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, nevaParserRULE_taggedUnionTypeExpr)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(507)
+	{
+		p.SetState(608)
+		p.Match(nevaParserT__18)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(612)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserPUB_KW {
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(506)
-			p.Match(nevaParserPUB_KW)
+			p.SetState(609)
+			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
+		p.SetState(614)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(509)
-		p.Match(nevaParserT__17)
+		p.SetState(615)
+		p.Match(nevaParserT__5)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(619)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(616)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(621)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(623)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserIDENTIFIER {
+		{
+			p.SetState(622)
+			p.UnionTags()
+		}
+
+	}
 	{
-		p.SetState(510)
-		p.InterfaceDef()
+		p.SetState(625)
+		p.Match(nevaParserT__6)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -6400,99 +7046,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IInterfaceDefContext is an interface to support dynamic dispatch.
-type IInterfaceDefContext interface {
+// IUnionTagsContext is an interface to support dynamic dispatch.
+type IUnionTagsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	InPortsDef() IInPortsDefContext
-	OutPortsDef() IOutPortsDefContext
-	TypeParams() ITypeParamsContext
+	AllUnionTag() []IUnionTagContext
+	UnionTag(i int) IUnionTagContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsInterfaceDefContext differentiates from other interfaces.
-	IsInterfaceDefContext()
+	// IsUnionTagsContext differentiates from other interfaces.
+	IsUnionTagsContext()
 }
 
-type InterfaceDefContext struct {
+type UnionTagsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInterfaceDefContext() *InterfaceDefContext {
-	var p = new(InterfaceDefContext)
+func NewEmptyUnionTagsContext() *UnionTagsContext {
+	var p = new(UnionTagsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_interfaceDef
+	p.RuleIndex = nevaParserRULE_unionTags
 	return p
 }
 
-func InitEmptyInterfaceDefContext(p *InterfaceDefContext) {
+func InitEmptyUnionTagsContext(p *UnionTagsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_interfaceDef
+	p.RuleIndex = nevaParserRULE_unionTags
 }
 
-func (*InterfaceDefContext) IsInterfaceDefContext() {}
+func (*UnionTagsContext) IsUnionTagsContext() {}
 
-func NewInterfaceDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceDefContext {
-	var p = new(InterfaceDefContext)
+func NewUnionTagsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionTagsContext {
+	var p = new(UnionTagsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_interfaceDef
+	p.RuleIndex = nevaParserRULE_unionTags
 
 	return p
 }
 
-func (s *InterfaceDefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *InterfaceDefContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
-}
+func (s *UnionTagsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InterfaceDefContext) InPortsDef() IInPortsDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IInPortsDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *UnionTagsContext) AllUnionTag() []IUnionTagContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUnionTagContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IInPortsDefContext)
-}
-
-func (s *InterfaceDefContext) OutPortsDef() IOutPortsDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOutPortsDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	tst := make([]IUnionTagContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUnionTagContext); ok {
+			tst[i] = t.(IUnionTagContext)
+			i++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IOutPortsDefContext)
+	return tst
 }
 
-func (s *InterfaceDefContext) TypeParams() ITypeParamsContext {
+func (s *UnionTagsContext) UnionTag(i int) IUnionTagContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeParamsContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IUnionTagContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -6500,88 +7133,65 @@ func (s *InterfaceDefContext) TypeParams() ITypeParamsContext {
 		return nil
 	}
 
-	return t.(ITypeParamsContext)
+	return t.(IUnionTagContext)
 }
 
-func (s *InterfaceDefContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *UnionTagsContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *InterfaceDefContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *UnionTagsContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *InterfaceDefContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionTagsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InterfaceDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnionTagsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InterfaceDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionTagsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterInterfaceDef(s)
+		listenerT.EnterUnionTags(s)
 	}
 }
 
-func (s *InterfaceDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionTagsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitInterfaceDef(s)
+		listenerT.ExitUnionTags(s)
 	}
 }
 
-func (p *nevaParser) InterfaceDef() (localctx IInterfaceDefContext) {
-	localctx = NewInterfaceDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, nevaParserRULE_interfaceDef)
+func (p *nevaParser) UnionTags() (localctx IUnionTagsContext) {
+	localctx = NewUnionTagsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, nevaParserRULE_unionTags)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(512)
-		p.Match(nevaParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(627)
+		p.UnionTag()
 	}
-	p.SetState(514)
+	p.SetState(636)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserT__12 {
-		{
-			p.SetState(513)
-			p.TypeParams()
+	for _la == nevaParserNEWLINE {
+		p.SetState(629)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	{
-		p.SetState(516)
-		p.InPortsDef()
-	}
-	{
-		p.SetState(517)
-		p.OutPortsDef()
-	}
-	p.SetState(521)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
+		for ok := true; ok; ok = _la == nevaParserNEWLINE {
 			{
-				p.SetState(518)
+				p.SetState(628)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -6589,16 +7199,24 @@ func (p *nevaParser) InterfaceDef() (localctx IInterfaceDefContext) {
 				}
 			}
 
+			p.SetState(631)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(523)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(633)
+			p.UnionTag()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
+
+		p.SetState(638)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -6614,56 +7232,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IInPortsDefContext is an interface to support dynamic dispatch.
-type IInPortsDefContext interface {
+// IUnionTagContext is an interface to support dynamic dispatch.
+type IUnionTagContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PortsDef() IPortsDefContext
+	IDENTIFIER() antlr.TerminalNode
+	TypeExpr() ITypeExprContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsInPortsDefContext differentiates from other interfaces.
-	IsInPortsDefContext()
+	// IsUnionTagContext differentiates from other interfaces.
+	IsUnionTagContext()
 }
 
-type InPortsDefContext struct {
+type UnionTagContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInPortsDefContext() *InPortsDefContext {
-	var p = new(InPortsDefContext)
+func NewEmptyUnionTagContext() *UnionTagContext {
+	var p = new(UnionTagContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_inPortsDef
+	p.RuleIndex = nevaParserRULE_unionTag
 	return p
 }
 
-func InitEmptyInPortsDefContext(p *InPortsDefContext) {
+func InitEmptyUnionTagContext(p *UnionTagContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_inPortsDef
+	p.RuleIndex = nevaParserRULE_unionTag
 }
 
-func (*InPortsDefContext) IsInPortsDefContext() {}
+func (*UnionTagContext) IsUnionTagContext() {}
 
-func NewInPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InPortsDefContext {
-	var p = new(InPortsDefContext)
+func NewUnionTagContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionTagContext {
+	var p = new(UnionTagContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_inPortsDef
+	p.RuleIndex = nevaParserRULE_unionTag
 
 	return p
 }
 
-func (s *InPortsDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *UnionTagContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InPortsDefContext) PortsDef() IPortsDefContext {
+func (s *UnionTagContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *UnionTagContext) TypeExpr() ITypeExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPortsDefContext); ok {
+		if _, ok := ctx.(ITypeExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6673,140 +7298,97 @@ func (s *InPortsDefContext) PortsDef() IPortsDefContext {
 		return nil
 	}
 
-	return t.(IPortsDefContext)
+	return t.(ITypeExprContext)
 }
 
-func (s *InPortsDefContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionTagContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *UnionTagContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *UnionTagContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InPortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnionTagContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InPortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionTagContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterInPortsDef(s)
+		listenerT.EnterUnionTag(s)
 	}
 }
 
-func (s *InPortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionTagContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitInPortsDef(s)
+		listenerT.ExitUnionTag(s)
 	}
 }
 
-func (p *nevaParser) InPortsDef() (localctx IInPortsDefContext) {
-	localctx = NewInPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, nevaParserRULE_inPortsDef)
+func (p *nevaParser) UnionTag() (localctx IUnionTagContext) {
+	localctx = NewUnionTagContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, nevaParserRULE_unionTag)
+	var _la int
+
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(524)
-		p.PortsDef()
+		p.SetState(639)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-
-errorExit:
+	p.SetState(641)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+		goto errorExit
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IOutPortsDefContext is an interface to support dynamic dispatch.
-type IOutPortsDefContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	PortsDef() IPortsDefContext
-
-	// IsOutPortsDefContext differentiates from other interfaces.
-	IsOutPortsDefContext()
-}
-
-type OutPortsDefContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyOutPortsDefContext() *OutPortsDefContext {
-	var p = new(OutPortsDefContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_outPortsDef
-	return p
-}
-
-func InitEmptyOutPortsDefContext(p *OutPortsDefContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_outPortsDef
-}
-
-func (*OutPortsDefContext) IsOutPortsDefContext() {}
-
-func NewOutPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OutPortsDefContext {
-	var p = new(OutPortsDefContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_outPortsDef
-
-	return p
-}
-
-func (s *OutPortsDefContext) GetParser() antlr.Parser { return s.parser }
+	_la = p.GetTokenStream().LA(1)
 
-func (s *OutPortsDefContext) PortsDef() IPortsDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPortsDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&36028797019881472) != 0 {
+		{
+			p.SetState(640)
+			p.TypeExpr()
 		}
-	}
 
-	if t == nil {
-		return nil
 	}
-
-	return t.(IPortsDefContext)
-}
-
-func (s *OutPortsDefContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *OutPortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *OutPortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterOutPortsDef(s)
+	p.SetState(646)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-}
-
-func (s *OutPortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitOutPortsDef(s)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
 	}
-}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(643)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func (p *nevaParser) OutPortsDef() (localctx IOutPortsDefContext) {
-	localctx = NewOutPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, nevaParserRULE_outPortsDef)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(526)
-		p.PortsDef()
+		}
+		p.SetState(648)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -6822,69 +7404,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPortsDefContext is an interface to support dynamic dispatch.
-type IPortsDefContext interface {
+// IUnionTypeExprContext is an interface to support dynamic dispatch.
+type IUnionTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllPortDef() []IPortDefContext
-	PortDef(i int) IPortDefContext
+	AllNonUnionTypeExpr() []INonUnionTypeExprContext
+	NonUnionTypeExpr(i int) INonUnionTypeExprContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsPortsDefContext differentiates from other interfaces.
-	IsPortsDefContext()
+	// IsUnionTypeExprContext differentiates from other interfaces.
+	IsUnionTypeExprContext()
 }
 
-type PortsDefContext struct {
+type UnionTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPortsDefContext() *PortsDefContext {
-	var p = new(PortsDefContext)
+func NewEmptyUnionTypeExprContext() *UnionTypeExprContext {
+	var p = new(UnionTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portsDef
+	p.RuleIndex = nevaParserRULE_unionTypeExpr
 	return p
 }
 
-func InitEmptyPortsDefContext(p *PortsDefContext) {
+func InitEmptyUnionTypeExprContext(p *UnionTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portsDef
+	p.RuleIndex = nevaParserRULE_unionTypeExpr
 }
 
-func (*PortsDefContext) IsPortsDefContext() {}
+func (*UnionTypeExprContext) IsUnionTypeExprContext() {}
 
-func NewPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortsDefContext {
-	var p = new(PortsDefContext)
+func NewUnionTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionTypeExprContext {
+	var p = new(UnionTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_portsDef
+	p.RuleIndex = nevaParserRULE_unionTypeExpr
 
 	return p
 }
 
-func (s *PortsDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *UnionTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PortsDefContext) AllPortDef() []IPortDefContext {
+func (s *UnionTypeExprContext) AllNonUnionTypeExpr() []INonUnionTypeExprContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IPortDefContext); ok {
+		if _, ok := ctx.(INonUnionTypeExprContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IPortDefContext, len)
+	tst := make([]INonUnionTypeExprContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IPortDefContext); ok {
-			tst[i] = t.(IPortDefContext)
+		if t, ok := ctx.(INonUnionTypeExprContext); ok {
+			tst[i] = t.(INonUnionTypeExprContext)
 			i++
 		}
 	}
@@ -6892,11 +7474,11 @@ func (s *PortsDefContext) AllPortDef() []IPortDefContext {
 	return tst
 }
 
-func (s *PortsDefContext) PortDef(i int) IPortDefContext {
+func (s *UnionTypeExprContext) NonUnionTypeExpr(i int) INonUnionTypeExprContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPortDefContext); ok {
+		if _, ok := ctx.(INonUnionTypeExprContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -6909,142 +7491,128 @@ func (s *PortsDefContext) PortDef(i int) IPortDefContext {
 		return nil
 	}
 
-	return t.(IPortDefContext)
+	return t.(INonUnionTypeExprContext)
 }
 
-func (s *PortsDefContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *UnionTypeExprContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *PortsDefContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *UnionTypeExprContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *PortsDefContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnionTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPortsDef(s)
+		listenerT.EnterUnionTypeExpr(s)
 	}
 }
 
-func (s *PortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPortsDef(s)
+		listenerT.ExitUnionTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) PortsDef() (localctx IPortsDefContext) {
-	localctx = NewPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, nevaParserRULE_portsDef)
+func (p *nevaParser) UnionTypeExpr() (localctx IUnionTypeExprContext) {
+	localctx = NewUnionTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, nevaParserRULE_unionTypeExpr)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
-		p.Match(nevaParserT__1)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(649)
+		p.NonUnionTypeExpr()
 	}
-	p.SetState(546)
+	p.SetState(664)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+			p.SetState(653)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext()) {
-	case 1:
-		p.SetState(532)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(650)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-		for _la == nevaParserNEWLINE {
+				p.SetState(655)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
 			{
-				p.SetState(529)
-				p.Match(nevaParserNEWLINE)
+				p.SetState(656)
+				p.Match(nevaParserT__15)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-
-			p.SetState(534)
+			p.SetState(660)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
-		}
-
-	case 2:
-		p.SetState(536)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&148618787703848960) != 0 {
-			{
-				p.SetState(535)
-				p.PortDef()
-			}
-
-		}
 
-	case 3:
-		{
-			p.SetState(538)
-			p.PortDef()
-		}
-		p.SetState(543)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(657)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-		for _la == nevaParserT__2 {
-			{
-				p.SetState(539)
-				p.Match(nevaParserT__2)
+				p.SetState(662)
+				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
-					// Recognition error - abort rule
 					goto errorExit
 				}
+				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(540)
-				p.PortDef()
+				p.SetState(663)
+				p.NonUnionTypeExpr()
 			}
 
-			p.SetState(545)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
-	}
-	{
-		p.SetState(548)
-		p.Match(nevaParserT__3)
+		p.SetState(666)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 72, p.GetParserRuleContext())
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -7062,57 +7630,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPortDefContext is an interface to support dynamic dispatch.
-type IPortDefContext interface {
+// INonUnionTypeExprContext is an interface to support dynamic dispatch.
+type INonUnionTypeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SinglePortDef() ISinglePortDefContext
-	ArrayPortDef() IArrayPortDefContext
+	TypeInstExpr() ITypeInstExprContext
+	TypeLitExpr() ITypeLitExprContext
 
-	// IsPortDefContext differentiates from other interfaces.
-	IsPortDefContext()
+	// IsNonUnionTypeExprContext differentiates from other interfaces.
+	IsNonUnionTypeExprContext()
 }
 
-type PortDefContext struct {
+type NonUnionTypeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPortDefContext() *PortDefContext {
-	var p = new(PortDefContext)
+func NewEmptyNonUnionTypeExprContext() *NonUnionTypeExprContext {
+	var p = new(NonUnionTypeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portDef
+	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
 	return p
 }
 
-func InitEmptyPortDefContext(p *PortDefContext) {
+func InitEmptyNonUnionTypeExprContext(p *NonUnionTypeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portDef
+	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
 }
 
-func (*PortDefContext) IsPortDefContext() {}
+func (*NonUnionTypeExprContext) IsNonUnionTypeExprContext() {}
 
-func NewPortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortDefContext {
-	var p = new(PortDefContext)
+func NewNonUnionTypeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NonUnionTypeExprContext {
+	var p = new(NonUnionTypeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_portDef
+	p.RuleIndex = nevaParserRULE_nonUnionTypeExpr
 
 	return p
 }
 
-func (s *PortDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *NonUnionTypeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PortDefContext) SinglePortDef() ISinglePortDefContext {
+func (s *NonUnionTypeExprContext) TypeInstExpr() ITypeInstExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISinglePortDefContext); ok {
+		if _, ok := ctx.(ITypeInstExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7122,13 +7690,13 @@ func (s *PortDefContext) SinglePortDef() ISinglePortDefContext {
 		return nil
 	}
 
-	return t.(ISinglePortDefContext)
+	return t.(ITypeInstExprContext)
 }
 
-func (s *PortDefContext) ArrayPortDef() IArrayPortDefContext {
+func (s *NonUnionTypeExprContext) TypeLitExpr() ITypeLitExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArrayPortDefContext); ok {
+		if _, ok := ctx.(ITypeLitExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7138,54 +7706,55 @@ func (s *PortDefContext) ArrayPortDef() IArrayPortDefContext {
 		return nil
 	}
 
-	return t.(IArrayPortDefContext)
+	return t.(ITypeLitExprContext)
 }
 
-func (s *PortDefContext) GetRuleContext() antlr.RuleContext {
+func (s *NonUnionTypeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NonUnionTypeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PortDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NonUnionTypeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPortDef(s)
+		listenerT.EnterNonUnionTypeExpr(s)
 	}
 }
 
-func (s *PortDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NonUnionTypeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPortDef(s)
+		listenerT.ExitNonUnionTypeExpr(s)
 	}
 }
 
-func (p *nevaParser) PortDef() (localctx IPortDefContext) {
-	localctx = NewPortDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, nevaParserRULE_portDef)
-	p.SetState(552)
+func (p *nevaParser) NonUnionTypeExpr() (localctx INonUnionTypeExprContext) {
+	localctx = NewNonUnionTypeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, nevaParserRULE_nonUnionTypeExpr)
+	p.SetState(670)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
-	case 1:
+	switch p.GetTokenStream().LA(1) {
+	case nevaParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(550)
-			p.SinglePortDef()
+			p.SetState(668)
+			p.TypeInstExpr()
 		}
 
-	case 2:
+	case nevaParserT__16, nevaParserT__17, nevaParserT__18:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(551)
-			p.ArrayPortDef()
+			p.SetState(669)
+			p.TypeLitExpr()
 		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -7202,59 +7771,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISinglePortDefContext is an interface to support dynamic dispatch.
-type ISinglePortDefContext interface {
+// IInterfaceStmtContext is an interface to support dynamic dispatch.
+type IInterfaceStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TypeExpr() ITypeExprContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	IDENTIFIER() antlr.TerminalNode
+	InterfaceDef() IInterfaceDefContext
+	PUB_KW() antlr.TerminalNode
 
-	// IsSinglePortDefContext differentiates from other interfaces.
-	IsSinglePortDefContext()
+	// IsInterfaceStmtContext differentiates from other interfaces.
+	IsInterfaceStmtContext()
 }
 
-type SinglePortDefContext struct {
+type InterfaceStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySinglePortDefContext() *SinglePortDefContext {
-	var p = new(SinglePortDefContext)
+func NewEmptyInterfaceStmtContext() *InterfaceStmtContext {
+	var p = new(InterfaceStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singlePortDef
+	p.RuleIndex = nevaParserRULE_interfaceStmt
 	return p
 }
 
-func InitEmptySinglePortDefContext(p *SinglePortDefContext) {
+func InitEmptyInterfaceStmtContext(p *InterfaceStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singlePortDef
+	p.RuleIndex = nevaParserRULE_interfaceStmt
 }
 
-func (*SinglePortDefContext) IsSinglePortDefContext() {}
+func (*InterfaceStmtContext) IsInterfaceStmtContext() {}
 
-func NewSinglePortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SinglePortDefContext {
-	var p = new(SinglePortDefContext)
+func NewInterfaceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceStmtContext {
+	var p = new(InterfaceStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_singlePortDef
+	p.RuleIndex = nevaParserRULE_interfaceStmt
 
 	return p
 }
 
-func (s *SinglePortDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *InterfaceStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SinglePortDefContext) TypeExpr() ITypeExprContext {
+func (s *InterfaceStmtContext) InterfaceDef() IInterfaceDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeExprContext); ok {
+		if _, ok := ctx.(IInterfaceDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7264,114 +7831,68 @@ func (s *SinglePortDefContext) TypeExpr() ITypeExprContext {
 		return nil
 	}
 
-	return t.(ITypeExprContext)
-}
-
-func (s *SinglePortDefContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *SinglePortDefContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IInterfaceDefContext)
 }
 
-func (s *SinglePortDefContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *InterfaceStmtContext) PUB_KW() antlr.TerminalNode {
+	return s.GetToken(nevaParserPUB_KW, 0)
 }
 
-func (s *SinglePortDefContext) GetRuleContext() antlr.RuleContext {
+func (s *InterfaceStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SinglePortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InterfaceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SinglePortDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterSinglePortDef(s)
+		listenerT.EnterInterfaceStmt(s)
 	}
 }
 
-func (s *SinglePortDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitSinglePortDef(s)
+		listenerT.ExitInterfaceStmt(s)
 	}
 }
 
-func (p *nevaParser) SinglePortDef() (localctx ISinglePortDefContext) {
-	localctx = NewSinglePortDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, nevaParserRULE_singlePortDef)
+func (p *nevaParser) InterfaceStmt() (localctx IInterfaceStmtContext) {
+	localctx = NewInterfaceStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, nevaParserRULE_interfaceStmt)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(557)
+	p.SetState(673)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	if _la == nevaParserPUB_KW {
 		{
-			p.SetState(554)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(672)
+			p.Match(nevaParserPUB_KW)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-		p.SetState(559)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(675)
+		p.Match(nevaParserT__19)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(561)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(560)
-			p.Match(nevaParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	} else if p.HasError() { // JIM
-		goto errorExit
 	}
 	{
-		p.SetState(563)
-		p.TypeExpr()
-	}
-	p.SetState(567)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(564)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(569)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(676)
+		p.InterfaceDef()
 	}
 
 errorExit:
@@ -7387,8 +7908,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArrayPortDefContext is an interface to support dynamic dispatch.
-type IArrayPortDefContext interface {
+// IInterfaceDefContext is an interface to support dynamic dispatch.
+type IInterfaceDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -7396,62 +7917,88 @@ type IArrayPortDefContext interface {
 
 	// Getter signatures
 	IDENTIFIER() antlr.TerminalNode
+	InPortsDef() IInPortsDefContext
+	OutPortsDef() IOutPortsDefContext
+	TypeParams() ITypeParamsContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
-	TypeExpr() ITypeExprContext
 
-	// IsArrayPortDefContext differentiates from other interfaces.
-	IsArrayPortDefContext()
+	// IsInterfaceDefContext differentiates from other interfaces.
+	IsInterfaceDefContext()
 }
 
-type ArrayPortDefContext struct {
+type InterfaceDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayPortDefContext() *ArrayPortDefContext {
-	var p = new(ArrayPortDefContext)
+func NewEmptyInterfaceDefContext() *InterfaceDefContext {
+	var p = new(InterfaceDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_arrayPortDef
+	p.RuleIndex = nevaParserRULE_interfaceDef
 	return p
 }
 
-func InitEmptyArrayPortDefContext(p *ArrayPortDefContext) {
+func InitEmptyInterfaceDefContext(p *InterfaceDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_arrayPortDef
+	p.RuleIndex = nevaParserRULE_interfaceDef
 }
 
-func (*ArrayPortDefContext) IsArrayPortDefContext() {}
+func (*InterfaceDefContext) IsInterfaceDefContext() {}
 
-func NewArrayPortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayPortDefContext {
-	var p = new(ArrayPortDefContext)
+func NewInterfaceDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceDefContext {
+	var p = new(InterfaceDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_arrayPortDef
+	p.RuleIndex = nevaParserRULE_interfaceDef
 
 	return p
 }
 
-func (s *ArrayPortDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *InterfaceDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayPortDefContext) IDENTIFIER() antlr.TerminalNode {
+func (s *InterfaceDefContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(nevaParserIDENTIFIER, 0)
 }
 
-func (s *ArrayPortDefContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+func (s *InterfaceDefContext) InPortsDef() IInPortsDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IInPortsDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInPortsDefContext)
 }
 
-func (s *ArrayPortDefContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *InterfaceDefContext) OutPortsDef() IOutPortsDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOutPortsDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOutPortsDefContext)
 }
 
-func (s *ArrayPortDefContext) TypeExpr() ITypeExprContext {
+func (s *InterfaceDefContext) TypeParams() ITypeParamsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeExprContext); ok {
+		if _, ok := ctx.(ITypeParamsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7461,120 +8008,105 @@ func (s *ArrayPortDefContext) TypeExpr() ITypeExprContext {
 		return nil
 	}
 
-	return t.(ITypeExprContext)
+	return t.(ITypeParamsContext)
 }
 
-func (s *ArrayPortDefContext) GetRuleContext() antlr.RuleContext {
+func (s *InterfaceDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *InterfaceDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *InterfaceDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayPortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InterfaceDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayPortDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterArrayPortDef(s)
+		listenerT.EnterInterfaceDef(s)
 	}
 }
 
-func (s *ArrayPortDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitArrayPortDef(s)
+		listenerT.ExitInterfaceDef(s)
 	}
 }
 
-func (p *nevaParser) ArrayPortDef() (localctx IArrayPortDefContext) {
-	localctx = NewArrayPortDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, nevaParserRULE_arrayPortDef)
+func (p *nevaParser) InterfaceDef() (localctx IInterfaceDefContext) {
+	localctx = NewInterfaceDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, nevaParserRULE_interfaceDef)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(573)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(570)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	var _alt int
 
-		p.SetState(575)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(576)
-		p.Match(nevaParserT__18)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
+	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(577)
+		p.SetState(678)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(578)
-		p.Match(nevaParserT__19)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(580)
+	p.SetState(680)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4503599627468800) != 0 {
+	if _la == nevaParserT__12 {
 		{
-			p.SetState(579)
-			p.TypeExpr()
+			p.SetState(679)
+			p.TypeParams()
 		}
 
 	}
-	p.SetState(585)
+	{
+		p.SetState(682)
+		p.InPortsDef()
+	}
+	{
+		p.SetState(683)
+		p.OutPortsDef()
+	}
+	p.SetState(687)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(582)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 76, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(684)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
-		}
 
-		p.SetState(587)
+		}
+		p.SetState(689)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 76, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -7590,57 +8122,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConstStmtContext is an interface to support dynamic dispatch.
-type IConstStmtContext interface {
+// IInPortsDefContext is an interface to support dynamic dispatch.
+type IInPortsDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ConstDef() IConstDefContext
-	PUB_KW() antlr.TerminalNode
+	PortsDef() IPortsDefContext
 
-	// IsConstStmtContext differentiates from other interfaces.
-	IsConstStmtContext()
+	// IsInPortsDefContext differentiates from other interfaces.
+	IsInPortsDefContext()
 }
 
-type ConstStmtContext struct {
+type InPortsDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConstStmtContext() *ConstStmtContext {
-	var p = new(ConstStmtContext)
+func NewEmptyInPortsDefContext() *InPortsDefContext {
+	var p = new(InPortsDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constStmt
+	p.RuleIndex = nevaParserRULE_inPortsDef
 	return p
 }
 
-func InitEmptyConstStmtContext(p *ConstStmtContext) {
+func InitEmptyInPortsDefContext(p *InPortsDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constStmt
+	p.RuleIndex = nevaParserRULE_inPortsDef
 }
 
-func (*ConstStmtContext) IsConstStmtContext() {}
+func (*InPortsDefContext) IsInPortsDefContext() {}
 
-func NewConstStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstStmtContext {
-	var p = new(ConstStmtContext)
+func NewInPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InPortsDefContext {
+	var p = new(InPortsDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_constStmt
+	p.RuleIndex = nevaParserRULE_inPortsDef
 
 	return p
 }
 
-func (s *ConstStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *InPortsDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConstStmtContext) ConstDef() IConstDefContext {
+func (s *InPortsDefContext) PortsDef() IPortsDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConstDefContext); ok {
+		if _, ok := ctx.(IPortsDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7650,68 +8181,36 @@ func (s *ConstStmtContext) ConstDef() IConstDefContext {
 		return nil
 	}
 
-	return t.(IConstDefContext)
-}
-
-func (s *ConstStmtContext) PUB_KW() antlr.TerminalNode {
-	return s.GetToken(nevaParserPUB_KW, 0)
+	return t.(IPortsDefContext)
 }
 
-func (s *ConstStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *InPortsDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConstStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InPortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConstStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InPortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterConstStmt(s)
+		listenerT.EnterInPortsDef(s)
 	}
 }
 
-func (s *ConstStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InPortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitConstStmt(s)
+		listenerT.ExitInPortsDef(s)
 	}
 }
 
-func (p *nevaParser) ConstStmt() (localctx IConstStmtContext) {
-	localctx = NewConstStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, nevaParserRULE_constStmt)
-	var _la int
-
+func (p *nevaParser) InPortsDef() (localctx IInPortsDefContext) {
+	localctx = NewInPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, nevaParserRULE_inPortsDef)
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(589)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserPUB_KW {
-		{
-			p.SetState(588)
-			p.Match(nevaParserPUB_KW)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
-	{
-		p.SetState(591)
-		p.Match(nevaParserT__20)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
 	{
-		p.SetState(592)
-		p.ConstDef()
+		p.SetState(690)
+		p.PortsDef()
 	}
 
 errorExit:
@@ -7727,97 +8226,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConstDefContext is an interface to support dynamic dispatch.
-type IConstDefContext interface {
+// IOutPortsDefContext is an interface to support dynamic dispatch.
+type IOutPortsDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	TypeExpr() ITypeExprContext
-	EntityRef() IEntityRefContext
-	ConstLit() IConstLitContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	PortsDef() IPortsDefContext
 
-	// IsConstDefContext differentiates from other interfaces.
-	IsConstDefContext()
+	// IsOutPortsDefContext differentiates from other interfaces.
+	IsOutPortsDefContext()
 }
 
-type ConstDefContext struct {
+type OutPortsDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConstDefContext() *ConstDefContext {
-	var p = new(ConstDefContext)
+func NewEmptyOutPortsDefContext() *OutPortsDefContext {
+	var p = new(OutPortsDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constDef
+	p.RuleIndex = nevaParserRULE_outPortsDef
 	return p
 }
 
-func InitEmptyConstDefContext(p *ConstDefContext) {
+func InitEmptyOutPortsDefContext(p *OutPortsDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constDef
+	p.RuleIndex = nevaParserRULE_outPortsDef
 }
 
-func (*ConstDefContext) IsConstDefContext() {}
+func (*OutPortsDefContext) IsOutPortsDefContext() {}
 
-func NewConstDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstDefContext {
-	var p = new(ConstDefContext)
+func NewOutPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OutPortsDefContext {
+	var p = new(OutPortsDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_constDef
+	p.RuleIndex = nevaParserRULE_outPortsDef
 
 	return p
 }
 
-func (s *ConstDefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConstDefContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
-}
-
-func (s *ConstDefContext) TypeExpr() ITypeExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeExprContext)
-}
-
-func (s *ConstDefContext) EntityRef() IEntityRefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEntityRefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IEntityRefContext)
-}
+func (s *OutPortsDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConstDefContext) ConstLit() IConstLitContext {
+func (s *OutPortsDefContext) PortsDef() IPortsDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConstLitContext); ok {
+		if _, ok := ctx.(IPortsDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7827,115 +8285,36 @@ func (s *ConstDefContext) ConstLit() IConstLitContext {
 		return nil
 	}
 
-	return t.(IConstLitContext)
-}
-
-func (s *ConstDefContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *ConstDefContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IPortsDefContext)
 }
 
-func (s *ConstDefContext) GetRuleContext() antlr.RuleContext {
+func (s *OutPortsDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConstDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OutPortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConstDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OutPortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterConstDef(s)
+		listenerT.EnterOutPortsDef(s)
 	}
 }
 
-func (s *ConstDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OutPortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitConstDef(s)
+		listenerT.ExitOutPortsDef(s)
 	}
 }
 
-func (p *nevaParser) ConstDef() (localctx IConstDefContext) {
-	localctx = NewConstDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, nevaParserRULE_constDef)
-	var _alt int
-
+func (p *nevaParser) OutPortsDef() (localctx IOutPortsDefContext) {
+	localctx = NewOutPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, nevaParserRULE_outPortsDef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(594)
-		p.Match(nevaParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(595)
-		p.TypeExpr()
-	}
-	{
-		p.SetState(596)
-		p.Match(nevaParserT__21)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(599)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(597)
-			p.EntityRef()
-		}
-
-	case 2:
-		{
-			p.SetState(598)
-			p.ConstLit()
-		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
-	}
-	p.SetState(604)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 66, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(601)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		p.SetState(606)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 66, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
+		p.SetState(692)
+		p.PortsDef()
 	}
 
 errorExit:
@@ -7951,113 +8330,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConstLitContext is an interface to support dynamic dispatch.
-type IConstLitContext interface {
+// IPortsDefContext is an interface to support dynamic dispatch.
+type IPortsDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Bool_() IBoolContext
-	INT() antlr.TerminalNode
-	MINUS() antlr.TerminalNode
-	FLOAT() antlr.TerminalNode
-	STRING() antlr.TerminalNode
-	EnumLit() IEnumLitContext
-	ListLit() IListLitContext
-	StructLit() IStructLitContext
+	AllPortDef() []IPortDefContext
+	PortDef(i int) IPortDefContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsConstLitContext differentiates from other interfaces.
-	IsConstLitContext()
+	// IsPortsDefContext differentiates from other interfaces.
+	IsPortsDefContext()
 }
 
-type ConstLitContext struct {
+type PortsDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConstLitContext() *ConstLitContext {
-	var p = new(ConstLitContext)
+func NewEmptyPortsDefContext() *PortsDefContext {
+	var p = new(PortsDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constLit
+	p.RuleIndex = nevaParserRULE_portsDef
 	return p
 }
 
-func InitEmptyConstLitContext(p *ConstLitContext) {
+func InitEmptyPortsDefContext(p *PortsDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_constLit
+	p.RuleIndex = nevaParserRULE_portsDef
 }
 
-func (*ConstLitContext) IsConstLitContext() {}
+func (*PortsDefContext) IsPortsDefContext() {}
 
-func NewConstLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstLitContext {
-	var p = new(ConstLitContext)
+func NewPortsDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortsDefContext {
+	var p = new(PortsDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_constLit
+	p.RuleIndex = nevaParserRULE_portsDef
 
 	return p
 }
 
-func (s *ConstLitContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConstLitContext) Bool_() IBoolContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBoolContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *PortsDefContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBoolContext)
-}
-
-func (s *ConstLitContext) INT() antlr.TerminalNode {
-	return s.GetToken(nevaParserINT, 0)
-}
-
-func (s *ConstLitContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(nevaParserMINUS, 0)
-}
-
-func (s *ConstLitContext) FLOAT() antlr.TerminalNode {
-	return s.GetToken(nevaParserFLOAT, 0)
-}
-
-func (s *ConstLitContext) STRING() antlr.TerminalNode {
-	return s.GetToken(nevaParserSTRING, 0)
-}
-
-func (s *ConstLitContext) EnumLit() IEnumLitContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEnumLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *PortsDefContext) AllPortDef() []IPortDefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IPortDefContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IPortDefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IPortDefContext); ok {
+			tst[i] = t.(IPortDefContext)
+			i++
+		}
 	}
 
-	return t.(IEnumLitContext)
+	return tst
 }
 
-func (s *ConstLitContext) ListLit() IListLitContext {
+func (s *PortsDefContext) PortDef(i int) IPortDefContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IListLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IPortDefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8065,157 +8417,145 @@ func (s *ConstLitContext) ListLit() IListLitContext {
 		return nil
 	}
 
-	return t.(IListLitContext)
+	return t.(IPortDefContext)
 }
 
-func (s *ConstLitContext) StructLit() IStructLitContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *PortsDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
 
-	return t.(IStructLitContext)
+func (s *PortsDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *ConstLitContext) GetRuleContext() antlr.RuleContext {
+func (s *PortsDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConstLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PortsDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConstLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PortsDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterConstLit(s)
+		listenerT.EnterPortsDef(s)
 	}
 }
 
-func (s *ConstLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PortsDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitConstLit(s)
+		listenerT.ExitPortsDef(s)
 	}
 }
 
-func (p *nevaParser) ConstLit() (localctx IConstLitContext) {
-	localctx = NewConstLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, nevaParserRULE_constLit)
+func (p *nevaParser) PortsDef() (localctx IPortsDefContext) {
+	localctx = NewPortsDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, nevaParserRULE_portsDef)
 	var _la int
 
-	p.SetState(620)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(694)
+		p.Match(nevaParserT__1)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(712)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext()) {
 	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(607)
-			p.Bool_()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		p.SetState(609)
+		p.SetState(698)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == nevaParserMINUS {
+		for _la == nevaParserNEWLINE {
 			{
-				p.SetState(608)
-				p.Match(nevaParserMINUS)
+				p.SetState(695)
+				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
 
-		}
-		{
-			p.SetState(611)
-			p.Match(nevaParserINT)
+			p.SetState(700)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case 2:
+		p.SetState(702)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1188950301628825600) != 0 {
+			{
+				p.SetState(701)
+				p.PortDef()
+			}
+
 		}
 
 	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		p.SetState(613)
+		{
+			p.SetState(704)
+			p.PortDef()
+		}
+		p.SetState(709)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == nevaParserMINUS {
+		for _la == nevaParserT__2 {
 			{
-				p.SetState(612)
-				p.Match(nevaParserMINUS)
+				p.SetState(705)
+				p.Match(nevaParserT__2)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-
-		}
-		{
-			p.SetState(615)
-			p.Match(nevaParserFLOAT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			{
+				p.SetState(706)
+				p.PortDef()
 			}
-		}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(616)
-			p.Match(nevaParserSTRING)
+			p.SetState(711)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(617)
-			p.EnumLit()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(618)
-			p.ListLit()
-		}
-
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(619)
-			p.StructLit()
+			_la = p.GetTokenStream().LA(1)
 		}
 
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
+	{
+		p.SetState(714)
+		p.Match(nevaParserT__3)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -8230,61 +8570,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPrimitiveConstLitContext is an interface to support dynamic dispatch.
-type IPrimitiveConstLitContext interface {
+// IPortDefContext is an interface to support dynamic dispatch.
+type IPortDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Bool_() IBoolContext
-	INT() antlr.TerminalNode
-	MINUS() antlr.TerminalNode
-	FLOAT() antlr.TerminalNode
-	STRING() antlr.TerminalNode
-	EnumLit() IEnumLitContext
+	SinglePortDef() ISinglePortDefContext
+	ArrayPortDef() IArrayPortDefContext
 
-	// IsPrimitiveConstLitContext differentiates from other interfaces.
-	IsPrimitiveConstLitContext()
+	// IsPortDefContext differentiates from other interfaces.
+	IsPortDefContext()
 }
 
-type PrimitiveConstLitContext struct {
+type PortDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrimitiveConstLitContext() *PrimitiveConstLitContext {
-	var p = new(PrimitiveConstLitContext)
+func NewEmptyPortDefContext() *PortDefContext {
+	var p = new(PortDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_primitiveConstLit
+	p.RuleIndex = nevaParserRULE_portDef
 	return p
 }
 
-func InitEmptyPrimitiveConstLitContext(p *PrimitiveConstLitContext) {
+func InitEmptyPortDefContext(p *PortDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_primitiveConstLit
+	p.RuleIndex = nevaParserRULE_portDef
 }
 
-func (*PrimitiveConstLitContext) IsPrimitiveConstLitContext() {}
+func (*PortDefContext) IsPortDefContext() {}
 
-func NewPrimitiveConstLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimitiveConstLitContext {
-	var p = new(PrimitiveConstLitContext)
+func NewPortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortDefContext {
+	var p = new(PortDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_primitiveConstLit
+	p.RuleIndex = nevaParserRULE_portDef
 
 	return p
 }
 
-func (s *PrimitiveConstLitContext) GetParser() antlr.Parser { return s.parser }
+func (s *PortDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrimitiveConstLitContext) Bool_() IBoolContext {
+func (s *PortDefContext) SinglePortDef() ISinglePortDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBoolContext); ok {
+		if _, ok := ctx.(ISinglePortDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8294,29 +8630,13 @@ func (s *PrimitiveConstLitContext) Bool_() IBoolContext {
 		return nil
 	}
 
-	return t.(IBoolContext)
-}
-
-func (s *PrimitiveConstLitContext) INT() antlr.TerminalNode {
-	return s.GetToken(nevaParserINT, 0)
-}
-
-func (s *PrimitiveConstLitContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(nevaParserMINUS, 0)
-}
-
-func (s *PrimitiveConstLitContext) FLOAT() antlr.TerminalNode {
-	return s.GetToken(nevaParserFLOAT, 0)
-}
-
-func (s *PrimitiveConstLitContext) STRING() antlr.TerminalNode {
-	return s.GetToken(nevaParserSTRING, 0)
+	return t.(ISinglePortDefContext)
 }
 
-func (s *PrimitiveConstLitContext) EnumLit() IEnumLitContext {
+func (s *PortDefContext) ArrayPortDef() IArrayPortDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEnumLitContext); ok {
+		if _, ok := ctx.(IArrayPortDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8326,122 +8646,51 @@ func (s *PrimitiveConstLitContext) EnumLit() IEnumLitContext {
 		return nil
 	}
 
-	return t.(IEnumLitContext)
+	return t.(IArrayPortDefContext)
 }
 
-func (s *PrimitiveConstLitContext) GetRuleContext() antlr.RuleContext {
+func (s *PortDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrimitiveConstLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrimitiveConstLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PortDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPrimitiveConstLit(s)
+		listenerT.EnterPortDef(s)
 	}
 }
 
-func (s *PrimitiveConstLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PortDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPrimitiveConstLit(s)
+		listenerT.ExitPortDef(s)
 	}
 }
 
-func (p *nevaParser) PrimitiveConstLit() (localctx IPrimitiveConstLitContext) {
-	localctx = NewPrimitiveConstLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, nevaParserRULE_primitiveConstLit)
-	var _la int
-
-	p.SetState(633)
+func (p *nevaParser) PortDef() (localctx IPortDefContext) {
+	localctx = NewPortDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, nevaParserRULE_portDef)
+	p.SetState(718)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 72, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 81, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(622)
-			p.Bool_()
+			p.SetState(716)
+			p.SinglePortDef()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(624)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == nevaParserMINUS {
-			{
-				p.SetState(623)
-				p.Match(nevaParserMINUS)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		{
-			p.SetState(626)
-			p.Match(nevaParserINT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		p.SetState(628)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == nevaParserMINUS {
-			{
-				p.SetState(627)
-				p.Match(nevaParserMINUS)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		{
-			p.SetState(630)
-			p.Match(nevaParserFLOAT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(631)
-			p.Match(nevaParserSTRING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(632)
-			p.EnumLit()
+			p.SetState(717)
+			p.ArrayPortDef()
 		}
 
 	case antlr.ATNInvalidAltNumber:
@@ -8461,83 +8710,176 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBoolContext is an interface to support dynamic dispatch.
-type IBoolContext interface {
+// ISinglePortDefContext is an interface to support dynamic dispatch.
+type ISinglePortDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsBoolContext differentiates from other interfaces.
-	IsBoolContext()
+
+	// Getter signatures
+	TypeExpr() ITypeExprContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+
+	// IsSinglePortDefContext differentiates from other interfaces.
+	IsSinglePortDefContext()
 }
 
-type BoolContext struct {
+type SinglePortDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBoolContext() *BoolContext {
-	var p = new(BoolContext)
+func NewEmptySinglePortDefContext() *SinglePortDefContext {
+	var p = new(SinglePortDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_bool
+	p.RuleIndex = nevaParserRULE_singlePortDef
 	return p
 }
 
-func InitEmptyBoolContext(p *BoolContext) {
+func InitEmptySinglePortDefContext(p *SinglePortDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_bool
+	p.RuleIndex = nevaParserRULE_singlePortDef
 }
 
-func (*BoolContext) IsBoolContext() {}
+func (*SinglePortDefContext) IsSinglePortDefContext() {}
 
-func NewBoolContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BoolContext {
-	var p = new(BoolContext)
+func NewSinglePortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SinglePortDefContext {
+	var p = new(SinglePortDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_bool
+	p.RuleIndex = nevaParserRULE_singlePortDef
 
 	return p
 }
 
-func (s *BoolContext) GetParser() antlr.Parser { return s.parser }
-func (s *BoolContext) GetRuleContext() antlr.RuleContext {
+func (s *SinglePortDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SinglePortDefContext) TypeExpr() ITypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeExprContext)
+}
+
+func (s *SinglePortDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *SinglePortDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *SinglePortDefContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *SinglePortDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BoolContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SinglePortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BoolContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SinglePortDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterBool(s)
+		listenerT.EnterSinglePortDef(s)
 	}
 }
 
-func (s *BoolContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SinglePortDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitBool(s)
+		listenerT.ExitSinglePortDef(s)
 	}
 }
 
-func (p *nevaParser) Bool_() (localctx IBoolContext) {
-	localctx = NewBoolContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, nevaParserRULE_bool)
+func (p *nevaParser) SinglePortDef() (localctx ISinglePortDefContext) {
+	localctx = NewSinglePortDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, nevaParserRULE_singlePortDef)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(635)
+	p.SetState(723)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(720)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(725)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(727)
+	p.GetErrorHandler().Sync(p)
 
-		if !(_la == nevaParserT__22 || _la == nevaParserT__23) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 83, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(726)
+			p.Match(nevaParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(729)
+		p.TypeExpr()
+	}
+	p.SetState(733)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(730)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+		p.SetState(735)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -8553,57 +8895,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IEnumLitContext is an interface to support dynamic dispatch.
-type IEnumLitContext interface {
+// IArrayPortDefContext is an interface to support dynamic dispatch.
+type IArrayPortDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EntityRef() IEntityRefContext
 	IDENTIFIER() antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	TypeExpr() ITypeExprContext
 
-	// IsEnumLitContext differentiates from other interfaces.
-	IsEnumLitContext()
+	// IsArrayPortDefContext differentiates from other interfaces.
+	IsArrayPortDefContext()
 }
 
-type EnumLitContext struct {
+type ArrayPortDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyEnumLitContext() *EnumLitContext {
-	var p = new(EnumLitContext)
+func NewEmptyArrayPortDefContext() *ArrayPortDefContext {
+	var p = new(ArrayPortDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_enumLit
+	p.RuleIndex = nevaParserRULE_arrayPortDef
 	return p
 }
 
-func InitEmptyEnumLitContext(p *EnumLitContext) {
+func InitEmptyArrayPortDefContext(p *ArrayPortDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_enumLit
+	p.RuleIndex = nevaParserRULE_arrayPortDef
 }
 
-func (*EnumLitContext) IsEnumLitContext() {}
+func (*ArrayPortDefContext) IsArrayPortDefContext() {}
 
-func NewEnumLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumLitContext {
-	var p = new(EnumLitContext)
+func NewArrayPortDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayPortDefContext {
+	var p = new(ArrayPortDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_enumLit
+	p.RuleIndex = nevaParserRULE_arrayPortDef
 
 	return p
 }
 
-func (s *EnumLitContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrayPortDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *EnumLitContext) EntityRef() IEntityRefContext {
+func (s *ArrayPortDefContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *ArrayPortDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *ArrayPortDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *ArrayPortDefContext) TypeExpr() ITypeExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEntityRefContext); ok {
+		if _, ok := ctx.(ITypeExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8613,57 +8969,121 @@ func (s *EnumLitContext) EntityRef() IEntityRefContext {
 		return nil
 	}
 
-	return t.(IEntityRefContext)
-}
-
-func (s *EnumLitContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+	return t.(ITypeExprContext)
 }
 
-func (s *EnumLitContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayPortDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EnumLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayPortDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EnumLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayPortDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterEnumLit(s)
+		listenerT.EnterArrayPortDef(s)
 	}
 }
 
-func (s *EnumLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayPortDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitEnumLit(s)
+		listenerT.ExitArrayPortDef(s)
 	}
 }
 
-func (p *nevaParser) EnumLit() (localctx IEnumLitContext) {
-	localctx = NewEnumLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, nevaParserRULE_enumLit)
+func (p *nevaParser) ArrayPortDef() (localctx IArrayPortDefContext) {
+	localctx = NewArrayPortDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, nevaParserRULE_arrayPortDef)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(637)
-		p.EntityRef()
+	p.SetState(739)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(736)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(741)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(638)
-		p.Match(nevaParserT__24)
+		p.SetState(742)
+		p.Match(nevaParserT__20)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(639)
+		p.SetState(743)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	{
+		p.SetState(744)
+		p.Match(nevaParserT__21)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(746)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&36028797019881472) != 0 {
+		{
+			p.SetState(745)
+			p.TypeExpr()
+		}
+
+	}
+	p.SetState(751)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(748)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(753)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
 
 errorExit:
 	if p.HasError() {
@@ -8678,66 +9098,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IListLitContext is an interface to support dynamic dispatch.
-type IListLitContext interface {
+// IConstStmtContext is an interface to support dynamic dispatch.
+type IConstStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	ListItems() IListItemsContext
+	ConstDef() IConstDefContext
+	PUB_KW() antlr.TerminalNode
 
-	// IsListLitContext differentiates from other interfaces.
-	IsListLitContext()
+	// IsConstStmtContext differentiates from other interfaces.
+	IsConstStmtContext()
 }
 
-type ListLitContext struct {
+type ConstStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyListLitContext() *ListLitContext {
-	var p = new(ListLitContext)
+func NewEmptyConstStmtContext() *ConstStmtContext {
+	var p = new(ConstStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_listLit
+	p.RuleIndex = nevaParserRULE_constStmt
 	return p
 }
 
-func InitEmptyListLitContext(p *ListLitContext) {
+func InitEmptyConstStmtContext(p *ConstStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_listLit
+	p.RuleIndex = nevaParserRULE_constStmt
 }
 
-func (*ListLitContext) IsListLitContext() {}
+func (*ConstStmtContext) IsConstStmtContext() {}
 
-func NewListLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListLitContext {
-	var p = new(ListLitContext)
+func NewConstStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstStmtContext {
+	var p = new(ConstStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_listLit
+	p.RuleIndex = nevaParserRULE_constStmt
 
 	return p
 }
 
-func (s *ListLitContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ListLitContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *ListLitContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
-}
+func (s *ConstStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ListLitContext) ListItems() IListItemsContext {
+func (s *ConstStmtContext) ConstDef() IConstDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IListItemsContext); ok {
+		if _, ok := ctx.(IConstDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8747,89 +9158,69 @@ func (s *ListLitContext) ListItems() IListItemsContext {
 		return nil
 	}
 
-	return t.(IListItemsContext)
+	return t.(IConstDefContext)
 }
 
-func (s *ListLitContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstStmtContext) PUB_KW() antlr.TerminalNode {
+	return s.GetToken(nevaParserPUB_KW, 0)
+}
+
+func (s *ConstStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ListLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ListLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterListLit(s)
+		listenerT.EnterConstStmt(s)
 	}
 }
 
-func (s *ListLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitListLit(s)
+		listenerT.ExitConstStmt(s)
 	}
 }
 
-func (p *nevaParser) ListLit() (localctx IListLitContext) {
-	localctx = NewListLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, nevaParserRULE_listLit)
+func (p *nevaParser) ConstStmt() (localctx IConstStmtContext) {
+	localctx = NewConstStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, nevaParserRULE_constStmt)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(641)
-		p.Match(nevaParserT__18)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(645)
+	p.SetState(755)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	if _la == nevaParserPUB_KW {
 		{
-			p.SetState(642)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(754)
+			p.Match(nevaParserPUB_KW)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-		p.SetState(647)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(649)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&139611588474175552) != 0 {
-		{
-			p.SetState(648)
-			p.ListItems()
-		}
-
 	}
 	{
-		p.SetState(651)
-		p.Match(nevaParserT__19)
+		p.SetState(757)
+		p.Match(nevaParserT__22)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	{
+		p.SetState(758)
+		p.ConstDef()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -8844,86 +9235,82 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IListItemsContext is an interface to support dynamic dispatch.
-type IListItemsContext interface {
+// IConstDefContext is an interface to support dynamic dispatch.
+type IConstDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllCompositeItem() []ICompositeItemContext
-	CompositeItem(i int) ICompositeItemContext
+	IDENTIFIER() antlr.TerminalNode
+	TypeExpr() ITypeExprContext
+	ConstExpr() IConstExprContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsListItemsContext differentiates from other interfaces.
-	IsListItemsContext()
+	// IsConstDefContext differentiates from other interfaces.
+	IsConstDefContext()
 }
 
-type ListItemsContext struct {
+type ConstDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyListItemsContext() *ListItemsContext {
-	var p = new(ListItemsContext)
+func NewEmptyConstDefContext() *ConstDefContext {
+	var p = new(ConstDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_listItems
+	p.RuleIndex = nevaParserRULE_constDef
 	return p
 }
 
-func InitEmptyListItemsContext(p *ListItemsContext) {
+func InitEmptyConstDefContext(p *ConstDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_listItems
+	p.RuleIndex = nevaParserRULE_constDef
 }
 
-func (*ListItemsContext) IsListItemsContext() {}
+func (*ConstDefContext) IsConstDefContext() {}
 
-func NewListItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListItemsContext {
-	var p = new(ListItemsContext)
+func NewConstDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstDefContext {
+	var p = new(ConstDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_listItems
+	p.RuleIndex = nevaParserRULE_constDef
 
 	return p
 }
 
-func (s *ListItemsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ListItemsContext) AllCompositeItem() []ICompositeItemContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ICompositeItemContext); ok {
-			len++
+func (s *ConstDefContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *ConstDefContext) TypeExpr() ITypeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ICompositeItemContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ICompositeItemContext); ok {
-			tst[i] = t.(ICompositeItemContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ITypeExprContext)
 }
 
-func (s *ListItemsContext) CompositeItem(i int) ICompositeItemContext {
+func (s *ConstDefContext) ConstExpr() IConstExprContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompositeItemContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IConstExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -8931,141 +9318,97 @@ func (s *ListItemsContext) CompositeItem(i int) ICompositeItemContext {
 		return nil
 	}
 
-	return t.(ICompositeItemContext)
+	return t.(IConstExprContext)
 }
 
-func (s *ListItemsContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *ConstDefContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *ListItemsContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *ConstDefContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *ListItemsContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ListItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ListItemsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterListItems(s)
+		listenerT.EnterConstDef(s)
 	}
 }
 
-func (s *ListItemsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitListItems(s)
+		listenerT.ExitConstDef(s)
 	}
 }
 
-func (p *nevaParser) ListItems() (localctx IListItemsContext) {
-	localctx = NewListItemsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, nevaParserRULE_listItems)
-	var _la int
-
-	p.SetState(674)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 78, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(653)
-			p.CompositeItem()
-		}
+func (p *nevaParser) ConstDef() (localctx IConstDefContext) {
+	localctx = NewConstDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, nevaParserRULE_constDef)
+	var _alt int
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(654)
-			p.CompositeItem()
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(760)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		p.SetState(671)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(761)
+		p.TypeExpr()
+	}
+	{
+		p.SetState(762)
+		p.Match(nevaParserT__23)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == nevaParserT__2 {
+	}
+	{
+		p.SetState(763)
+		p.ConstExpr()
+	}
+	p.SetState(767)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 89, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(655)
-				p.Match(nevaParserT__2)
+				p.SetState(764)
+				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(659)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(656)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-				p.SetState(661)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
-			{
-				p.SetState(662)
-				p.CompositeItem()
-			}
-			p.SetState(666)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(663)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-				p.SetState(668)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
 
-			p.SetState(673)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		p.SetState(769)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 89, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -9081,59 +9424,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompositeItemContext is an interface to support dynamic dispatch.
-type ICompositeItemContext interface {
+// IConstExprContext is an interface to support dynamic dispatch.
+type IConstExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EntityRef() IEntityRefContext
-	ConstLit() IConstLitContext
+	AllConstOperand() []IConstOperandContext
+	ConstOperand(i int) IConstOperandContext
+	AllBinaryOp() []IBinaryOpContext
+	BinaryOp(i int) IBinaryOpContext
 
-	// IsCompositeItemContext differentiates from other interfaces.
-	IsCompositeItemContext()
+	// IsConstExprContext differentiates from other interfaces.
+	IsConstExprContext()
 }
 
-type CompositeItemContext struct {
+type ConstExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompositeItemContext() *CompositeItemContext {
-	var p = new(CompositeItemContext)
+func NewEmptyConstExprContext() *ConstExprContext {
+	var p = new(ConstExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compositeItem
+	p.RuleIndex = nevaParserRULE_constExpr
 	return p
 }
 
-func InitEmptyCompositeItemContext(p *CompositeItemContext) {
+func InitEmptyConstExprContext(p *ConstExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compositeItem
+	p.RuleIndex = nevaParserRULE_constExpr
 }
 
-func (*CompositeItemContext) IsCompositeItemContext() {}
+func (*ConstExprContext) IsConstExprContext() {}
 
-func NewCompositeItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompositeItemContext {
-	var p = new(CompositeItemContext)
+func NewConstExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstExprContext {
+	var p = new(ConstExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compositeItem
+	p.RuleIndex = nevaParserRULE_constExpr
 
 	return p
 }
 
-func (s *CompositeItemContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompositeItemContext) EntityRef() IEntityRefContext {
+func (s *ConstExprContext) AllConstOperand() []IConstOperandContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IConstOperandContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IConstOperandContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IConstOperandContext); ok {
+			tst[i] = t.(IConstOperandContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ConstExprContext) ConstOperand(i int) IConstOperandContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEntityRefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IConstOperandContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -9141,15 +9511,40 @@ func (s *CompositeItemContext) EntityRef() IEntityRefContext {
 		return nil
 	}
 
-	return t.(IEntityRefContext)
+	return t.(IConstOperandContext)
 }
 
-func (s *CompositeItemContext) ConstLit() IConstLitContext {
+func (s *ConstExprContext) AllBinaryOp() []IBinaryOpContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBinaryOpContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IBinaryOpContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBinaryOpContext); ok {
+			tst[i] = t.(IBinaryOpContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ConstExprContext) BinaryOp(i int) IBinaryOpContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConstLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IBinaryOpContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -9157,55 +9552,62 @@ func (s *CompositeItemContext) ConstLit() IConstLitContext {
 		return nil
 	}
 
-	return t.(IConstLitContext)
+	return t.(IBinaryOpContext)
 }
 
-func (s *CompositeItemContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompositeItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompositeItemContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompositeItem(s)
+		listenerT.EnterConstExpr(s)
 	}
 }
 
-func (s *CompositeItemContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompositeItem(s)
+		listenerT.ExitConstExpr(s)
 	}
 }
 
-func (p *nevaParser) CompositeItem() (localctx ICompositeItemContext) {
-	localctx = NewCompositeItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, nevaParserRULE_compositeItem)
-	p.SetState(678)
+func (p *nevaParser) ConstExpr() (localctx IConstExprContext) {
+	localctx = NewConstExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, nevaParserRULE_constExpr)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(770)
+		p.ConstOperand()
+	}
+	p.SetState(776)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 79, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&144678069309924352) != 0 {
 		{
-			p.SetState(676)
-			p.EntityRef()
+			p.SetState(771)
+			p.BinaryOp()
 		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(677)
-			p.ConstLit()
+			p.SetState(772)
+			p.ConstOperand()
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		p.SetState(778)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -9221,66 +9623,90 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructLitContext is an interface to support dynamic dispatch.
-type IStructLitContext interface {
+// IConstOperandContext is an interface to support dynamic dispatch.
+type IConstOperandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	StructValueFields() IStructValueFieldsContext
+	EntityRef() IEntityRefContext
+	ConstLit() IConstLitContext
+	ConstExpr() IConstExprContext
 
-	// IsStructLitContext differentiates from other interfaces.
-	IsStructLitContext()
+	// IsConstOperandContext differentiates from other interfaces.
+	IsConstOperandContext()
 }
 
-type StructLitContext struct {
+type ConstOperandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructLitContext() *StructLitContext {
-	var p = new(StructLitContext)
+func NewEmptyConstOperandContext() *ConstOperandContext {
+	var p = new(ConstOperandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structLit
+	p.RuleIndex = nevaParserRULE_constOperand
 	return p
 }
 
-func InitEmptyStructLitContext(p *StructLitContext) {
+func InitEmptyConstOperandContext(p *ConstOperandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structLit
+	p.RuleIndex = nevaParserRULE_constOperand
 }
 
-func (*StructLitContext) IsStructLitContext() {}
+func (*ConstOperandContext) IsConstOperandContext() {}
 
-func NewStructLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructLitContext {
-	var p = new(StructLitContext)
+func NewConstOperandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstOperandContext {
+	var p = new(ConstOperandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structLit
+	p.RuleIndex = nevaParserRULE_constOperand
 
 	return p
 }
 
-func (s *StructLitContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstOperandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructLitContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+func (s *ConstOperandContext) EntityRef() IEntityRefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntityRefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntityRefContext)
 }
 
-func (s *StructLitContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *ConstOperandContext) ConstLit() IConstLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstLitContext)
 }
 
-func (s *StructLitContext) StructValueFields() IStructValueFieldsContext {
+func (s *ConstOperandContext) ConstExpr() IConstExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructValueFieldsContext); ok {
+		if _, ok := ctx.(IConstExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9290,88 +9716,78 @@ func (s *StructLitContext) StructValueFields() IStructValueFieldsContext {
 		return nil
 	}
 
-	return t.(IStructValueFieldsContext)
+	return t.(IConstExprContext)
 }
 
-func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstOperandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstOperandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstOperandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructLit(s)
+		listenerT.EnterConstOperand(s)
 	}
 }
 
-func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstOperandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructLit(s)
+		listenerT.ExitConstOperand(s)
 	}
 }
 
-func (p *nevaParser) StructLit() (localctx IStructLitContext) {
-	localctx = NewStructLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, nevaParserRULE_structLit)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(680)
-		p.Match(nevaParserT__5)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(684)
+func (p *nevaParser) ConstOperand() (localctx IConstOperandContext) {
+	localctx = NewConstOperandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, nevaParserRULE_constOperand)
+	p.SetState(785)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 91, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(681)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(779)
+			p.EntityRef()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(780)
+			p.ConstLit()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(781)
+			p.Match(nevaParserT__1)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-		p.SetState(686)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(782)
+			p.ConstExpr()
 		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(688)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserIDENTIFIER {
 		{
-			p.SetState(687)
-			p.StructValueFields()
+			p.SetState(783)
+			p.Match(nevaParserT__3)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	}
-	{
-		p.SetState(690)
-		p.Match(nevaParserT__6)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9387,86 +9803,97 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructValueFieldsContext is an interface to support dynamic dispatch.
-type IStructValueFieldsContext interface {
+// IConstLitContext is an interface to support dynamic dispatch.
+type IConstLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllStructValueField() []IStructValueFieldContext
-	StructValueField(i int) IStructValueFieldContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	Bool_() IBoolContext
+	INT() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+	FLOAT() antlr.TerminalNode
+	STRING() antlr.TerminalNode
+	EnumLit() IEnumLitContext
+	ListLit() IListLitContext
+	StructLit() IStructLitContext
 
-	// IsStructValueFieldsContext differentiates from other interfaces.
-	IsStructValueFieldsContext()
+	// IsConstLitContext differentiates from other interfaces.
+	IsConstLitContext()
 }
 
-type StructValueFieldsContext struct {
+type ConstLitContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructValueFieldsContext() *StructValueFieldsContext {
-	var p = new(StructValueFieldsContext)
+func NewEmptyConstLitContext() *ConstLitContext {
+	var p = new(ConstLitContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structValueFields
+	p.RuleIndex = nevaParserRULE_constLit
 	return p
 }
 
-func InitEmptyStructValueFieldsContext(p *StructValueFieldsContext) {
+func InitEmptyConstLitContext(p *ConstLitContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structValueFields
+	p.RuleIndex = nevaParserRULE_constLit
 }
 
-func (*StructValueFieldsContext) IsStructValueFieldsContext() {}
+func (*ConstLitContext) IsConstLitContext() {}
 
-func NewStructValueFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructValueFieldsContext {
-	var p = new(StructValueFieldsContext)
+func NewConstLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstLitContext {
+	var p = new(ConstLitContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structValueFields
+	p.RuleIndex = nevaParserRULE_constLit
 
 	return p
 }
 
-func (s *StructValueFieldsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructValueFieldsContext) AllStructValueField() []IStructValueFieldContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStructValueFieldContext); ok {
-			len++
+func (s *ConstLitContext) Bool_() IBoolContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBoolContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IStructValueFieldContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStructValueFieldContext); ok {
-			tst[i] = t.(IStructValueFieldContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IBoolContext)
 }
 
-func (s *StructValueFieldsContext) StructValueField(i int) IStructValueFieldContext {
+func (s *ConstLitContext) INT() antlr.TerminalNode {
+	return s.GetToken(nevaParserINT, 0)
+}
+
+func (s *ConstLitContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(nevaParserMINUS, 0)
+}
+
+func (s *ConstLitContext) FLOAT() antlr.TerminalNode {
+	return s.GetToken(nevaParserFLOAT, 0)
+}
+
+func (s *ConstLitContext) STRING() antlr.TerminalNode {
+	return s.GetToken(nevaParserSTRING, 0)
+}
+
+func (s *ConstLitContext) EnumLit() IEnumLitContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructValueFieldContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IEnumLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -9474,98 +9901,172 @@ func (s *StructValueFieldsContext) StructValueField(i int) IStructValueFieldCont
 		return nil
 	}
 
-	return t.(IStructValueFieldContext)
+	return t.(IEnumLitContext)
 }
 
-func (s *StructValueFieldsContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+func (s *ConstLitContext) ListLit() IListLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListLitContext)
 }
 
-func (s *StructValueFieldsContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *ConstLitContext) StructLit() IStructLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructLitContext)
 }
 
-func (s *StructValueFieldsContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructValueFieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructValueFieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructValueFields(s)
+		listenerT.EnterConstLit(s)
 	}
 }
 
-func (s *StructValueFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructValueFields(s)
+		listenerT.ExitConstLit(s)
 	}
 }
 
-func (p *nevaParser) StructValueFields() (localctx IStructValueFieldsContext) {
-	localctx = NewStructValueFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, nevaParserRULE_structValueFields)
+func (p *nevaParser) ConstLit() (localctx IConstLitContext) {
+	localctx = NewConstLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, nevaParserRULE_constLit)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(692)
-		p.StructValueField()
-	}
-	p.SetState(703)
+	p.SetState(800)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserT__2 {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(693)
-			p.Match(nevaParserT__2)
+			p.SetState(787)
+			p.Bool_()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(789)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == nevaParserMINUS {
+			{
+				p.SetState(788)
+				p.Match(nevaParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(791)
+			p.Match(nevaParserINT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(697)
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(793)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for _la == nevaParserNEWLINE {
+		if _la == nevaParserMINUS {
 			{
-				p.SetState(694)
-				p.Match(nevaParserNEWLINE)
+				p.SetState(792)
+				p.Match(nevaParserMINUS)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
 
-			p.SetState(699)
-			p.GetErrorHandler().Sync(p)
+		}
+		{
+			p.SetState(795)
+			p.Match(nevaParserFLOAT)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-			_la = p.GetTokenStream().LA(1)
 		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(700)
-			p.StructValueField()
+			p.SetState(796)
+			p.Match(nevaParserSTRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-		p.SetState(705)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(797)
+			p.EnumLit()
 		}
-		_la = p.GetTokenStream().LA(1)
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(798)
+			p.ListLit()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(799)
+			p.StructLit()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9581,63 +10082,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructValueFieldContext is an interface to support dynamic dispatch.
-type IStructValueFieldContext interface {
+// IPrimitiveConstLitContext is an interface to support dynamic dispatch.
+type IPrimitiveConstLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	CompositeItem() ICompositeItemContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	Bool_() IBoolContext
+	INT() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+	FLOAT() antlr.TerminalNode
+	STRING() antlr.TerminalNode
+	EnumLit() IEnumLitContext
 
-	// IsStructValueFieldContext differentiates from other interfaces.
-	IsStructValueFieldContext()
+	// IsPrimitiveConstLitContext differentiates from other interfaces.
+	IsPrimitiveConstLitContext()
 }
 
-type StructValueFieldContext struct {
+type PrimitiveConstLitContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructValueFieldContext() *StructValueFieldContext {
-	var p = new(StructValueFieldContext)
+func NewEmptyPrimitiveConstLitContext() *PrimitiveConstLitContext {
+	var p = new(PrimitiveConstLitContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structValueField
+	p.RuleIndex = nevaParserRULE_primitiveConstLit
 	return p
 }
 
-func InitEmptyStructValueFieldContext(p *StructValueFieldContext) {
+func InitEmptyPrimitiveConstLitContext(p *PrimitiveConstLitContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structValueField
+	p.RuleIndex = nevaParserRULE_primitiveConstLit
 }
 
-func (*StructValueFieldContext) IsStructValueFieldContext() {}
+func (*PrimitiveConstLitContext) IsPrimitiveConstLitContext() {}
 
-func NewStructValueFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructValueFieldContext {
-	var p = new(StructValueFieldContext)
+func NewPrimitiveConstLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimitiveConstLitContext {
+	var p = new(PrimitiveConstLitContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structValueField
+	p.RuleIndex = nevaParserRULE_primitiveConstLit
 
 	return p
 }
 
-func (s *StructValueFieldContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StructValueFieldContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
-}
+func (s *PrimitiveConstLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructValueFieldContext) CompositeItem() ICompositeItemContext {
+func (s *PrimitiveConstLitContext) Bool_() IBoolContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompositeItemContext); ok {
+		if _, ok := ctx.(IBoolContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9647,255 +10146,251 @@ func (s *StructValueFieldContext) CompositeItem() ICompositeItemContext {
 		return nil
 	}
 
-	return t.(ICompositeItemContext)
+	return t.(IBoolContext)
 }
 
-func (s *StructValueFieldContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+func (s *PrimitiveConstLitContext) INT() antlr.TerminalNode {
+	return s.GetToken(nevaParserINT, 0)
 }
 
-func (s *StructValueFieldContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *PrimitiveConstLitContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(nevaParserMINUS, 0)
 }
 
-func (s *StructValueFieldContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimitiveConstLitContext) FLOAT() antlr.TerminalNode {
+	return s.GetToken(nevaParserFLOAT, 0)
+}
+
+func (s *PrimitiveConstLitContext) STRING() antlr.TerminalNode {
+	return s.GetToken(nevaParserSTRING, 0)
+}
+
+func (s *PrimitiveConstLitContext) EnumLit() IEnumLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumLitContext)
+}
+
+func (s *PrimitiveConstLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructValueFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrimitiveConstLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructValueFieldContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimitiveConstLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructValueField(s)
+		listenerT.EnterPrimitiveConstLit(s)
 	}
 }
 
-func (s *StructValueFieldContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimitiveConstLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructValueField(s)
+		listenerT.ExitPrimitiveConstLit(s)
 	}
 }
 
-func (p *nevaParser) StructValueField() (localctx IStructValueFieldContext) {
-	localctx = NewStructValueFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, nevaParserRULE_structValueField)
+func (p *nevaParser) PrimitiveConstLit() (localctx IPrimitiveConstLitContext) {
+	localctx = NewPrimitiveConstLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, nevaParserRULE_primitiveConstLit)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(706)
-		p.Match(nevaParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(707)
-		p.Match(nevaParserT__7)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(708)
-		p.CompositeItem()
-	}
-	p.SetState(712)
+	p.SetState(813)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(709)
-			p.Match(nevaParserNEWLINE)
+			p.SetState(802)
+			p.Bool_()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(804)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == nevaParserMINUS {
+			{
+				p.SetState(803)
+				p.Match(nevaParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(806)
+			p.Match(nevaParserINT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-		p.SetState(714)
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(808)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
+		if _la == nevaParserMINUS {
+			{
+				p.SetState(807)
+				p.Match(nevaParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-// ICompStmtContext is an interface to support dynamic dispatch.
-type ICompStmtContext interface {
+		}
+		{
+			p.SetState(810)
+			p.Match(nevaParserFLOAT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(811)
+			p.Match(nevaParserSTRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(812)
+			p.EnumLit()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IBoolContext is an interface to support dynamic dispatch.
+type IBoolContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-
-	// Getter signatures
-	CompDef() ICompDefContext
-	CompilerDirectives() ICompilerDirectivesContext
-	PUB_KW() antlr.TerminalNode
-
-	// IsCompStmtContext differentiates from other interfaces.
-	IsCompStmtContext()
+	// IsBoolContext differentiates from other interfaces.
+	IsBoolContext()
 }
 
-type CompStmtContext struct {
+type BoolContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompStmtContext() *CompStmtContext {
-	var p = new(CompStmtContext)
+func NewEmptyBoolContext() *BoolContext {
+	var p = new(BoolContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compStmt
+	p.RuleIndex = nevaParserRULE_bool
 	return p
 }
 
-func InitEmptyCompStmtContext(p *CompStmtContext) {
+func InitEmptyBoolContext(p *BoolContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compStmt
+	p.RuleIndex = nevaParserRULE_bool
 }
 
-func (*CompStmtContext) IsCompStmtContext() {}
+func (*BoolContext) IsBoolContext() {}
 
-func NewCompStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompStmtContext {
-	var p = new(CompStmtContext)
+func NewBoolContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BoolContext {
+	var p = new(BoolContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compStmt
+	p.RuleIndex = nevaParserRULE_bool
 
 	return p
 }
 
-func (s *CompStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *CompStmtContext) CompDef() ICompDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ICompDefContext)
-}
-
-func (s *CompStmtContext) CompilerDirectives() ICompilerDirectivesContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompilerDirectivesContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ICompilerDirectivesContext)
-}
-
-func (s *CompStmtContext) PUB_KW() antlr.TerminalNode {
-	return s.GetToken(nevaParserPUB_KW, 0)
-}
-
-func (s *CompStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *BoolContext) GetParser() antlr.Parser { return s.parser }
+func (s *BoolContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BoolContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BoolContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompStmt(s)
+		listenerT.EnterBool(s)
 	}
 }
 
-func (s *CompStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BoolContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompStmt(s)
+		listenerT.ExitBool(s)
 	}
 }
 
-func (p *nevaParser) CompStmt() (localctx ICompStmtContext) {
-	localctx = NewCompStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, nevaParserRULE_compStmt)
+func (p *nevaParser) Bool_() (localctx IBoolContext) {
+	localctx = NewBoolContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, nevaParserRULE_bool)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(716)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserT__0 {
-		{
-			p.SetState(715)
-			p.CompilerDirectives()
-		}
-
-	}
-	p.SetState(719)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserPUB_KW {
-		{
-			p.SetState(718)
-			p.Match(nevaParserPUB_KW)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
 	{
-		p.SetState(721)
-		p.Match(nevaParserT__25)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(815)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == nevaParserT__24 || _la == nevaParserT__25) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
-	{
-		p.SetState(722)
-		p.CompDef()
-	}
 
 errorExit:
 	if p.HasError() {
@@ -9910,75 +10405,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompDefContext is an interface to support dynamic dispatch.
-type ICompDefContext interface {
+// IEnumLitContext is an interface to support dynamic dispatch.
+type IEnumLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	InterfaceDef() IInterfaceDefContext
-	CompBody() ICompBodyContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	EntityRef() IEntityRefContext
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsCompDefContext differentiates from other interfaces.
-	IsCompDefContext()
+	// IsEnumLitContext differentiates from other interfaces.
+	IsEnumLitContext()
 }
 
-type CompDefContext struct {
+type EnumLitContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompDefContext() *CompDefContext {
-	var p = new(CompDefContext)
+func NewEmptyEnumLitContext() *EnumLitContext {
+	var p = new(EnumLitContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compDef
+	p.RuleIndex = nevaParserRULE_enumLit
 	return p
 }
 
-func InitEmptyCompDefContext(p *CompDefContext) {
+func InitEmptyEnumLitContext(p *EnumLitContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compDef
+	p.RuleIndex = nevaParserRULE_enumLit
 }
 
-func (*CompDefContext) IsCompDefContext() {}
+func (*EnumLitContext) IsEnumLitContext() {}
 
-func NewCompDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompDefContext {
-	var p = new(CompDefContext)
+func NewEnumLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumLitContext {
+	var p = new(EnumLitContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compDef
+	p.RuleIndex = nevaParserRULE_enumLit
 
 	return p
 }
 
-func (s *CompDefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *CompDefContext) InterfaceDef() IInterfaceDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IInterfaceDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IInterfaceDefContext)
-}
+func (s *EnumLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompDefContext) CompBody() ICompBodyContext {
+func (s *EnumLitContext) EntityRef() IEntityRefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompBodyContext); ok {
+		if _, ok := ctx.(IEntityRefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9988,91 +10465,54 @@ func (s *CompDefContext) CompBody() ICompBodyContext {
 		return nil
 	}
 
-	return t.(ICompBodyContext)
-}
-
-func (s *CompDefContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+	return t.(IEntityRefContext)
 }
 
-func (s *CompDefContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *EnumLitContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
 }
 
-func (s *CompDefContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompDef(s)
+		listenerT.EnterEnumLit(s)
 	}
 }
 
-func (s *CompDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompDef(s)
+		listenerT.ExitEnumLit(s)
 	}
 }
 
-func (p *nevaParser) CompDef() (localctx ICompDefContext) {
-	localctx = NewCompDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, nevaParserRULE_compDef)
-	var _la int
-
-	var _alt int
-
+func (p *nevaParser) EnumLit() (localctx IEnumLitContext) {
+	localctx = NewEnumLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, nevaParserRULE_enumLit)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(724)
-		p.InterfaceDef()
-	}
-	p.SetState(726)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserT__5 {
-		{
-			p.SetState(725)
-			p.CompBody()
-		}
-
-	}
-	p.SetState(731)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 88, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
+		p.SetState(817)
+		p.EntityRef()
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(728)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		p.SetState(733)
-		p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(818)
+		p.Match(nevaParserT__26)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 88, p.GetParserRuleContext())
+	}
+	{
+		p.SetState(819)
+		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -10090,8 +10530,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompBodyContext is an interface to support dynamic dispatch.
-type ICompBodyContext interface {
+// IListLitContext is an interface to support dynamic dispatch.
+type IListLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -10100,83 +10540,56 @@ type ICompBodyContext interface {
 	// Getter signatures
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
-	AllCOMMENT() []antlr.TerminalNode
-	COMMENT(i int) antlr.TerminalNode
-	CompNodesDef() ICompNodesDefContext
-	ConnDefList() IConnDefListContext
+	ListItems() IListItemsContext
 
-	// IsCompBodyContext differentiates from other interfaces.
-	IsCompBodyContext()
+	// IsListLitContext differentiates from other interfaces.
+	IsListLitContext()
 }
 
-type CompBodyContext struct {
+type ListLitContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompBodyContext() *CompBodyContext {
-	var p = new(CompBodyContext)
+func NewEmptyListLitContext() *ListLitContext {
+	var p = new(ListLitContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compBody
+	p.RuleIndex = nevaParserRULE_listLit
 	return p
 }
 
-func InitEmptyCompBodyContext(p *CompBodyContext) {
+func InitEmptyListLitContext(p *ListLitContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compBody
+	p.RuleIndex = nevaParserRULE_listLit
 }
 
-func (*CompBodyContext) IsCompBodyContext() {}
+func (*ListLitContext) IsListLitContext() {}
 
-func NewCompBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompBodyContext {
-	var p = new(CompBodyContext)
+func NewListLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListLitContext {
+	var p = new(ListLitContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compBody
+	p.RuleIndex = nevaParserRULE_listLit
 
 	return p
 }
 
-func (s *CompBodyContext) GetParser() antlr.Parser { return s.parser }
+func (s *ListLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompBodyContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *ListLitContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *CompBodyContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *ListLitContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *CompBodyContext) AllCOMMENT() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserCOMMENT)
-}
-
-func (s *CompBodyContext) COMMENT(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserCOMMENT, i)
-}
-
-func (s *CompBodyContext) CompNodesDef() ICompNodesDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompNodesDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ICompNodesDefContext)
-}
-
-func (s *CompBodyContext) ConnDefList() IConnDefListContext {
+func (s *ListLitContext) ListItems() IListItemsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConnDefListContext); ok {
+		if _, ok := ctx.(IListItemsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10186,46 +10599,44 @@ func (s *CompBodyContext) ConnDefList() IConnDefListContext {
 		return nil
 	}
 
-	return t.(IConnDefListContext)
+	return t.(IListItemsContext)
 }
 
-func (s *CompBodyContext) GetRuleContext() antlr.RuleContext {
+func (s *ListLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ListLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompBodyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ListLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompBody(s)
+		listenerT.EnterListLit(s)
 	}
 }
 
-func (s *CompBodyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ListLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompBody(s)
+		listenerT.ExitListLit(s)
 	}
 }
 
-func (p *nevaParser) CompBody() (localctx ICompBodyContext) {
-	localctx = NewCompBodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, nevaParserRULE_compBody)
+func (p *nevaParser) ListLit() (localctx IListLitContext) {
+	localctx = NewListLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, nevaParserRULE_listLit)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(734)
-		p.Match(nevaParserT__5)
+		p.SetState(821)
+		p.Match(nevaParserT__20)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(738)
+	p.SetState(825)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10234,7 +10645,7 @@ func (p *nevaParser) CompBody() (localctx ICompBodyContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(735)
+			p.SetState(822)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10242,229 +10653,262 @@ func (p *nevaParser) CompBody() (localctx ICompBodyContext) {
 			}
 		}
 
-		p.SetState(740)
+		p.SetState(827)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(750)
+	p.SetState(829)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 91, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(741)
-				p.Match(nevaParserCOMMENT)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			p.SetState(745)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(742)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-				p.SetState(747)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
-
-		}
-		p.SetState(752)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 91, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-	}
-	p.SetState(760)
-	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 93, p.GetParserRuleContext()) == 1 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1116892707690643520) != 0 {
 		{
-			p.SetState(753)
-			p.CompNodesDef()
+			p.SetState(828)
+			p.ListItems()
 		}
-		p.SetState(757)
-		p.GetErrorHandler().Sync(p)
+
+	}
+	{
+		p.SetState(831)
+		p.Match(nevaParserT__21)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == nevaParserNEWLINE {
-			{
-				p.SetState(754)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-			p.SetState(759)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
-	} else if p.HasError() { // JIM
-		goto errorExit
-	}
-	p.SetState(771)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 95, p.GetParserRuleContext())
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(762)
-				p.Match(nevaParserCOMMENT)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			p.SetState(766)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-			for _la == nevaParserNEWLINE {
-				{
-					p.SetState(763)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
+// IListItemsContext is an interface to support dynamic dispatch.
+type IListItemsContext interface {
+	antlr.ParserRuleContext
 
-				p.SetState(768)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-			}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
+	// Getter signatures
+	AllCompositeItem() []ICompositeItemContext
+	CompositeItem(i int) ICompositeItemContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsListItemsContext differentiates from other interfaces.
+	IsListItemsContext()
+}
+
+type ListItemsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyListItemsContext() *ListItemsContext {
+	var p = new(ListItemsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_listItems
+	return p
+}
+
+func InitEmptyListItemsContext(p *ListItemsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_listItems
+}
+
+func (*ListItemsContext) IsListItemsContext() {}
+
+func NewListItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListItemsContext {
+	var p = new(ListItemsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_listItems
+
+	return p
+}
+
+func (s *ListItemsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ListItemsContext) AllCompositeItem() []ICompositeItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICompositeItemContext); ok {
+			len++
 		}
-		p.SetState(773)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 95, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+	}
+
+	tst := make([]ICompositeItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICompositeItemContext); ok {
+			tst[i] = t.(ICompositeItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ListItemsContext) CompositeItem(i int) ICompositeItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompositeItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
-	p.SetState(781)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompositeItemContext)
+}
+
+func (s *ListItemsContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *ListItemsContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *ListItemsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ListItemsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterListItems(s)
+	}
+}
+
+func (s *ListItemsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitListItems(s)
+	}
+}
+
+func (p *nevaParser) ListItems() (localctx IListItemsContext) {
+	localctx = NewListItemsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, nevaParserRULE_listItems)
+	var _la int
+
+	p.SetState(854)
 	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext()) == 1 {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(774)
-			p.ConnDefList()
+			p.SetState(833)
+			p.CompositeItem()
 		}
-		p.SetState(778)
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(834)
+			p.CompositeItem()
+		}
+		p.SetState(851)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for _la == nevaParserNEWLINE {
+		for _la == nevaParserT__2 {
 			{
-				p.SetState(775)
-				p.Match(nevaParserNEWLINE)
+				p.SetState(835)
+				p.Match(nevaParserT__2)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-
-			p.SetState(780)
+			p.SetState(839)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
-		}
 
-	} else if p.HasError() { // JIM
-		goto errorExit
-	}
-	p.SetState(792)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(836)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-	for _la == nevaParserCOMMENT {
-		{
-			p.SetState(783)
-			p.Match(nevaParserCOMMENT)
+				p.SetState(841)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(842)
+				p.CompositeItem()
+			}
+			p.SetState(846)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
-		p.SetState(787)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+			_la = p.GetTokenStream().LA(1)
 
-		for _la == nevaParserNEWLINE {
-			{
-				p.SetState(784)
-				p.Match(nevaParserNEWLINE)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(843)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(848)
+				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
-					// Recognition error - abort rule
 					goto errorExit
 				}
+				_la = p.GetTokenStream().LA(1)
 			}
 
-			p.SetState(789)
+			p.SetState(853)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -10472,20 +10916,8 @@ func (p *nevaParser) CompBody() (localctx ICompBodyContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 
-		p.SetState(794)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(795)
-		p.Match(nevaParserT__6)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -10501,58 +10933,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompNodesDefContext is an interface to support dynamic dispatch.
-type ICompNodesDefContext interface {
+// ICompositeItemContext is an interface to support dynamic dispatch.
+type ICompositeItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	CompNodesDefBody() ICompNodesDefBodyContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	EntityRef() IEntityRefContext
+	ConstLit() IConstLitContext
 
-	// IsCompNodesDefContext differentiates from other interfaces.
-	IsCompNodesDefContext()
+	// IsCompositeItemContext differentiates from other interfaces.
+	IsCompositeItemContext()
 }
 
-type CompNodesDefContext struct {
+type CompositeItemContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompNodesDefContext() *CompNodesDefContext {
-	var p = new(CompNodesDefContext)
+func NewEmptyCompositeItemContext() *CompositeItemContext {
+	var p = new(CompositeItemContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodesDef
+	p.RuleIndex = nevaParserRULE_compositeItem
 	return p
 }
 
-func InitEmptyCompNodesDefContext(p *CompNodesDefContext) {
+func InitEmptyCompositeItemContext(p *CompositeItemContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodesDef
+	p.RuleIndex = nevaParserRULE_compositeItem
 }
 
-func (*CompNodesDefContext) IsCompNodesDefContext() {}
+func (*CompositeItemContext) IsCompositeItemContext() {}
 
-func NewCompNodesDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodesDefContext {
-	var p = new(CompNodesDefContext)
+func NewCompositeItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompositeItemContext {
+	var p = new(CompositeItemContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compNodesDef
+	p.RuleIndex = nevaParserRULE_compositeItem
 
 	return p
 }
 
-func (s *CompNodesDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *CompositeItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompNodesDefContext) CompNodesDefBody() ICompNodesDefBodyContext {
+func (s *CompositeItemContext) EntityRef() IEntityRefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompNodesDefBodyContext); ok {
+		if _, ok := ctx.(IEntityRefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10562,57 +10993,202 @@ func (s *CompNodesDefContext) CompNodesDefBody() ICompNodesDefBodyContext {
 		return nil
 	}
 
-	return t.(ICompNodesDefBodyContext)
-}
-
-func (s *CompNodesDefContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *CompNodesDefContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IEntityRefContext)
 }
 
-func (s *CompNodesDefContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CompositeItemContext) ConstLit() IConstLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstLitContext)
 }
 
-func (s *CompNodesDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompositeItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompositeItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompNodesDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompositeItemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompNodesDef(s)
+		listenerT.EnterCompositeItem(s)
 	}
 }
 
-func (s *CompNodesDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompositeItemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompNodesDef(s)
+		listenerT.ExitCompositeItem(s)
 	}
 }
 
-func (p *nevaParser) CompNodesDef() (localctx ICompNodesDefContext) {
-	localctx = NewCompNodesDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, nevaParserRULE_compNodesDef)
+func (p *nevaParser) CompositeItem() (localctx ICompositeItemContext) {
+	localctx = NewCompositeItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, nevaParserRULE_compositeItem)
+	p.SetState(858)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 104, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(856)
+			p.EntityRef()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(857)
+			p.ConstLit()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStructLitContext is an interface to support dynamic dispatch.
+type IStructLitContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	StructValueFields() IStructValueFieldsContext
+
+	// IsStructLitContext differentiates from other interfaces.
+	IsStructLitContext()
+}
+
+type StructLitContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStructLitContext() *StructLitContext {
+	var p = new(StructLitContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_structLit
+	return p
+}
+
+func InitEmptyStructLitContext(p *StructLitContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_structLit
+}
+
+func (*StructLitContext) IsStructLitContext() {}
+
+func NewStructLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructLitContext {
+	var p = new(StructLitContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_structLit
+
+	return p
+}
+
+func (s *StructLitContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructLitContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *StructLitContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *StructLitContext) StructValueFields() IStructValueFieldsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructValueFieldsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructValueFieldsContext)
+}
+
+func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterStructLit(s)
+	}
+}
+
+func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitStructLit(s)
+	}
+}
+
+func (p *nevaParser) StructLit() (localctx IStructLitContext) {
+	localctx = NewStructLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, nevaParserRULE_structLit)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(797)
-		p.CompNodesDefBody()
+		p.SetState(860)
+		p.Match(nevaParserT__5)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(799)
+	p.SetState(864)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = _la == nevaParserNEWLINE {
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(798)
+			p.SetState(861)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10620,16 +11196,30 @@ func (p *nevaParser) CompNodesDef() (localctx ICompNodesDefContext) {
 			}
 		}
 
-		p.SetState(801)
+		p.SetState(866)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
+	p.SetState(868)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserIDENTIFIER || _la == nevaParserSTRING {
+		{
+			p.SetState(867)
+			p.StructValueFields()
+		}
+
+	}
 	{
-		p.SetState(803)
-		p.Match(nevaParserT__26)
+		p.SetState(870)
+		p.Match(nevaParserT__6)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -10649,71 +11239,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompNodesDefBodyContext is an interface to support dynamic dispatch.
-type ICompNodesDefBodyContext interface {
+// IStructValueFieldsContext is an interface to support dynamic dispatch.
+type IStructValueFieldsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllCompNodeDef() []ICompNodeDefContext
-	CompNodeDef(i int) ICompNodeDefContext
-	AllCOMMENT() []antlr.TerminalNode
-	COMMENT(i int) antlr.TerminalNode
+	AllStructValueField() []IStructValueFieldContext
+	StructValueField(i int) IStructValueFieldContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
 
-	// IsCompNodesDefBodyContext differentiates from other interfaces.
-	IsCompNodesDefBodyContext()
+	// IsStructValueFieldsContext differentiates from other interfaces.
+	IsStructValueFieldsContext()
 }
 
-type CompNodesDefBodyContext struct {
+type StructValueFieldsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompNodesDefBodyContext() *CompNodesDefBodyContext {
-	var p = new(CompNodesDefBodyContext)
+func NewEmptyStructValueFieldsContext() *StructValueFieldsContext {
+	var p = new(StructValueFieldsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodesDefBody
+	p.RuleIndex = nevaParserRULE_structValueFields
 	return p
 }
 
-func InitEmptyCompNodesDefBodyContext(p *CompNodesDefBodyContext) {
+func InitEmptyStructValueFieldsContext(p *StructValueFieldsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodesDefBody
+	p.RuleIndex = nevaParserRULE_structValueFields
 }
 
-func (*CompNodesDefBodyContext) IsCompNodesDefBodyContext() {}
+func (*StructValueFieldsContext) IsStructValueFieldsContext() {}
 
-func NewCompNodesDefBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodesDefBodyContext {
-	var p = new(CompNodesDefBodyContext)
+func NewStructValueFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructValueFieldsContext {
+	var p = new(StructValueFieldsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compNodesDefBody
+	p.RuleIndex = nevaParserRULE_structValueFields
 
 	return p
 }
 
-func (s *CompNodesDefBodyContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructValueFieldsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompNodesDefBodyContext) AllCompNodeDef() []ICompNodeDefContext {
+func (s *StructValueFieldsContext) AllStructValueField() []IStructValueFieldContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ICompNodeDefContext); ok {
+		if _, ok := ctx.(IStructValueFieldContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ICompNodeDefContext, len)
+	tst := make([]IStructValueFieldContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ICompNodeDefContext); ok {
-			tst[i] = t.(ICompNodeDefContext)
+		if t, ok := ctx.(IStructValueFieldContext); ok {
+			tst[i] = t.(IStructValueFieldContext)
 			i++
 		}
 	}
@@ -10721,11 +11309,11 @@ func (s *CompNodesDefBodyContext) AllCompNodeDef() []ICompNodeDefContext {
 	return tst
 }
 
-func (s *CompNodesDefBodyContext) CompNodeDef(i int) ICompNodeDefContext {
+func (s *StructValueFieldsContext) StructValueField(i int) IStructValueFieldContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompNodeDefContext); ok {
+		if _, ok := ctx.(IStructValueFieldContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -10738,139 +11326,93 @@ func (s *CompNodesDefBodyContext) CompNodeDef(i int) ICompNodeDefContext {
 		return nil
 	}
 
-	return t.(ICompNodeDefContext)
-}
-
-func (s *CompNodesDefBodyContext) AllCOMMENT() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserCOMMENT)
-}
-
-func (s *CompNodesDefBodyContext) COMMENT(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserCOMMENT, i)
+	return t.(IStructValueFieldContext)
 }
 
-func (s *CompNodesDefBodyContext) AllNEWLINE() []antlr.TerminalNode {
+func (s *StructValueFieldsContext) AllNEWLINE() []antlr.TerminalNode {
 	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *CompNodesDefBodyContext) NEWLINE(i int) antlr.TerminalNode {
+func (s *StructValueFieldsContext) NEWLINE(i int) antlr.TerminalNode {
 	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *CompNodesDefBodyContext) GetRuleContext() antlr.RuleContext {
+func (s *StructValueFieldsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompNodesDefBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructValueFieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompNodesDefBodyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructValueFieldsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompNodesDefBody(s)
+		listenerT.EnterStructValueFields(s)
 	}
 }
 
-func (s *CompNodesDefBodyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructValueFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompNodesDefBody(s)
+		listenerT.ExitStructValueFields(s)
 	}
 }
 
-func (p *nevaParser) CompNodesDefBody() (localctx ICompNodesDefBodyContext) {
-	localctx = NewCompNodesDefBodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, nevaParserRULE_compNodesDefBody)
+func (p *nevaParser) StructValueFields() (localctx IStructValueFieldsContext) {
+	localctx = NewStructValueFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, nevaParserRULE_structValueFields)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(818)
+	{
+		p.SetState(872)
+		p.StructValueField()
+	}
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&5629499534213122) != 0) {
-		p.SetState(810)
+	for _la == nevaParserT__2 {
+		{
+			p.SetState(873)
+			p.Match(nevaParserT__2)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(877)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-		switch p.GetTokenStream().LA(1) {
-		case nevaParserT__0, nevaParserIDENTIFIER:
-			{
-				p.SetState(805)
-				p.CompNodeDef()
-			}
-			p.SetState(807)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-
-			if _la == nevaParserT__2 {
-				{
-					p.SetState(806)
-					p.Match(nevaParserT__2)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			}
-
-		case nevaParserCOMMENT:
+		for _la == nevaParserNEWLINE {
 			{
-				p.SetState(809)
-				p.Match(nevaParserCOMMENT)
+				p.SetState(874)
+				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
 
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
-		}
-		p.SetState(815)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(812)
-					p.Match(nevaParserNEWLINE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			}
-			p.SetState(817)
+			p.SetState(879)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext())
-			if p.HasError() {
-				goto errorExit
-			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(880)
+			p.StructValueField()
 		}
 
-		p.SetState(820)
+		p.SetState(885)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10891,58 +11433,60 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompNodeDefContext is an interface to support dynamic dispatch.
-type ICompNodeDefContext interface {
+// IStructValueFieldContext is an interface to support dynamic dispatch.
+type IStructValueFieldContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NodeInst() INodeInstContext
-	CompilerDirectives() ICompilerDirectivesContext
+	CompositeItem() ICompositeItemContext
 	IDENTIFIER() antlr.TerminalNode
+	STRING() antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsCompNodeDefContext differentiates from other interfaces.
-	IsCompNodeDefContext()
+	// IsStructValueFieldContext differentiates from other interfaces.
+	IsStructValueFieldContext()
 }
 
-type CompNodeDefContext struct {
+type StructValueFieldContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompNodeDefContext() *CompNodeDefContext {
-	var p = new(CompNodeDefContext)
+func NewEmptyStructValueFieldContext() *StructValueFieldContext {
+	var p = new(StructValueFieldContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodeDef
+	p.RuleIndex = nevaParserRULE_structValueField
 	return p
 }
 
-func InitEmptyCompNodeDefContext(p *CompNodeDefContext) {
+func InitEmptyStructValueFieldContext(p *StructValueFieldContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_compNodeDef
+	p.RuleIndex = nevaParserRULE_structValueField
 }
 
-func (*CompNodeDefContext) IsCompNodeDefContext() {}
+func (*StructValueFieldContext) IsStructValueFieldContext() {}
 
-func NewCompNodeDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodeDefContext {
-	var p = new(CompNodeDefContext)
+func NewStructValueFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructValueFieldContext {
+	var p = new(StructValueFieldContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_compNodeDef
+	p.RuleIndex = nevaParserRULE_structValueField
 
 	return p
 }
 
-func (s *CompNodeDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructValueFieldContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompNodeDefContext) NodeInst() INodeInstContext {
+func (s *StructValueFieldContext) CompositeItem() ICompositeItemContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INodeInstContext); ok {
+		if _, ok := ctx.(ICompositeItemContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10952,88 +11496,97 @@ func (s *CompNodeDefContext) NodeInst() INodeInstContext {
 		return nil
 	}
 
-	return t.(INodeInstContext)
+	return t.(ICompositeItemContext)
 }
 
-func (s *CompNodeDefContext) CompilerDirectives() ICompilerDirectivesContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompilerDirectivesContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *StructValueFieldContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *StructValueFieldContext) STRING() antlr.TerminalNode {
+	return s.GetToken(nevaParserSTRING, 0)
+}
 
-	return t.(ICompilerDirectivesContext)
+func (s *StructValueFieldContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *CompNodeDefContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *StructValueFieldContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *CompNodeDefContext) GetRuleContext() antlr.RuleContext {
+func (s *StructValueFieldContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompNodeDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructValueFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompNodeDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructValueFieldContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterCompNodeDef(s)
+		listenerT.EnterStructValueField(s)
 	}
 }
 
-func (s *CompNodeDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructValueFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitCompNodeDef(s)
+		listenerT.ExitStructValueField(s)
 	}
 }
 
-func (p *nevaParser) CompNodeDef() (localctx ICompNodeDefContext) {
-	localctx = NewCompNodeDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, nevaParserRULE_compNodeDef)
+func (p *nevaParser) StructValueField() (localctx IStructValueFieldContext) {
+	localctx = NewStructValueFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, nevaParserRULE_structValueField)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(823)
+	{
+		p.SetState(886)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == nevaParserIDENTIFIER || _la == nevaParserSTRING) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(887)
+		p.Match(nevaParserT__7)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(888)
+		p.CompositeItem()
+	}
+	p.SetState(892)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserT__0 {
-		{
-			p.SetState(822)
-			p.CompilerDirectives()
-		}
-
-	}
-	p.SetState(826)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 106, p.GetParserRuleContext()) == 1 {
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(825)
-			p.Match(nevaParserIDENTIFIER)
+			p.SetState(889)
+			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	} else if p.HasError() { // JIM
-		goto errorExit
-	}
-	{
-		p.SetState(828)
-		p.NodeInst()
+		p.SetState(894)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -11049,61 +11602,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INodeInstContext is an interface to support dynamic dispatch.
-type INodeInstContext interface {
+// ICompStmtContext is an interface to support dynamic dispatch.
+type ICompStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EntityRef() IEntityRefContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	TypeArgs() ITypeArgsContext
-	NodeDIArgs() INodeDIArgsContext
-	ErrGuard() IErrGuardContext
+	CompDef() ICompDefContext
+	CompilerDirectives() ICompilerDirectivesContext
+	PUB_KW() antlr.TerminalNode
 
-	// IsNodeInstContext differentiates from other interfaces.
-	IsNodeInstContext()
+	// IsCompStmtContext differentiates from other interfaces.
+	IsCompStmtContext()
 }
 
-type NodeInstContext struct {
+type CompStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNodeInstContext() *NodeInstContext {
-	var p = new(NodeInstContext)
+func NewEmptyCompStmtContext() *CompStmtContext {
+	var p = new(CompStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nodeInst
+	p.RuleIndex = nevaParserRULE_compStmt
 	return p
 }
 
-func InitEmptyNodeInstContext(p *NodeInstContext) {
+func InitEmptyCompStmtContext(p *CompStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nodeInst
+	p.RuleIndex = nevaParserRULE_compStmt
 }
 
-func (*NodeInstContext) IsNodeInstContext() {}
+func (*CompStmtContext) IsCompStmtContext() {}
 
-func NewNodeInstContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeInstContext {
-	var p = new(NodeInstContext)
+func NewCompStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompStmtContext {
+	var p = new(CompStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_nodeInst
+	p.RuleIndex = nevaParserRULE_compStmt
 
 	return p
 }
 
-func (s *NodeInstContext) GetParser() antlr.Parser { return s.parser }
+func (s *CompStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NodeInstContext) EntityRef() IEntityRefContext {
+func (s *CompStmtContext) CompDef() ICompDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEntityRefContext); ok {
+		if _, ok := ctx.(ICompDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11113,21 +11663,13 @@ func (s *NodeInstContext) EntityRef() IEntityRefContext {
 		return nil
 	}
 
-	return t.(IEntityRefContext)
-}
-
-func (s *NodeInstContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *NodeInstContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(ICompDefContext)
 }
 
-func (s *NodeInstContext) TypeArgs() ITypeArgsContext {
+func (s *CompStmtContext) CompilerDirectives() ICompilerDirectivesContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeArgsContext); ok {
+		if _, ok := ctx.(ICompilerDirectivesContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11137,13 +11679,150 @@ func (s *NodeInstContext) TypeArgs() ITypeArgsContext {
 		return nil
 	}
 
-	return t.(ITypeArgsContext)
+	return t.(ICompilerDirectivesContext)
 }
 
-func (s *NodeInstContext) NodeDIArgs() INodeDIArgsContext {
+func (s *CompStmtContext) PUB_KW() antlr.TerminalNode {
+	return s.GetToken(nevaParserPUB_KW, 0)
+}
+
+func (s *CompStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterCompStmt(s)
+	}
+}
+
+func (s *CompStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitCompStmt(s)
+	}
+}
+
+func (p *nevaParser) CompStmt() (localctx ICompStmtContext) {
+	localctx = NewCompStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, nevaParserRULE_compStmt)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(896)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserT__0 {
+		{
+			p.SetState(895)
+			p.CompilerDirectives()
+		}
+
+	}
+	p.SetState(899)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserPUB_KW {
+		{
+			p.SetState(898)
+			p.Match(nevaParserPUB_KW)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(901)
+		p.Match(nevaParserT__27)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(902)
+		p.CompDef()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICompDefContext is an interface to support dynamic dispatch.
+type ICompDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	InterfaceDef() IInterfaceDefContext
+	CompBody() ICompBodyContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsCompDefContext differentiates from other interfaces.
+	IsCompDefContext()
+}
+
+type CompDefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompDefContext() *CompDefContext {
+	var p = new(CompDefContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_compDef
+	return p
+}
+
+func InitEmptyCompDefContext(p *CompDefContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_compDef
+}
+
+func (*CompDefContext) IsCompDefContext() {}
+
+func NewCompDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompDefContext {
+	var p = new(CompDefContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_compDef
+
+	return p
+}
+
+func (s *CompDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompDefContext) InterfaceDef() IInterfaceDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INodeDIArgsContext); ok {
+		if _, ok := ctx.(IInterfaceDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11153,13 +11832,13 @@ func (s *NodeInstContext) NodeDIArgs() INodeDIArgsContext {
 		return nil
 	}
 
-	return t.(INodeDIArgsContext)
+	return t.(IInterfaceDefContext)
 }
 
-func (s *NodeInstContext) ErrGuard() IErrGuardContext {
+func (s *CompDefContext) CompBody() ICompBodyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IErrGuardContext); ok {
+		if _, ok := ctx.(ICompBodyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11169,99 +11848,76 @@ func (s *NodeInstContext) ErrGuard() IErrGuardContext {
 		return nil
 	}
 
-	return t.(IErrGuardContext)
+	return t.(ICompBodyContext)
 }
 
-func (s *NodeInstContext) GetRuleContext() antlr.RuleContext {
+func (s *CompDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *CompDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *CompDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NodeInstContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NodeInstContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterNodeInst(s)
+		listenerT.EnterCompDef(s)
 	}
 }
 
-func (s *NodeInstContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitNodeInst(s)
+		listenerT.ExitCompDef(s)
 	}
 }
 
-func (p *nevaParser) NodeInst() (localctx INodeInstContext) {
-	localctx = NewNodeInstContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, nevaParserRULE_nodeInst)
+func (p *nevaParser) CompDef() (localctx ICompDefContext) {
+	localctx = NewCompDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, nevaParserRULE_compDef)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(830)
-		p.EntityRef()
-	}
-	p.SetState(834)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 107, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(831)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
-		p.SetState(836)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 107, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
+		p.SetState(904)
+		p.InterfaceDef()
 	}
-	p.SetState(838)
+	p.SetState(906)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserT__12 {
+	if _la == nevaParserT__5 {
 		{
-			p.SetState(837)
-			p.TypeArgs()
+			p.SetState(905)
+			p.CompBody()
 		}
 
 	}
-	p.SetState(843)
+	p.SetState(911)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 113, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(840)
+				p.SetState(908)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11270,44 +11926,16 @@ func (p *nevaParser) NodeInst() (localctx INodeInstContext) {
 			}
 
 		}
-		p.SetState(845)
+		p.SetState(913)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 113, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(847)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserT__5 {
-		{
-			p.SetState(846)
-			p.NodeDIArgs()
-		}
-
-	}
-	p.SetState(850)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == nevaParserT__27 {
-		{
-			p.SetState(849)
-			p.ErrGuard()
-		}
-
-	}
 
 errorExit:
 	if p.HasError() {
@@ -11322,145 +11950,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IErrGuardContext is an interface to support dynamic dispatch.
-type IErrGuardContext interface {
+// ICompBodyContext is an interface to support dynamic dispatch.
+type ICompBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsErrGuardContext differentiates from other interfaces.
-	IsErrGuardContext()
+
+	// Getter signatures
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	AllCOMMENT() []antlr.TerminalNode
+	COMMENT(i int) antlr.TerminalNode
+	CompNodesDef() ICompNodesDefContext
+	ConnDefList() IConnDefListContext
+
+	// IsCompBodyContext differentiates from other interfaces.
+	IsCompBodyContext()
 }
 
-type ErrGuardContext struct {
+type CompBodyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyErrGuardContext() *ErrGuardContext {
-	var p = new(ErrGuardContext)
+func NewEmptyCompBodyContext() *CompBodyContext {
+	var p = new(CompBodyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_errGuard
+	p.RuleIndex = nevaParserRULE_compBody
 	return p
 }
 
-func InitEmptyErrGuardContext(p *ErrGuardContext) {
+func InitEmptyCompBodyContext(p *CompBodyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_errGuard
+	p.RuleIndex = nevaParserRULE_compBody
 }
 
-func (*ErrGuardContext) IsErrGuardContext() {}
+func (*CompBodyContext) IsCompBodyContext() {}
 
-func NewErrGuardContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ErrGuardContext {
-	var p = new(ErrGuardContext)
+func NewCompBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompBodyContext {
+	var p = new(CompBodyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_errGuard
+	p.RuleIndex = nevaParserRULE_compBody
 
 	return p
 }
 
-func (s *ErrGuardContext) GetParser() antlr.Parser { return s.parser }
-func (s *ErrGuardContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *CompBodyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompBodyContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
 }
 
-func (s *ErrGuardContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *CompBodyContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *ErrGuardContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterErrGuard(s)
-	}
+func (s *CompBodyContext) AllCOMMENT() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserCOMMENT)
 }
 
-func (s *ErrGuardContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitErrGuard(s)
-	}
+func (s *CompBodyContext) COMMENT(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserCOMMENT, i)
 }
 
-func (p *nevaParser) ErrGuard() (localctx IErrGuardContext) {
-	localctx = NewErrGuardContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, nevaParserRULE_errGuard)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(852)
-		p.Match(nevaParserT__27)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *CompBodyContext) CompNodesDef() ICompNodesDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompNodesDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// INodeDIArgsContext is an interface to support dynamic dispatch.
-type INodeDIArgsContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	CompNodesDefBody() ICompNodesDefBodyContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-
-	// IsNodeDIArgsContext differentiates from other interfaces.
-	IsNodeDIArgsContext()
-}
-
-type NodeDIArgsContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyNodeDIArgsContext() *NodeDIArgsContext {
-	var p = new(NodeDIArgsContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nodeDIArgs
-	return p
-}
-
-func InitEmptyNodeDIArgsContext(p *NodeDIArgsContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_nodeDIArgs
-}
-
-func (*NodeDIArgsContext) IsNodeDIArgsContext() {}
-
-func NewNodeDIArgsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeDIArgsContext {
-	var p = new(NodeDIArgsContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_nodeDIArgs
 
-	return p
+	return t.(ICompNodesDefContext)
 }
 
-func (s *NodeDIArgsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *NodeDIArgsContext) CompNodesDefBody() ICompNodesDefBodyContext {
+func (s *CompBodyContext) ConnDefList() IConnDefListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompNodesDefBodyContext); ok {
+		if _, ok := ctx.(IConnDefListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11470,52 +12046,46 @@ func (s *NodeDIArgsContext) CompNodesDefBody() ICompNodesDefBodyContext {
 		return nil
 	}
 
-	return t.(ICompNodesDefBodyContext)
-}
-
-func (s *NodeDIArgsContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *NodeDIArgsContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IConnDefListContext)
 }
 
-func (s *NodeDIArgsContext) GetRuleContext() antlr.RuleContext {
+func (s *CompBodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NodeDIArgsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NodeDIArgsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompBodyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterNodeDIArgs(s)
+		listenerT.EnterCompBody(s)
 	}
 }
 
-func (s *NodeDIArgsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompBodyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitNodeDIArgs(s)
+		listenerT.ExitCompBody(s)
 	}
 }
 
-func (p *nevaParser) NodeDIArgs() (localctx INodeDIArgsContext) {
-	localctx = NewNodeDIArgsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, nevaParserRULE_nodeDIArgs)
+func (p *nevaParser) CompBody() (localctx ICompBodyContext) {
+	localctx = NewCompBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, nevaParserRULE_compBody)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(854)
+		p.SetState(914)
 		p.Match(nevaParserT__5)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(858)
+	p.SetState(918)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11524,7 +12094,7 @@ func (p *nevaParser) NodeDIArgs() (localctx INodeDIArgsContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(855)
+			p.SetState(915)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11532,214 +12102,124 @@ func (p *nevaParser) NodeDIArgs() (localctx INodeDIArgsContext) {
 			}
 		}
 
-		p.SetState(860)
+		p.SetState(920)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(861)
-		p.CompNodesDefBody()
-	}
-	{
-		p.SetState(862)
-		p.Match(nevaParserT__6)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	p.SetState(930)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-
-errorExit:
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
 	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+		goto errorExit
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(921)
+				p.Match(nevaParserCOMMENT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(925)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 
-// IConnDefListContext is an interface to support dynamic dispatch.
-type IConnDefListContext interface {
-	antlr.ParserRuleContext
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(922)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+				p.SetState(927)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
 
-	// Getter signatures
-	AllConnDef() []IConnDefContext
-	ConnDef(i int) IConnDefContext
-	AllCOMMENT() []antlr.TerminalNode
-	COMMENT(i int) antlr.TerminalNode
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+		}
+		p.SetState(932)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(940)
+	p.GetErrorHandler().Sync(p)
 
-	// IsConnDefListContext differentiates from other interfaces.
-	IsConnDefListContext()
-}
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 118, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(933)
+			p.CompNodesDef()
+		}
+		p.SetState(937)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-type ConnDefListContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(934)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func NewEmptyConnDefListContext() *ConnDefListContext {
-	var p = new(ConnDefListContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_connDefList
-	return p
-}
+			p.SetState(939)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
 
-func InitEmptyConnDefListContext(p *ConnDefListContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_connDefList
-}
-
-func (*ConnDefListContext) IsConnDefListContext() {}
-
-func NewConnDefListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConnDefListContext {
-	var p = new(ConnDefListContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_connDefList
-
-	return p
-}
-
-func (s *ConnDefListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConnDefListContext) AllConnDef() []IConnDefContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IConnDefContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IConnDefContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IConnDefContext); ok {
-			tst[i] = t.(IConnDefContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *ConnDefListContext) ConnDef(i int) IConnDefContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConnDefContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConnDefContext)
-}
-
-func (s *ConnDefListContext) AllCOMMENT() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserCOMMENT)
-}
-
-func (s *ConnDefListContext) COMMENT(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserCOMMENT, i)
-}
-
-func (s *ConnDefListContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *ConnDefListContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
-}
-
-func (s *ConnDefListContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ConnDefListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *ConnDefListContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterConnDefList(s)
-	}
-}
-
-func (s *ConnDefListContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitConnDefList(s)
-	}
-}
-
-func (p *nevaParser) ConnDefList() (localctx IConnDefListContext) {
-	localctx = NewConnDefListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, nevaParserRULE_connDefList)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(866)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case nevaParserT__1, nevaParserT__7, nevaParserT__10, nevaParserT__18, nevaParserT__22, nevaParserT__23, nevaParserT__30, nevaParserT__31, nevaParserT__32, nevaParserT__45, nevaParserIDENTIFIER, nevaParserINT, nevaParserMINUS, nevaParserFLOAT, nevaParserSTRING:
-		{
-			p.SetState(864)
-			p.ConnDef()
-		}
-
-	case nevaParserCOMMENT:
-		{
-			p.SetState(865)
-			p.Match(nevaParserCOMMENT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	} else if p.HasError() { // JIM
 		goto errorExit
 	}
-	p.SetState(880)
+	p.SetState(951)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 120, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(871)
+			{
+				p.SetState(942)
+				p.Match(nevaParserCOMMENT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(946)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11748,7 +12228,7 @@ func (p *nevaParser) ConnDefList() (localctx IConnDefListContext) {
 
 			for _la == nevaParserNEWLINE {
 				{
-					p.SetState(868)
+					p.SetState(943)
 					p.Match(nevaParserNEWLINE)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -11756,49 +12236,114 @@ func (p *nevaParser) ConnDefList() (localctx IConnDefListContext) {
 					}
 				}
 
-				p.SetState(873)
+				p.SetState(948)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
 				}
 				_la = p.GetTokenStream().LA(1)
 			}
-			p.SetState(876)
+
+		}
+		p.SetState(953)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 120, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(961)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 122, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(954)
+			p.ConnDefList()
+		}
+		p.SetState(958)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(955)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(960)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
+			_la = p.GetTokenStream().LA(1)
+		}
 
-			switch p.GetTokenStream().LA(1) {
-			case nevaParserT__1, nevaParserT__7, nevaParserT__10, nevaParserT__18, nevaParserT__22, nevaParserT__23, nevaParserT__30, nevaParserT__31, nevaParserT__32, nevaParserT__45, nevaParserIDENTIFIER, nevaParserINT, nevaParserMINUS, nevaParserFLOAT, nevaParserSTRING:
-				{
-					p.SetState(874)
-					p.ConnDef()
-				}
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	p.SetState(972)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-			case nevaParserCOMMENT:
-				{
-					p.SetState(875)
-					p.Match(nevaParserCOMMENT)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
+	for _la == nevaParserCOMMENT {
+		{
+			p.SetState(963)
+			p.Match(nevaParserCOMMENT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(967)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(964)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
 				}
+			}
 
-			default:
-				p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetState(969)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
 				goto errorExit
 			}
-
+			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(882)
+
+		p.SetState(974)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(975)
+		p.Match(nevaParserT__6)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -11816,57 +12361,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConnDefContext is an interface to support dynamic dispatch.
-type IConnDefContext interface {
+// ICompNodesDefContext is an interface to support dynamic dispatch.
+type ICompNodesDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NormConnDef() INormConnDefContext
-	ArrBypassConnDef() IArrBypassConnDefContext
+	CompNodesDefBody() ICompNodesDefBodyContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsConnDefContext differentiates from other interfaces.
-	IsConnDefContext()
+	// IsCompNodesDefContext differentiates from other interfaces.
+	IsCompNodesDefContext()
 }
 
-type ConnDefContext struct {
+type CompNodesDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConnDefContext() *ConnDefContext {
-	var p = new(ConnDefContext)
+func NewEmptyCompNodesDefContext() *CompNodesDefContext {
+	var p = new(CompNodesDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_connDef
+	p.RuleIndex = nevaParserRULE_compNodesDef
 	return p
 }
 
-func InitEmptyConnDefContext(p *ConnDefContext) {
+func InitEmptyCompNodesDefContext(p *CompNodesDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_connDef
+	p.RuleIndex = nevaParserRULE_compNodesDef
 }
 
-func (*ConnDefContext) IsConnDefContext() {}
+func (*CompNodesDefContext) IsCompNodesDefContext() {}
 
-func NewConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConnDefContext {
-	var p = new(ConnDefContext)
+func NewCompNodesDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodesDefContext {
+	var p = new(CompNodesDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_connDef
+	p.RuleIndex = nevaParserRULE_compNodesDef
 
 	return p
 }
 
-func (s *ConnDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *CompNodesDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConnDefContext) NormConnDef() INormConnDefContext {
+func (s *CompNodesDefContext) CompNodesDefBody() ICompNodesDefBodyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INormConnDefContext); ok {
+		if _, ok := ctx.(ICompNodesDefBodyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11876,71 +12422,78 @@ func (s *ConnDefContext) NormConnDef() INormConnDefContext {
 		return nil
 	}
 
-	return t.(INormConnDefContext)
+	return t.(ICompNodesDefBodyContext)
 }
 
-func (s *ConnDefContext) ArrBypassConnDef() IArrBypassConnDefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArrBypassConnDefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *CompNodesDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
 
-	return t.(IArrBypassConnDefContext)
+func (s *CompNodesDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *ConnDefContext) GetRuleContext() antlr.RuleContext {
+func (s *CompNodesDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompNodesDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompNodesDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterConnDef(s)
+		listenerT.EnterCompNodesDef(s)
 	}
 }
 
-func (s *ConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompNodesDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitConnDef(s)
+		listenerT.ExitCompNodesDef(s)
 	}
 }
 
-func (p *nevaParser) ConnDef() (localctx IConnDefContext) {
-	localctx = NewConnDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, nevaParserRULE_connDef)
-	p.SetState(885)
+func (p *nevaParser) CompNodesDef() (localctx ICompNodesDefContext) {
+	localctx = NewCompNodesDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, nevaParserRULE_compNodesDef)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(977)
+		p.CompNodesDefBody()
+	}
+	p.SetState(979)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 117, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for ok := true; ok; ok = _la == nevaParserNEWLINE {
 		{
-			p.SetState(883)
-			p.NormConnDef()
+			p.SetState(978)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(884)
-			p.ArrBypassConnDef()
+		p.SetState(981)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(983)
+		p.Match(nevaParserT__28)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -11956,75 +12509,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INormConnDefContext is an interface to support dynamic dispatch.
-type INormConnDefContext interface {
+// ICompNodesDefBodyContext is an interface to support dynamic dispatch.
+type ICompNodesDefBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SenderSide() ISenderSideContext
-	ReceiverSide() IReceiverSideContext
+	AllCompNodeDef() []ICompNodeDefContext
+	CompNodeDef(i int) ICompNodeDefContext
+	AllCOMMENT() []antlr.TerminalNode
+	COMMENT(i int) antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsNormConnDefContext differentiates from other interfaces.
-	IsNormConnDefContext()
+	// IsCompNodesDefBodyContext differentiates from other interfaces.
+	IsCompNodesDefBodyContext()
 }
 
-type NormConnDefContext struct {
+type CompNodesDefBodyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNormConnDefContext() *NormConnDefContext {
-	var p = new(NormConnDefContext)
+func NewEmptyCompNodesDefBodyContext() *CompNodesDefBodyContext {
+	var p = new(CompNodesDefBodyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_normConnDef
+	p.RuleIndex = nevaParserRULE_compNodesDefBody
 	return p
 }
 
-func InitEmptyNormConnDefContext(p *NormConnDefContext) {
+func InitEmptyCompNodesDefBodyContext(p *CompNodesDefBodyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_normConnDef
+	p.RuleIndex = nevaParserRULE_compNodesDefBody
 }
 
-func (*NormConnDefContext) IsNormConnDefContext() {}
+func (*CompNodesDefBodyContext) IsCompNodesDefBodyContext() {}
 
-func NewNormConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NormConnDefContext {
-	var p = new(NormConnDefContext)
+func NewCompNodesDefBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodesDefBodyContext {
+	var p = new(CompNodesDefBodyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_normConnDef
+	p.RuleIndex = nevaParserRULE_compNodesDefBody
 
 	return p
 }
 
-func (s *NormConnDefContext) GetParser() antlr.Parser { return s.parser }
+func (s *CompNodesDefBodyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NormConnDefContext) SenderSide() ISenderSideContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISenderSideContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *CompNodesDefBodyContext) AllCompNodeDef() []ICompNodeDefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICompNodeDefContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]ICompNodeDefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICompNodeDefContext); ok {
+			tst[i] = t.(ICompNodeDefContext)
+			i++
+		}
 	}
 
-	return t.(ISenderSideContext)
+	return tst
 }
 
-func (s *NormConnDefContext) ReceiverSide() IReceiverSideContext {
+func (s *CompNodesDefBodyContext) CompNodeDef(i int) ICompNodeDefContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IReceiverSideContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ICompNodeDefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -12032,114 +12598,212 @@ func (s *NormConnDefContext) ReceiverSide() IReceiverSideContext {
 		return nil
 	}
 
-	return t.(IReceiverSideContext)
+	return t.(ICompNodeDefContext)
 }
 
-func (s *NormConnDefContext) GetRuleContext() antlr.RuleContext {
+func (s *CompNodesDefBodyContext) AllCOMMENT() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserCOMMENT)
+}
+
+func (s *CompNodesDefBodyContext) COMMENT(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserCOMMENT, i)
+}
+
+func (s *CompNodesDefBodyContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *CompNodesDefBodyContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *CompNodesDefBodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NormConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompNodesDefBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NormConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompNodesDefBodyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterNormConnDef(s)
+		listenerT.EnterCompNodesDefBody(s)
 	}
 }
 
-func (s *NormConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompNodesDefBodyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitNormConnDef(s)
+		listenerT.ExitCompNodesDefBody(s)
 	}
 }
 
-func (p *nevaParser) NormConnDef() (localctx INormConnDefContext) {
-	localctx = NewNormConnDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, nevaParserRULE_normConnDef)
+func (p *nevaParser) CompNodesDefBody() (localctx ICompNodesDefBodyContext) {
+	localctx = NewCompNodesDefBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, nevaParserRULE_compNodesDefBody)
+	var _la int
+
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(887)
-		p.SenderSide()
+	p.SetState(998)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(888)
-		p.Match(nevaParserT__28)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&45035996542140418) != 0) {
+		p.SetState(990)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	{
-		p.SetState(889)
-		p.ReceiverSide()
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// ISenderSideContext is an interface to support dynamic dispatch.
-type ISenderSideContext interface {
-	antlr.ParserRuleContext
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+		switch p.GetTokenStream().LA(1) {
+		case nevaParserT__0, nevaParserT__27, nevaParserIDENTIFIER:
+			{
+				p.SetState(985)
+				p.CompNodeDef()
+			}
+			p.SetState(987)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 
-	// Getter signatures
-	SingleSenderSide() ISingleSenderSideContext
-	MultipleSenderSide() IMultipleSenderSideContext
+			if _la == nevaParserT__2 {
+				{
+					p.SetState(986)
+					p.Match(nevaParserT__2)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-	// IsSenderSideContext differentiates from other interfaces.
-	IsSenderSideContext()
-}
+			}
 
-type SenderSideContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+		case nevaParserCOMMENT:
+			{
+				p.SetState(989)
+				p.Match(nevaParserCOMMENT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func NewEmptySenderSideContext() *SenderSideContext {
-	var p = new(SenderSideContext)
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+		p.SetState(995)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 128, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(992)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			p.SetState(997)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 128, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+
+		p.SetState(1000)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICompNodeDefContext is an interface to support dynamic dispatch.
+type ICompNodeDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NodeInst() INodeInstContext
+	AnonCompDef() IAnonCompDefContext
+	CompilerDirectives() ICompilerDirectivesContext
+	IDENTIFIER() antlr.TerminalNode
+
+	// IsCompNodeDefContext differentiates from other interfaces.
+	IsCompNodeDefContext()
+}
+
+type CompNodeDefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompNodeDefContext() *CompNodeDefContext {
+	var p = new(CompNodeDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_senderSide
+	p.RuleIndex = nevaParserRULE_compNodeDef
 	return p
 }
 
-func InitEmptySenderSideContext(p *SenderSideContext) {
+func InitEmptyCompNodeDefContext(p *CompNodeDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_senderSide
+	p.RuleIndex = nevaParserRULE_compNodeDef
 }
 
-func (*SenderSideContext) IsSenderSideContext() {}
+func (*CompNodeDefContext) IsCompNodeDefContext() {}
 
-func NewSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SenderSideContext {
-	var p = new(SenderSideContext)
+func NewCompNodeDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompNodeDefContext {
+	var p = new(CompNodeDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_senderSide
+	p.RuleIndex = nevaParserRULE_compNodeDef
 
 	return p
 }
 
-func (s *SenderSideContext) GetParser() antlr.Parser { return s.parser }
+func (s *CompNodeDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SenderSideContext) SingleSenderSide() ISingleSenderSideContext {
+func (s *CompNodeDefContext) NodeInst() INodeInstContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
+		if _, ok := ctx.(INodeInstContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12149,13 +12813,13 @@ func (s *SenderSideContext) SingleSenderSide() ISingleSenderSideContext {
 		return nil
 	}
 
-	return t.(ISingleSenderSideContext)
+	return t.(INodeInstContext)
 }
 
-func (s *SenderSideContext) MultipleSenderSide() IMultipleSenderSideContext {
+func (s *CompNodeDefContext) AnonCompDef() IAnonCompDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMultipleSenderSideContext); ok {
+		if _, ok := ctx.(IAnonCompDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12165,51 +12829,102 @@ func (s *SenderSideContext) MultipleSenderSide() IMultipleSenderSideContext {
 		return nil
 	}
 
-	return t.(IMultipleSenderSideContext)
+	return t.(IAnonCompDefContext)
 }
 
-func (s *SenderSideContext) GetRuleContext() antlr.RuleContext {
+func (s *CompNodeDefContext) CompilerDirectives() ICompilerDirectivesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompilerDirectivesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompilerDirectivesContext)
+}
+
+func (s *CompNodeDefContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *CompNodeDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CompNodeDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CompNodeDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterSenderSide(s)
+		listenerT.EnterCompNodeDef(s)
 	}
 }
 
-func (s *SenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CompNodeDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitSenderSide(s)
+		listenerT.ExitCompNodeDef(s)
 	}
 }
 
-func (p *nevaParser) SenderSide() (localctx ISenderSideContext) {
-	localctx = NewSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, nevaParserRULE_senderSide)
-	p.SetState(893)
+func (p *nevaParser) CompNodeDef() (localctx ICompNodeDefContext) {
+	localctx = NewCompNodeDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, nevaParserRULE_compNodeDef)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(1003)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserT__0 {
+		{
+			p.SetState(1002)
+			p.CompilerDirectives()
+		}
+
+	}
+	p.SetState(1006)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 131, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(1005)
+			p.Match(nevaParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	p.SetState(1010)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case nevaParserT__1, nevaParserT__7, nevaParserT__10, nevaParserT__22, nevaParserT__23, nevaParserT__30, nevaParserT__31, nevaParserT__32, nevaParserT__45, nevaParserIDENTIFIER, nevaParserINT, nevaParserMINUS, nevaParserFLOAT, nevaParserSTRING:
-		p.EnterOuterAlt(localctx, 1)
+	case nevaParserIDENTIFIER:
 		{
-			p.SetState(891)
-			p.SingleSenderSide()
+			p.SetState(1008)
+			p.NodeInst()
 		}
 
-	case nevaParserT__18:
-		p.EnterOuterAlt(localctx, 2)
+	case nevaParserT__27:
 		{
-			p.SetState(892)
-			p.MultipleSenderSide()
+			p.SetState(1009)
+			p.AnonCompDef()
 		}
 
 	default:
@@ -12230,86 +12945,87 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMultipleSenderSideContext is an interface to support dynamic dispatch.
-type IMultipleSenderSideContext interface {
+// INodeInstContext is an interface to support dynamic dispatch.
+type INodeInstContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllSingleSenderSide() []ISingleSenderSideContext
-	SingleSenderSide(i int) ISingleSenderSideContext
+	EntityRef() IEntityRefContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
+	TypeArgs() ITypeArgsContext
+	NodeDIArgs() INodeDIArgsContext
+	ErrGuard() IErrGuardContext
 
-	// IsMultipleSenderSideContext differentiates from other interfaces.
-	IsMultipleSenderSideContext()
+	// IsNodeInstContext differentiates from other interfaces.
+	IsNodeInstContext()
 }
 
-type MultipleSenderSideContext struct {
+type NodeInstContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMultipleSenderSideContext() *MultipleSenderSideContext {
-	var p = new(MultipleSenderSideContext)
+func NewEmptyNodeInstContext() *NodeInstContext {
+	var p = new(NodeInstContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_multipleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeInst
 	return p
 }
 
-func InitEmptyMultipleSenderSideContext(p *MultipleSenderSideContext) {
+func InitEmptyNodeInstContext(p *NodeInstContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_multipleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeInst
 }
 
-func (*MultipleSenderSideContext) IsMultipleSenderSideContext() {}
+func (*NodeInstContext) IsNodeInstContext() {}
 
-func NewMultipleSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultipleSenderSideContext {
-	var p = new(MultipleSenderSideContext)
+func NewNodeInstContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeInstContext {
+	var p = new(NodeInstContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_multipleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeInst
 
 	return p
 }
 
-func (s *MultipleSenderSideContext) GetParser() antlr.Parser { return s.parser }
+func (s *NodeInstContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MultipleSenderSideContext) AllSingleSenderSide() []ISingleSenderSideContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			len++
+func (s *NodeInstContext) EntityRef() IEntityRefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntityRefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ISingleSenderSideContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISingleSenderSideContext); ok {
-			tst[i] = t.(ISingleSenderSideContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IEntityRefContext)
 }
 
-func (s *MultipleSenderSideContext) SingleSenderSide(i int) ISingleSenderSideContext {
+func (s *NodeInstContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *NodeInstContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *NodeInstContext) TypeArgs() ITypeArgsContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -12317,105 +13033,131 @@ func (s *MultipleSenderSideContext) SingleSenderSide(i int) ISingleSenderSideCon
 		return nil
 	}
 
-	return t.(ISingleSenderSideContext)
-}
-
-func (s *MultipleSenderSideContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
+	return t.(ITypeArgsContext)
 }
 
-func (s *MultipleSenderSideContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+func (s *NodeInstContext) NodeDIArgs() INodeDIArgsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INodeDIArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INodeDIArgsContext)
 }
 
-func (s *MultipleSenderSideContext) GetRuleContext() antlr.RuleContext {
+func (s *NodeInstContext) ErrGuard() IErrGuardContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IErrGuardContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IErrGuardContext)
+}
+
+func (s *NodeInstContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MultipleSenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NodeInstContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MultipleSenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NodeInstContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterMultipleSenderSide(s)
+		listenerT.EnterNodeInst(s)
 	}
 }
 
-func (s *MultipleSenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NodeInstContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitMultipleSenderSide(s)
+		listenerT.ExitNodeInst(s)
 	}
 }
 
-func (p *nevaParser) MultipleSenderSide() (localctx IMultipleSenderSideContext) {
-	localctx = NewMultipleSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, nevaParserRULE_multipleSenderSide)
+func (p *nevaParser) NodeInst() (localctx INodeInstContext) {
+	localctx = NewNodeInstContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, nevaParserRULE_nodeInst)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(895)
-		p.Match(nevaParserT__18)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(1012)
+		p.EntityRef()
 	}
-	p.SetState(899)
+	p.SetState(1016)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(896)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 133, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(1013)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
-		}
 
-		p.SetState(901)
+		}
+		p.SetState(1018)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(902)
-		p.SingleSenderSide()
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 133, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
-	p.SetState(919)
+	p.SetState(1020)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserT__2 {
+	if _la == nevaParserT__12 {
 		{
-			p.SetState(903)
-			p.Match(nevaParserT__2)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(907)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+			p.SetState(1019)
+			p.TypeArgs()
 		}
-		_la = p.GetTokenStream().LA(1)
 
-		for _la == nevaParserNEWLINE {
+	}
+	p.SetState(1025)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 135, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(904)
+				p.SetState(1022)
 				p.Match(nevaParserNEWLINE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12423,56 +13165,44 @@ func (p *nevaParser) MultipleSenderSide() (localctx IMultipleSenderSideContext)
 				}
 			}
 
-			p.SetState(909)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
 		}
-		{
-			p.SetState(910)
-			p.SingleSenderSide()
-		}
-		p.SetState(914)
+		p.SetState(1027)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == nevaParserNEWLINE {
-			{
-				p.SetState(911)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-			p.SetState(916)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
-		p.SetState(921)
-		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 135, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(922)
-		p.Match(nevaParserT__19)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	p.SetState(1029)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserT__5 {
+		{
+			p.SetState(1028)
+			p.NodeDIArgs()
+		}
+
+	}
+	p.SetState(1032)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserT__29 {
+		{
+			p.SetState(1031)
+			p.ErrGuard()
 		}
+
 	}
 
 errorExit:
@@ -12488,134 +13218,79 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArrBypassConnDefContext is an interface to support dynamic dispatch.
-type IArrBypassConnDefContext interface {
+// IErrGuardContext is an interface to support dynamic dispatch.
+type IErrGuardContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllSinglePortAddr() []ISinglePortAddrContext
-	SinglePortAddr(i int) ISinglePortAddrContext
-
-	// IsArrBypassConnDefContext differentiates from other interfaces.
-	IsArrBypassConnDefContext()
+	// IsErrGuardContext differentiates from other interfaces.
+	IsErrGuardContext()
 }
 
-type ArrBypassConnDefContext struct {
+type ErrGuardContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrBypassConnDefContext() *ArrBypassConnDefContext {
-	var p = new(ArrBypassConnDefContext)
+func NewEmptyErrGuardContext() *ErrGuardContext {
+	var p = new(ErrGuardContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_arrBypassConnDef
+	p.RuleIndex = nevaParserRULE_errGuard
 	return p
 }
 
-func InitEmptyArrBypassConnDefContext(p *ArrBypassConnDefContext) {
+func InitEmptyErrGuardContext(p *ErrGuardContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_arrBypassConnDef
+	p.RuleIndex = nevaParserRULE_errGuard
 }
 
-func (*ArrBypassConnDefContext) IsArrBypassConnDefContext() {}
+func (*ErrGuardContext) IsErrGuardContext() {}
 
-func NewArrBypassConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrBypassConnDefContext {
-	var p = new(ArrBypassConnDefContext)
+func NewErrGuardContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ErrGuardContext {
+	var p = new(ErrGuardContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_arrBypassConnDef
+	p.RuleIndex = nevaParserRULE_errGuard
 
 	return p
 }
 
-func (s *ArrBypassConnDefContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ArrBypassConnDefContext) AllSinglePortAddr() []ISinglePortAddrContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISinglePortAddrContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ISinglePortAddrContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISinglePortAddrContext); ok {
-			tst[i] = t.(ISinglePortAddrContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *ArrBypassConnDefContext) SinglePortAddr(i int) ISinglePortAddrContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISinglePortAddrContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISinglePortAddrContext)
-}
-
-func (s *ArrBypassConnDefContext) GetRuleContext() antlr.RuleContext {
+func (s *ErrGuardContext) GetParser() antlr.Parser { return s.parser }
+func (s *ErrGuardContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrBypassConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ErrGuardContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrBypassConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ErrGuardContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterArrBypassConnDef(s)
+		listenerT.EnterErrGuard(s)
 	}
 }
 
-func (s *ArrBypassConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ErrGuardContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitArrBypassConnDef(s)
+		listenerT.ExitErrGuard(s)
 	}
 }
 
-func (p *nevaParser) ArrBypassConnDef() (localctx IArrBypassConnDefContext) {
-	localctx = NewArrBypassConnDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, nevaParserRULE_arrBypassConnDef)
+func (p *nevaParser) ErrGuard() (localctx IErrGuardContext) {
+	localctx = NewErrGuardContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, nevaParserRULE_errGuard)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(924)
-		p.SinglePortAddr()
-	}
-	{
-		p.SetState(925)
+		p.SetState(1034)
 		p.Match(nevaParserT__29)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(926)
-		p.SinglePortAddr()
-	}
 
 errorExit:
 	if p.HasError() {
@@ -12630,63 +13305,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISingleSenderSideContext is an interface to support dynamic dispatch.
-type ISingleSenderSideContext interface {
+// INodeDIArgsContext is an interface to support dynamic dispatch.
+type INodeDIArgsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PortAddr() IPortAddrContext
-	SenderConstRef() ISenderConstRefContext
-	PrimitiveConstLit() IPrimitiveConstLitContext
-	RangeExpr() IRangeExprContext
-	StructSelectors() IStructSelectorsContext
-	UnaryExpr() IUnaryExprContext
-	BinaryExpr() IBinaryExprContext
-	TernaryExpr() ITernaryExprContext
+	CompNodesDefBody() ICompNodesDefBodyContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsSingleSenderSideContext differentiates from other interfaces.
-	IsSingleSenderSideContext()
+	// IsNodeDIArgsContext differentiates from other interfaces.
+	IsNodeDIArgsContext()
 }
 
-type SingleSenderSideContext struct {
+type NodeDIArgsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySingleSenderSideContext() *SingleSenderSideContext {
-	var p = new(SingleSenderSideContext)
+func NewEmptyNodeDIArgsContext() *NodeDIArgsContext {
+	var p = new(NodeDIArgsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeDIArgs
 	return p
 }
 
-func InitEmptySingleSenderSideContext(p *SingleSenderSideContext) {
+func InitEmptyNodeDIArgsContext(p *NodeDIArgsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeDIArgs
 }
 
-func (*SingleSenderSideContext) IsSingleSenderSideContext() {}
+func (*NodeDIArgsContext) IsNodeDIArgsContext() {}
 
-func NewSingleSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleSenderSideContext {
-	var p = new(SingleSenderSideContext)
+func NewNodeDIArgsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeDIArgsContext {
+	var p = new(NodeDIArgsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_singleSenderSide
+	p.RuleIndex = nevaParserRULE_nodeDIArgs
 
 	return p
 }
 
-func (s *SingleSenderSideContext) GetParser() antlr.Parser { return s.parser }
+func (s *NodeDIArgsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SingleSenderSideContext) PortAddr() IPortAddrContext {
+func (s *NodeDIArgsContext) CompNodesDefBody() ICompNodesDefBodyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPortAddrContext); ok {
+		if _, ok := ctx.(ICompNodesDefBodyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12696,77 +13366,155 @@ func (s *SingleSenderSideContext) PortAddr() IPortAddrContext {
 		return nil
 	}
 
-	return t.(IPortAddrContext)
+	return t.(ICompNodesDefBodyContext)
 }
 
-func (s *SingleSenderSideContext) SenderConstRef() ISenderConstRefContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISenderConstRefContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *NodeDIArgsContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *NodeDIArgsContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
 
-	return t.(ISenderConstRefContext)
+func (s *NodeDIArgsContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *SingleSenderSideContext) PrimitiveConstLit() IPrimitiveConstLitContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimitiveConstLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *NodeDIArgsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	if t == nil {
-		return nil
+func (s *NodeDIArgsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterNodeDIArgs(s)
 	}
+}
 
-	return t.(IPrimitiveConstLitContext)
+func (s *NodeDIArgsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitNodeDIArgs(s)
+	}
 }
 
-func (s *SingleSenderSideContext) RangeExpr() IRangeExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRangeExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (p *nevaParser) NodeDIArgs() (localctx INodeDIArgsContext) {
+	localctx = NewNodeDIArgsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, nevaParserRULE_nodeDIArgs)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1036)
+		p.Match(nevaParserT__5)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-	if t == nil {
-		return nil
+	p.SetState(1040)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	return t.(IRangeExprContext)
-}
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(1037)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
 
-func (s *SingleSenderSideContext) StructSelectors() IStructSelectorsContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStructSelectorsContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		p.SetState(1042)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1043)
+		p.CompNodesDefBody()
+	}
+	{
+		p.SetState(1044)
+		p.Match(nevaParserT__6)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
-	if t == nil {
-		return nil
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	return t.(IStructSelectorsContext)
+// IAnonCompDefContext is an interface to support dynamic dispatch.
+type IAnonCompDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	InPortsDef() IInPortsDefContext
+	OutPortsDef() IOutPortsDefContext
+	CompBody() ICompBodyContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+
+	// IsAnonCompDefContext differentiates from other interfaces.
+	IsAnonCompDefContext()
 }
 
-func (s *SingleSenderSideContext) UnaryExpr() IUnaryExprContext {
+type AnonCompDefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAnonCompDefContext() *AnonCompDefContext {
+	var p = new(AnonCompDefContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_anonCompDef
+	return p
+}
+
+func InitEmptyAnonCompDefContext(p *AnonCompDefContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_anonCompDef
+}
+
+func (*AnonCompDefContext) IsAnonCompDefContext() {}
+
+func NewAnonCompDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnonCompDefContext {
+	var p = new(AnonCompDefContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_anonCompDef
+
+	return p
+}
+
+func (s *AnonCompDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AnonCompDefContext) InPortsDef() IInPortsDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExprContext); ok {
+		if _, ok := ctx.(IInPortsDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12776,13 +13524,13 @@ func (s *SingleSenderSideContext) UnaryExpr() IUnaryExprContext {
 		return nil
 	}
 
-	return t.(IUnaryExprContext)
+	return t.(IInPortsDefContext)
 }
 
-func (s *SingleSenderSideContext) BinaryExpr() IBinaryExprContext {
+func (s *AnonCompDefContext) OutPortsDef() IOutPortsDefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBinaryExprContext); ok {
+		if _, ok := ctx.(IOutPortsDefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12792,13 +13540,13 @@ func (s *SingleSenderSideContext) BinaryExpr() IBinaryExprContext {
 		return nil
 	}
 
-	return t.(IBinaryExprContext)
+	return t.(IOutPortsDefContext)
 }
 
-func (s *SingleSenderSideContext) TernaryExpr() ITernaryExprContext {
+func (s *AnonCompDefContext) CompBody() ICompBodyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITernaryExprContext); ok {
+		if _, ok := ctx.(ICompBodyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12808,97 +13556,86 @@ func (s *SingleSenderSideContext) TernaryExpr() ITernaryExprContext {
 		return nil
 	}
 
-	return t.(ITernaryExprContext)
+	return t.(ICompBodyContext)
 }
 
-func (s *SingleSenderSideContext) GetRuleContext() antlr.RuleContext {
+func (s *AnonCompDefContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *AnonCompDefContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *AnonCompDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SingleSenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AnonCompDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SingleSenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AnonCompDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterSingleSenderSide(s)
+		listenerT.EnterAnonCompDef(s)
 	}
 }
 
-func (s *SingleSenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AnonCompDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitSingleSenderSide(s)
+		listenerT.ExitAnonCompDef(s)
 	}
 }
 
-func (p *nevaParser) SingleSenderSide() (localctx ISingleSenderSideContext) {
-	localctx = NewSingleSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, nevaParserRULE_singleSenderSide)
-	p.SetState(936)
+func (p *nevaParser) AnonCompDef() (localctx IAnonCompDefContext) {
+	localctx = NewAnonCompDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, nevaParserRULE_anonCompDef)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1046)
+		p.Match(nevaParserT__27)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1047)
+		p.InPortsDef()
+	}
+	{
+		p.SetState(1048)
+		p.OutPortsDef()
+	}
+	p.SetState(1052)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 123, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(928)
-			p.PortAddr()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(929)
-			p.SenderConstRef()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(930)
-			p.PrimitiveConstLit()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(931)
-			p.RangeExpr()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(932)
-			p.StructSelectors()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(933)
-			p.UnaryExpr()
-		}
-
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(934)
-			p.BinaryExpr()
+			p.SetState(1049)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(935)
-			p.TernaryExpr()
+		p.SetState(1054)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1055)
+		p.CompBody()
 	}
 
 errorExit:
@@ -12914,75 +13651,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUnaryExprContext is an interface to support dynamic dispatch.
-type IUnaryExprContext interface {
+// IConnDefListContext is an interface to support dynamic dispatch.
+type IConnDefListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	UnaryOp() IUnaryOpContext
-	SingleSenderSide() ISingleSenderSideContext
+	AllConnDef() []IConnDefContext
+	ConnDef(i int) IConnDefContext
+	AllCOMMENT() []antlr.TerminalNode
+	COMMENT(i int) antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsUnaryExprContext differentiates from other interfaces.
-	IsUnaryExprContext()
+	// IsConnDefListContext differentiates from other interfaces.
+	IsConnDefListContext()
 }
 
-type UnaryExprContext struct {
+type ConnDefListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnaryExprContext() *UnaryExprContext {
-	var p = new(UnaryExprContext)
+func NewEmptyConnDefListContext() *ConnDefListContext {
+	var p = new(ConnDefListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unaryExpr
+	p.RuleIndex = nevaParserRULE_connDefList
 	return p
 }
 
-func InitEmptyUnaryExprContext(p *UnaryExprContext) {
+func InitEmptyConnDefListContext(p *ConnDefListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unaryExpr
+	p.RuleIndex = nevaParserRULE_connDefList
 }
 
-func (*UnaryExprContext) IsUnaryExprContext() {}
+func (*ConnDefListContext) IsConnDefListContext() {}
 
-func NewUnaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExprContext {
-	var p = new(UnaryExprContext)
+func NewConnDefListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConnDefListContext {
+	var p = new(ConnDefListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_unaryExpr
+	p.RuleIndex = nevaParserRULE_connDefList
 
 	return p
 }
 
-func (s *UnaryExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConnDefListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UnaryExprContext) UnaryOp() IUnaryOpContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryOpContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *ConnDefListContext) AllConnDef() []IConnDefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IConnDefContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IConnDefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IConnDefContext); ok {
+			tst[i] = t.(IConnDefContext)
+			i++
+		}
 	}
 
-	return t.(IUnaryOpContext)
+	return tst
 }
 
-func (s *UnaryExprContext) SingleSenderSide() ISingleSenderSideContext {
+func (s *ConnDefListContext) ConnDef(i int) IConnDefContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IConnDefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -12990,40 +13740,153 @@ func (s *UnaryExprContext) SingleSenderSide() ISingleSenderSideContext {
 		return nil
 	}
 
-	return t.(ISingleSenderSideContext)
+	return t.(IConnDefContext)
 }
 
-func (s *UnaryExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ConnDefListContext) AllCOMMENT() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserCOMMENT)
+}
+
+func (s *ConnDefListContext) COMMENT(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserCOMMENT, i)
+}
+
+func (s *ConnDefListContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *ConnDefListContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *ConnDefListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConnDefListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UnaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConnDefListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterUnaryExpr(s)
+		listenerT.EnterConnDefList(s)
 	}
 }
 
-func (s *UnaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConnDefListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitUnaryExpr(s)
+		listenerT.ExitConnDefList(s)
 	}
 }
 
-func (p *nevaParser) UnaryExpr() (localctx IUnaryExprContext) {
-	localctx = NewUnaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, nevaParserRULE_unaryExpr)
+func (p *nevaParser) ConnDefList() (localctx IConnDefListContext) {
+	localctx = NewConnDefListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, nevaParserRULE_connDefList)
+	var _la int
+
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(938)
-		p.UnaryOp()
+	p.SetState(1059)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(939)
-		p.SingleSenderSide()
+
+	switch p.GetTokenStream().LA(1) {
+	case nevaParserT__1, nevaParserT__5, nevaParserT__7, nevaParserT__10, nevaParserT__20, nevaParserT__24, nevaParserT__25, nevaParserT__32, nevaParserT__33, nevaParserT__34, nevaParserT__48, nevaParserIDENTIFIER, nevaParserINT, nevaParserMINUS, nevaParserFLOAT, nevaParserSTRING:
+		{
+			p.SetState(1057)
+			p.ConnDef()
+		}
+
+	case nevaParserCOMMENT:
+		{
+			p.SetState(1058)
+			p.Match(nevaParserCOMMENT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	p.SetState(1073)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 143, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(1064)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(1061)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(1066)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			p.SetState(1069)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetTokenStream().LA(1) {
+			case nevaParserT__1, nevaParserT__5, nevaParserT__7, nevaParserT__10, nevaParserT__20, nevaParserT__24, nevaParserT__25, nevaParserT__32, nevaParserT__33, nevaParserT__34, nevaParserT__48, nevaParserIDENTIFIER, nevaParserINT, nevaParserMINUS, nevaParserFLOAT, nevaParserSTRING:
+				{
+					p.SetState(1067)
+					p.ConnDef()
+				}
+
+			case nevaParserCOMMENT:
+				{
+					p.SetState(1068)
+					p.Match(nevaParserCOMMENT)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			default:
+				p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				goto errorExit
+			}
+
+		}
+		p.SetState(1075)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 143, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -13039,92 +13902,131 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUnaryOpContext is an interface to support dynamic dispatch.
-type IUnaryOpContext interface {
+// IConnDefContext is an interface to support dynamic dispatch.
+type IConnDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	MINUS() antlr.TerminalNode
+	NormConnDef() INormConnDefContext
+	ArrBypassConnDef() IArrBypassConnDefContext
 
-	// IsUnaryOpContext differentiates from other interfaces.
-	IsUnaryOpContext()
+	// IsConnDefContext differentiates from other interfaces.
+	IsConnDefContext()
 }
 
-type UnaryOpContext struct {
+type ConnDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnaryOpContext() *UnaryOpContext {
-	var p = new(UnaryOpContext)
+func NewEmptyConnDefContext() *ConnDefContext {
+	var p = new(ConnDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unaryOp
+	p.RuleIndex = nevaParserRULE_connDef
 	return p
 }
 
-func InitEmptyUnaryOpContext(p *UnaryOpContext) {
+func InitEmptyConnDefContext(p *ConnDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_unaryOp
+	p.RuleIndex = nevaParserRULE_connDef
 }
 
-func (*UnaryOpContext) IsUnaryOpContext() {}
+func (*ConnDefContext) IsConnDefContext() {}
 
-func NewUnaryOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryOpContext {
-	var p = new(UnaryOpContext)
+func NewConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConnDefContext {
+	var p = new(ConnDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_unaryOp
+	p.RuleIndex = nevaParserRULE_connDef
 
 	return p
 }
 
-func (s *UnaryOpContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConnDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UnaryOpContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(nevaParserMINUS, 0)
+func (s *ConnDefContext) NormConnDef() INormConnDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INormConnDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INormConnDefContext)
 }
 
-func (s *UnaryOpContext) GetRuleContext() antlr.RuleContext {
+func (s *ConnDefContext) ArrBypassConnDef() IArrBypassConnDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArrBypassConnDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArrBypassConnDefContext)
+}
+
+func (s *ConnDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UnaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterUnaryOp(s)
+		listenerT.EnterConnDef(s)
 	}
 }
 
-func (s *UnaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitUnaryOp(s)
+		listenerT.ExitConnDef(s)
 	}
 }
 
-func (p *nevaParser) UnaryOp() (localctx IUnaryOpContext) {
-	localctx = NewUnaryOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, nevaParserRULE_unaryOp)
-	var _la int
+func (p *nevaParser) ConnDef() (localctx IConnDefContext) {
+	localctx = NewConnDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, nevaParserRULE_connDef)
+	p.SetState(1078)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(941)
-		_la = p.GetTokenStream().LA(1)
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 144, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1076)
+			p.NormConnDef()
+		}
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&18014413541867520) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1077)
+			p.ArrBypassConnDef()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -13140,84 +14042,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITernaryExprContext is an interface to support dynamic dispatch.
-type ITernaryExprContext interface {
+// INormConnDefContext is an interface to support dynamic dispatch.
+type INormConnDefContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllSingleSenderSide() []ISingleSenderSideContext
-	SingleSenderSide(i int) ISingleSenderSideContext
+	SenderSide() ISenderSideContext
+	ReceiverSide() IReceiverSideContext
 
-	// IsTernaryExprContext differentiates from other interfaces.
-	IsTernaryExprContext()
+	// IsNormConnDefContext differentiates from other interfaces.
+	IsNormConnDefContext()
 }
 
-type TernaryExprContext struct {
+type NormConnDefContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTernaryExprContext() *TernaryExprContext {
-	var p = new(TernaryExprContext)
+func NewEmptyNormConnDefContext() *NormConnDefContext {
+	var p = new(NormConnDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_ternaryExpr
+	p.RuleIndex = nevaParserRULE_normConnDef
 	return p
 }
 
-func InitEmptyTernaryExprContext(p *TernaryExprContext) {
+func InitEmptyNormConnDefContext(p *NormConnDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_ternaryExpr
+	p.RuleIndex = nevaParserRULE_normConnDef
 }
 
-func (*TernaryExprContext) IsTernaryExprContext() {}
+func (*NormConnDefContext) IsNormConnDefContext() {}
 
-func NewTernaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TernaryExprContext {
-	var p = new(TernaryExprContext)
+func NewNormConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NormConnDefContext {
+	var p = new(NormConnDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_ternaryExpr
+	p.RuleIndex = nevaParserRULE_normConnDef
 
 	return p
 }
 
-func (s *TernaryExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *NormConnDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TernaryExprContext) AllSingleSenderSide() []ISingleSenderSideContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			len++
+func (s *NormConnDefContext) SenderSide() ISenderSideContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISenderSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ISingleSenderSideContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISingleSenderSideContext); ok {
-			tst[i] = t.(ISingleSenderSideContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ISenderSideContext)
 }
 
-func (s *TernaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
+func (s *NormConnDefContext) ReceiverSide() IReceiverSideContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IReceiverSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -13225,76 +14118,48 @@ func (s *TernaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
 		return nil
 	}
 
-	return t.(ISingleSenderSideContext)
+	return t.(IReceiverSideContext)
 }
 
-func (s *TernaryExprContext) GetRuleContext() antlr.RuleContext {
+func (s *NormConnDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TernaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NormConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TernaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NormConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterTernaryExpr(s)
+		listenerT.EnterNormConnDef(s)
 	}
 }
 
-func (s *TernaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NormConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitTernaryExpr(s)
+		listenerT.ExitNormConnDef(s)
 	}
 }
 
-func (p *nevaParser) TernaryExpr() (localctx ITernaryExprContext) {
-	localctx = NewTernaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, nevaParserRULE_ternaryExpr)
+func (p *nevaParser) NormConnDef() (localctx INormConnDefContext) {
+	localctx = NewNormConnDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, nevaParserRULE_normConnDef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(943)
-		p.Match(nevaParserT__1)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(944)
-		p.SingleSenderSide()
-	}
-	{
-		p.SetState(945)
-		p.Match(nevaParserT__27)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(946)
-		p.SingleSenderSide()
+		p.SetState(1080)
+		p.SenderSide()
 	}
 	{
-		p.SetState(947)
-		p.Match(nevaParserT__7)
+		p.SetState(1081)
+		p.Match(nevaParserT__30)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(948)
-		p.SingleSenderSide()
-	}
-	{
-		p.SetState(949)
-		p.Match(nevaParserT__3)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(1082)
+		p.ReceiverSide()
 	}
 
 errorExit:
@@ -13310,85 +14175,59 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBinaryExprContext is an interface to support dynamic dispatch.
-type IBinaryExprContext interface {
+// ISenderSideContext is an interface to support dynamic dispatch.
+type ISenderSideContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllSingleSenderSide() []ISingleSenderSideContext
-	SingleSenderSide(i int) ISingleSenderSideContext
-	BinaryOp() IBinaryOpContext
+	SingleSenderSide() ISingleSenderSideContext
+	MultipleSenderSide() IMultipleSenderSideContext
 
-	// IsBinaryExprContext differentiates from other interfaces.
-	IsBinaryExprContext()
+	// IsSenderSideContext differentiates from other interfaces.
+	IsSenderSideContext()
 }
 
-type BinaryExprContext struct {
+type SenderSideContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBinaryExprContext() *BinaryExprContext {
-	var p = new(BinaryExprContext)
+func NewEmptySenderSideContext() *SenderSideContext {
+	var p = new(SenderSideContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_binaryExpr
+	p.RuleIndex = nevaParserRULE_senderSide
 	return p
 }
 
-func InitEmptyBinaryExprContext(p *BinaryExprContext) {
+func InitEmptySenderSideContext(p *SenderSideContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_binaryExpr
+	p.RuleIndex = nevaParserRULE_senderSide
 }
 
-func (*BinaryExprContext) IsBinaryExprContext() {}
+func (*SenderSideContext) IsSenderSideContext() {}
 
-func NewBinaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BinaryExprContext {
-	var p = new(BinaryExprContext)
+func NewSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SenderSideContext {
+	var p = new(SenderSideContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_binaryExpr
+	p.RuleIndex = nevaParserRULE_senderSide
 
 	return p
 }
 
-func (s *BinaryExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *SenderSideContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BinaryExprContext) AllSingleSenderSide() []ISingleSenderSideContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ISingleSenderSideContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISingleSenderSideContext); ok {
-			tst[i] = t.(ISingleSenderSideContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *BinaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
+func (s *SenderSideContext) SingleSenderSide() ISingleSenderSideContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ISingleSenderSideContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -13399,10 +14238,10 @@ func (s *BinaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
 	return t.(ISingleSenderSideContext)
 }
 
-func (s *BinaryExprContext) BinaryOp() IBinaryOpContext {
+func (s *SenderSideContext) MultipleSenderSide() IMultipleSenderSideContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBinaryOpContext); ok {
+		if _, ok := ctx.(IMultipleSenderSideContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -13412,60 +14251,55 @@ func (s *BinaryExprContext) BinaryOp() IBinaryOpContext {
 		return nil
 	}
 
-	return t.(IBinaryOpContext)
+	return t.(IMultipleSenderSideContext)
 }
 
-func (s *BinaryExprContext) GetRuleContext() antlr.RuleContext {
+func (s *SenderSideContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BinaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BinaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterBinaryExpr(s)
+		listenerT.EnterSenderSide(s)
 	}
 }
 
-func (s *BinaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitBinaryExpr(s)
+		listenerT.ExitSenderSide(s)
 	}
 }
 
-func (p *nevaParser) BinaryExpr() (localctx IBinaryExprContext) {
-	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, nevaParserRULE_binaryExpr)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(951)
-		p.Match(nevaParserT__1)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(952)
-		p.SingleSenderSide()
-	}
-	{
-		p.SetState(953)
-		p.BinaryOp()
-	}
-	{
-		p.SetState(954)
-		p.SingleSenderSide()
+func (p *nevaParser) SenderSide() (localctx ISenderSideContext) {
+	localctx = NewSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, nevaParserRULE_senderSide)
+	p.SetState(1086)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(955)
-		p.Match(nevaParserT__3)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 145, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1084)
+			p.SingleSenderSide()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1085)
+			p.MultipleSenderSide()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -13481,176 +14315,342 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBinaryOpContext is an interface to support dynamic dispatch.
-type IBinaryOpContext interface {
+// IMultipleSenderSideContext is an interface to support dynamic dispatch.
+type IMultipleSenderSideContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	MINUS() antlr.TerminalNode
+	AllSingleSenderSide() []ISingleSenderSideContext
+	SingleSenderSide(i int) ISingleSenderSideContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsBinaryOpContext differentiates from other interfaces.
-	IsBinaryOpContext()
+	// IsMultipleSenderSideContext differentiates from other interfaces.
+	IsMultipleSenderSideContext()
 }
 
-type BinaryOpContext struct {
+type MultipleSenderSideContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBinaryOpContext() *BinaryOpContext {
-	var p = new(BinaryOpContext)
+func NewEmptyMultipleSenderSideContext() *MultipleSenderSideContext {
+	var p = new(MultipleSenderSideContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_binaryOp
+	p.RuleIndex = nevaParserRULE_multipleSenderSide
 	return p
 }
 
-func InitEmptyBinaryOpContext(p *BinaryOpContext) {
+func InitEmptyMultipleSenderSideContext(p *MultipleSenderSideContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_binaryOp
+	p.RuleIndex = nevaParserRULE_multipleSenderSide
 }
 
-func (*BinaryOpContext) IsBinaryOpContext() {}
+func (*MultipleSenderSideContext) IsMultipleSenderSideContext() {}
 
-func NewBinaryOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BinaryOpContext {
-	var p = new(BinaryOpContext)
+func NewMultipleSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultipleSenderSideContext {
+	var p = new(MultipleSenderSideContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_binaryOp
+	p.RuleIndex = nevaParserRULE_multipleSenderSide
 
 	return p
 }
 
-func (s *BinaryOpContext) GetParser() antlr.Parser { return s.parser }
+func (s *MultipleSenderSideContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BinaryOpContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(nevaParserMINUS, 0)
+func (s *MultipleSenderSideContext) AllSingleSenderSide() []ISingleSenderSideContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISingleSenderSideContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISingleSenderSideContext); ok {
+			tst[i] = t.(ISingleSenderSideContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *BinaryOpContext) GetRuleContext() antlr.RuleContext {
+func (s *MultipleSenderSideContext) SingleSenderSide(i int) ISingleSenderSideContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleSenderSideContext)
+}
+
+func (s *MultipleSenderSideContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *MultipleSenderSideContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *MultipleSenderSideContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BinaryOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MultipleSenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BinaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MultipleSenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterBinaryOp(s)
+		listenerT.EnterMultipleSenderSide(s)
 	}
 }
 
-func (s *BinaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MultipleSenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitBinaryOp(s)
+		listenerT.ExitMultipleSenderSide(s)
 	}
 }
 
-func (p *nevaParser) BinaryOp() (localctx IBinaryOpContext) {
-	localctx = NewBinaryOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, nevaParserRULE_binaryOp)
+func (p *nevaParser) MultipleSenderSide() (localctx IMultipleSenderSideContext) {
+	localctx = NewMultipleSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, nevaParserRULE_multipleSenderSide)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(957)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&18084750073947136) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(1088)
+		p.Match(nevaParserT__20)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-errorExit:
+	p.SetState(1092)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+		goto errorExit
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IReceiverSideContext is an interface to support dynamic dispatch.
-type IReceiverSideContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	_la = p.GetTokenStream().LA(1)
 
-	// Getter signatures
-	SingleReceiverSide() ISingleReceiverSideContext
-	MultipleReceiverSide() IMultipleReceiverSideContext
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(1089)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
 
-	// IsReceiverSideContext differentiates from other interfaces.
-	IsReceiverSideContext()
-}
+		p.SetState(1094)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1095)
+		p.SingleSenderSide()
+	}
+	p.SetState(1112)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-type ReceiverSideContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+	for _la == nevaParserT__2 {
+		{
+			p.SetState(1096)
+			p.Match(nevaParserT__2)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(1100)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-func NewEmptyReceiverSideContext() *ReceiverSideContext {
-	var p = new(ReceiverSideContext)
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(1097)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1102)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1103)
+			p.SingleSenderSide()
+		}
+		p.SetState(1107)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(1104)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1109)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+		p.SetState(1114)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1115)
+		p.Match(nevaParserT__21)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArrBypassConnDefContext is an interface to support dynamic dispatch.
+type IArrBypassConnDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSinglePortAddr() []ISinglePortAddrContext
+	SinglePortAddr(i int) ISinglePortAddrContext
+
+	// IsArrBypassConnDefContext differentiates from other interfaces.
+	IsArrBypassConnDefContext()
+}
+
+type ArrBypassConnDefContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArrBypassConnDefContext() *ArrBypassConnDefContext {
+	var p = new(ArrBypassConnDefContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_receiverSide
+	p.RuleIndex = nevaParserRULE_arrBypassConnDef
 	return p
 }
 
-func InitEmptyReceiverSideContext(p *ReceiverSideContext) {
+func InitEmptyArrBypassConnDefContext(p *ArrBypassConnDefContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_receiverSide
+	p.RuleIndex = nevaParserRULE_arrBypassConnDef
 }
 
-func (*ReceiverSideContext) IsReceiverSideContext() {}
+func (*ArrBypassConnDefContext) IsArrBypassConnDefContext() {}
 
-func NewReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReceiverSideContext {
-	var p = new(ReceiverSideContext)
+func NewArrBypassConnDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrBypassConnDefContext {
+	var p = new(ArrBypassConnDefContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_receiverSide
+	p.RuleIndex = nevaParserRULE_arrBypassConnDef
 
 	return p
 }
 
-func (s *ReceiverSideContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrBypassConnDefContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReceiverSideContext) SingleReceiverSide() ISingleReceiverSideContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleReceiverSideContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *ArrBypassConnDefContext) AllSinglePortAddr() []ISinglePortAddrContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISinglePortAddrContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]ISinglePortAddrContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISinglePortAddrContext); ok {
+			tst[i] = t.(ISinglePortAddrContext)
+			i++
+		}
 	}
 
-	return t.(ISingleReceiverSideContext)
+	return tst
 }
 
-func (s *ReceiverSideContext) MultipleReceiverSide() IMultipleReceiverSideContext {
+func (s *ArrBypassConnDefContext) SinglePortAddr(i int) ISinglePortAddrContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMultipleReceiverSideContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ISinglePortAddrContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -13658,55 +14658,48 @@ func (s *ReceiverSideContext) MultipleReceiverSide() IMultipleReceiverSideContex
 		return nil
 	}
 
-	return t.(IMultipleReceiverSideContext)
+	return t.(ISinglePortAddrContext)
 }
 
-func (s *ReceiverSideContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrBypassConnDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrBypassConnDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrBypassConnDefContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterReceiverSide(s)
+		listenerT.EnterArrBypassConnDef(s)
 	}
 }
 
-func (s *ReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrBypassConnDefContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitReceiverSide(s)
+		listenerT.ExitArrBypassConnDef(s)
 	}
 }
 
-func (p *nevaParser) ReceiverSide() (localctx IReceiverSideContext) {
-	localctx = NewReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, nevaParserRULE_receiverSide)
-	p.SetState(961)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+func (p *nevaParser) ArrBypassConnDef() (localctx IArrBypassConnDefContext) {
+	localctx = NewArrBypassConnDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, nevaParserRULE_arrBypassConnDef)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1117)
+		p.SinglePortAddr()
 	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 124, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(959)
-			p.SingleReceiverSide()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(960)
-			p.MultipleReceiverSide()
+	{
+		p.SetState(1118)
+		p.Match(nevaParserT__31)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(1119)
+		p.SinglePortAddr()
 	}
 
 errorExit:
@@ -13722,56 +14715,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IChainedNormConnContext is an interface to support dynamic dispatch.
-type IChainedNormConnContext interface {
+// ISingleSenderSideContext is an interface to support dynamic dispatch.
+type ISingleSenderSideContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NormConnDef() INormConnDefContext
+	PortAddr() IPortAddrContext
+	SenderConstRef() ISenderConstRefContext
+	PrimitiveConstLit() IPrimitiveConstLitContext
+	RangeExpr() IRangeExprContext
+	StructSelectors() IStructSelectorsContext
+	UnaryExpr() IUnaryExprContext
+	BinaryExpr() IBinaryExprContext
+	TernaryExpr() ITernaryExprContext
+	StructLit() IStructLitContext
+	ListSenderLit() IListSenderLitContext
+	UnionSender() IUnionSenderContext
 
-	// IsChainedNormConnContext differentiates from other interfaces.
-	IsChainedNormConnContext()
+	// IsSingleSenderSideContext differentiates from other interfaces.
+	IsSingleSenderSideContext()
 }
 
-type ChainedNormConnContext struct {
+type SingleSenderSideContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyChainedNormConnContext() *ChainedNormConnContext {
-	var p = new(ChainedNormConnContext)
+func NewEmptySingleSenderSideContext() *SingleSenderSideContext {
+	var p = new(SingleSenderSideContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_chainedNormConn
+	p.RuleIndex = nevaParserRULE_singleSenderSide
 	return p
 }
 
-func InitEmptyChainedNormConnContext(p *ChainedNormConnContext) {
+func InitEmptySingleSenderSideContext(p *SingleSenderSideContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_chainedNormConn
+	p.RuleIndex = nevaParserRULE_singleSenderSide
 }
 
-func (*ChainedNormConnContext) IsChainedNormConnContext() {}
+func (*SingleSenderSideContext) IsSingleSenderSideContext() {}
 
-func NewChainedNormConnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChainedNormConnContext {
-	var p = new(ChainedNormConnContext)
+func NewSingleSenderSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleSenderSideContext {
+	var p = new(SingleSenderSideContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_chainedNormConn
+	p.RuleIndex = nevaParserRULE_singleSenderSide
 
 	return p
 }
 
-func (s *ChainedNormConnContext) GetParser() antlr.Parser { return s.parser }
+func (s *SingleSenderSideContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ChainedNormConnContext) NormConnDef() INormConnDefContext {
+func (s *SingleSenderSideContext) PortAddr() IPortAddrContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INormConnDefContext); ok {
+		if _, ok := ctx.(IPortAddrContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -13781,16 +14784,1170 @@ func (s *ChainedNormConnContext) NormConnDef() INormConnDefContext {
 		return nil
 	}
 
-	return t.(INormConnDefContext)
-}
-
-func (s *ChainedNormConnContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IPortAddrContext)
 }
 
-func (s *ChainedNormConnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *SingleSenderSideContext) SenderConstRef() ISenderConstRefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISenderConstRefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISenderConstRefContext)
+}
+
+func (s *SingleSenderSideContext) PrimitiveConstLit() IPrimitiveConstLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimitiveConstLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimitiveConstLitContext)
+}
+
+func (s *SingleSenderSideContext) RangeExpr() IRangeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRangeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRangeExprContext)
+}
+
+func (s *SingleSenderSideContext) StructSelectors() IStructSelectorsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructSelectorsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructSelectorsContext)
+}
+
+func (s *SingleSenderSideContext) UnaryExpr() IUnaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryExprContext)
+}
+
+func (s *SingleSenderSideContext) BinaryExpr() IBinaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBinaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBinaryExprContext)
+}
+
+func (s *SingleSenderSideContext) TernaryExpr() ITernaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITernaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITernaryExprContext)
+}
+
+func (s *SingleSenderSideContext) StructLit() IStructLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructLitContext)
+}
+
+func (s *SingleSenderSideContext) ListSenderLit() IListSenderLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListSenderLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListSenderLitContext)
+}
+
+func (s *SingleSenderSideContext) UnionSender() IUnionSenderContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnionSenderContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnionSenderContext)
+}
+
+func (s *SingleSenderSideContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SingleSenderSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SingleSenderSideContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterSingleSenderSide(s)
+	}
+}
+
+func (s *SingleSenderSideContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitSingleSenderSide(s)
+	}
+}
+
+func (p *nevaParser) SingleSenderSide() (localctx ISingleSenderSideContext) {
+	localctx = NewSingleSenderSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, nevaParserRULE_singleSenderSide)
+	p.SetState(1132)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 150, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1121)
+			p.PortAddr()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1122)
+			p.SenderConstRef()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1123)
+			p.PrimitiveConstLit()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1124)
+			p.RangeExpr()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1125)
+			p.StructSelectors()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1126)
+			p.UnaryExpr()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1127)
+			p.BinaryExpr()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1128)
+			p.TernaryExpr()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1129)
+			p.StructLit()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(1130)
+			p.ListSenderLit()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(1131)
+			p.UnionSender()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUnaryExprContext is an interface to support dynamic dispatch.
+type IUnaryExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	UnaryOp() IUnaryOpContext
+	SingleSenderSide() ISingleSenderSideContext
+
+	// IsUnaryExprContext differentiates from other interfaces.
+	IsUnaryExprContext()
+}
+
+type UnaryExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUnaryExprContext() *UnaryExprContext {
+	var p = new(UnaryExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_unaryExpr
+	return p
+}
+
+func InitEmptyUnaryExprContext(p *UnaryExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_unaryExpr
+}
+
+func (*UnaryExprContext) IsUnaryExprContext() {}
+
+func NewUnaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExprContext {
+	var p = new(UnaryExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_unaryExpr
+
+	return p
+}
+
+func (s *UnaryExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UnaryExprContext) UnaryOp() IUnaryOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryOpContext)
+}
+
+func (s *UnaryExprContext) SingleSenderSide() ISingleSenderSideContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleSenderSideContext)
+}
+
+func (s *UnaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UnaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterUnaryExpr(s)
+	}
+}
+
+func (s *UnaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitUnaryExpr(s)
+	}
+}
+
+func (p *nevaParser) UnaryExpr() (localctx IUnaryExprContext) {
+	localctx = NewUnaryExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, nevaParserRULE_unaryExpr)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1134)
+		p.UnaryOp()
+	}
+	{
+		p.SetState(1135)
+		p.SingleSenderSide()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUnaryOpContext is an interface to support dynamic dispatch.
+type IUnaryOpContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MINUS() antlr.TerminalNode
+
+	// IsUnaryOpContext differentiates from other interfaces.
+	IsUnaryOpContext()
+}
+
+type UnaryOpContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUnaryOpContext() *UnaryOpContext {
+	var p = new(UnaryOpContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_unaryOp
+	return p
+}
+
+func InitEmptyUnaryOpContext(p *UnaryOpContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_unaryOp
+}
+
+func (*UnaryOpContext) IsUnaryOpContext() {}
+
+func NewUnaryOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryOpContext {
+	var p = new(UnaryOpContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_unaryOp
+
+	return p
+}
+
+func (s *UnaryOpContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UnaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(nevaParserMINUS, 0)
+}
+
+func (s *UnaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UnaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterUnaryOp(s)
+	}
+}
+
+func (s *UnaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitUnaryOp(s)
+	}
+}
+
+func (p *nevaParser) UnaryOp() (localctx IUnaryOpContext) {
+	localctx = NewUnaryOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, nevaParserRULE_unaryOp)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1137)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&144115248205398016) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITernaryExprContext is an interface to support dynamic dispatch.
+type ITernaryExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSingleSenderSide() []ISingleSenderSideContext
+	SingleSenderSide(i int) ISingleSenderSideContext
+
+	// IsTernaryExprContext differentiates from other interfaces.
+	IsTernaryExprContext()
+}
+
+type TernaryExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTernaryExprContext() *TernaryExprContext {
+	var p = new(TernaryExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_ternaryExpr
+	return p
+}
+
+func InitEmptyTernaryExprContext(p *TernaryExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_ternaryExpr
+}
+
+func (*TernaryExprContext) IsTernaryExprContext() {}
+
+func NewTernaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TernaryExprContext {
+	var p = new(TernaryExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_ternaryExpr
+
+	return p
+}
+
+func (s *TernaryExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TernaryExprContext) AllSingleSenderSide() []ISingleSenderSideContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISingleSenderSideContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISingleSenderSideContext); ok {
+			tst[i] = t.(ISingleSenderSideContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TernaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleSenderSideContext)
+}
+
+func (s *TernaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TernaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TernaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterTernaryExpr(s)
+	}
+}
+
+func (s *TernaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitTernaryExpr(s)
+	}
+}
+
+func (p *nevaParser) TernaryExpr() (localctx ITernaryExprContext) {
+	localctx = NewTernaryExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, nevaParserRULE_ternaryExpr)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1139)
+		p.Match(nevaParserT__1)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1140)
+		p.SingleSenderSide()
+	}
+	{
+		p.SetState(1141)
+		p.Match(nevaParserT__29)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1142)
+		p.SingleSenderSide()
+	}
+	{
+		p.SetState(1143)
+		p.Match(nevaParserT__7)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1144)
+		p.SingleSenderSide()
+	}
+	{
+		p.SetState(1145)
+		p.Match(nevaParserT__3)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IBinaryExprContext is an interface to support dynamic dispatch.
+type IBinaryExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSingleSenderSide() []ISingleSenderSideContext
+	SingleSenderSide(i int) ISingleSenderSideContext
+	BinaryOp() IBinaryOpContext
+
+	// IsBinaryExprContext differentiates from other interfaces.
+	IsBinaryExprContext()
+}
+
+type BinaryExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBinaryExprContext() *BinaryExprContext {
+	var p = new(BinaryExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_binaryExpr
+	return p
+}
+
+func InitEmptyBinaryExprContext(p *BinaryExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_binaryExpr
+}
+
+func (*BinaryExprContext) IsBinaryExprContext() {}
+
+func NewBinaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BinaryExprContext {
+	var p = new(BinaryExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_binaryExpr
+
+	return p
+}
+
+func (s *BinaryExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BinaryExprContext) AllSingleSenderSide() []ISingleSenderSideContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISingleSenderSideContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISingleSenderSideContext); ok {
+			tst[i] = t.(ISingleSenderSideContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BinaryExprContext) SingleSenderSide(i int) ISingleSenderSideContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleSenderSideContext)
+}
+
+func (s *BinaryExprContext) BinaryOp() IBinaryOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBinaryOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBinaryOpContext)
+}
+
+func (s *BinaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BinaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BinaryExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterBinaryExpr(s)
+	}
+}
+
+func (s *BinaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitBinaryExpr(s)
+	}
+}
+
+func (p *nevaParser) BinaryExpr() (localctx IBinaryExprContext) {
+	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, nevaParserRULE_binaryExpr)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1147)
+		p.Match(nevaParserT__1)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1148)
+		p.SingleSenderSide()
+	}
+	{
+		p.SetState(1149)
+		p.BinaryOp()
+	}
+	{
+		p.SetState(1150)
+		p.SingleSenderSide()
+	}
+	{
+		p.SetState(1151)
+		p.Match(nevaParserT__3)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IBinaryOpContext is an interface to support dynamic dispatch.
+type IBinaryOpContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MINUS() antlr.TerminalNode
+
+	// IsBinaryOpContext differentiates from other interfaces.
+	IsBinaryOpContext()
+}
+
+type BinaryOpContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBinaryOpContext() *BinaryOpContext {
+	var p = new(BinaryOpContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_binaryOp
+	return p
+}
+
+func InitEmptyBinaryOpContext(p *BinaryOpContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_binaryOp
+}
+
+func (*BinaryOpContext) IsBinaryOpContext() {}
+
+func NewBinaryOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BinaryOpContext {
+	var p = new(BinaryOpContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_binaryOp
+
+	return p
+}
+
+func (s *BinaryOpContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BinaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(nevaParserMINUS, 0)
+}
+
+func (s *BinaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BinaryOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BinaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterBinaryOp(s)
+	}
+}
+
+func (s *BinaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitBinaryOp(s)
+	}
+}
+
+func (p *nevaParser) BinaryOp() (localctx IBinaryOpContext) {
+	localctx = NewBinaryOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, nevaParserRULE_binaryOp)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1153)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&144678069309924352) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IReceiverSideContext is an interface to support dynamic dispatch.
+type IReceiverSideContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SingleReceiverSide() ISingleReceiverSideContext
+	MultipleReceiverSide() IMultipleReceiverSideContext
+
+	// IsReceiverSideContext differentiates from other interfaces.
+	IsReceiverSideContext()
+}
+
+type ReceiverSideContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyReceiverSideContext() *ReceiverSideContext {
+	var p = new(ReceiverSideContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_receiverSide
+	return p
+}
+
+func InitEmptyReceiverSideContext(p *ReceiverSideContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_receiverSide
+}
+
+func (*ReceiverSideContext) IsReceiverSideContext() {}
+
+func NewReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReceiverSideContext {
+	var p = new(ReceiverSideContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_receiverSide
+
+	return p
+}
+
+func (s *ReceiverSideContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ReceiverSideContext) SingleReceiverSide() ISingleReceiverSideContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleReceiverSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleReceiverSideContext)
+}
+
+func (s *ReceiverSideContext) MultipleReceiverSide() IMultipleReceiverSideContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMultipleReceiverSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMultipleReceiverSideContext)
+}
+
+func (s *ReceiverSideContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterReceiverSide(s)
+	}
+}
+
+func (s *ReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitReceiverSide(s)
+	}
+}
+
+func (p *nevaParser) ReceiverSide() (localctx IReceiverSideContext) {
+	localctx = NewReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 164, nevaParserRULE_receiverSide)
+	p.SetState(1157)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 151, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1155)
+			p.SingleReceiverSide()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1156)
+			p.MultipleReceiverSide()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IChainedNormConnContext is an interface to support dynamic dispatch.
+type IChainedNormConnContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NormConnDef() INormConnDefContext
+
+	// IsChainedNormConnContext differentiates from other interfaces.
+	IsChainedNormConnContext()
+}
+
+type ChainedNormConnContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyChainedNormConnContext() *ChainedNormConnContext {
+	var p = new(ChainedNormConnContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_chainedNormConn
+	return p
+}
+
+func InitEmptyChainedNormConnContext(p *ChainedNormConnContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_chainedNormConn
+}
+
+func (*ChainedNormConnContext) IsChainedNormConnContext() {}
+
+func NewChainedNormConnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChainedNormConnContext {
+	var p = new(ChainedNormConnContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_chainedNormConn
+
+	return p
+}
+
+func (s *ChainedNormConnContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ChainedNormConnContext) NormConnDef() INormConnDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INormConnDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INormConnDefContext)
+}
+
+func (s *ChainedNormConnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ChainedNormConnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
 func (s *ChainedNormConnContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
@@ -13806,10 +15963,10 @@ func (s *ChainedNormConnContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) ChainedNormConn() (localctx IChainedNormConnContext) {
 	localctx = NewChainedNormConnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, nevaParserRULE_chainedNormConn)
+	p.EnterRule(localctx, 166, nevaParserRULE_chainedNormConn)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(963)
+		p.SetState(1159)
 		p.NormConnDef()
 	}
 
@@ -13920,19 +16077,19 @@ func (s *DeferredConnContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 	localctx = NewDeferredConnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, nevaParserRULE_deferredConn)
+	p.EnterRule(localctx, 168, nevaParserRULE_deferredConn)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(965)
+		p.SetState(1161)
 		p.Match(nevaParserT__5)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(969)
+	p.SetState(1165)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13941,7 +16098,7 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(966)
+			p.SetState(1162)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13949,7 +16106,7 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 			}
 		}
 
-		p.SetState(971)
+		p.SetState(1167)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13957,10 +16114,10 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(972)
+		p.SetState(1168)
 		p.ConnDef()
 	}
-	p.SetState(976)
+	p.SetState(1172)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13969,7 +16126,7 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(973)
+			p.SetState(1169)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13977,7 +16134,7 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 			}
 		}
 
-		p.SetState(978)
+		p.SetState(1174)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13985,7 +16142,7 @@ func (p *nevaParser) DeferredConn() (localctx IDeferredConnContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(979)
+		p.SetState(1175)
 		p.Match(nevaParserT__6)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14090,18 +16247,18 @@ func (s *SenderConstRefContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) SenderConstRef() (localctx ISenderConstRefContext) {
 	localctx = NewSenderConstRefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, nevaParserRULE_senderConstRef)
+	p.EnterRule(localctx, 170, nevaParserRULE_senderConstRef)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(981)
-		p.Match(nevaParserT__45)
+		p.SetState(1177)
+		p.Match(nevaParserT__48)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(982)
+		p.SetState(1178)
 		p.EntityRef()
 	}
 
@@ -14228,24 +16385,48 @@ func (s *RangeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) RangeExpr() (localctx IRangeExprContext) {
 	localctx = NewRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, nevaParserRULE_rangeExpr)
+	p.EnterRule(localctx, 172, nevaParserRULE_rangeExpr)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(984)
+		p.SetState(1180)
 		p.RangeMember()
 	}
 	{
-		p.SetState(985)
-		p.Match(nevaParserT__46)
+		p.SetState(1181)
+		p.Match(nevaParserT__49)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(986)
+		p.SetState(1182)
 		p.RangeMember()
 	}
+	p.SetState(1185)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == nevaParserT__49 {
+		{
+			p.SetState(1183)
+			p.Match(nevaParserT__49)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1184)
+			p.RangeMember()
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -14269,7 +16450,10 @@ type IRangeMemberContext interface {
 
 	// Getter signatures
 	INT() antlr.TerminalNode
+	FLOAT() antlr.TerminalNode
 	MINUS() antlr.TerminalNode
+	SenderConstRef() ISenderConstRefContext
+	PortAddr() IPortAddrContext
 
 	// IsRangeMemberContext differentiates from other interfaces.
 	IsRangeMemberContext()
@@ -14299,20 +16483,56 @@ func NewRangeMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	p.parser = parser
-	p.RuleIndex = nevaParserRULE_rangeMember
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_rangeMember
+
+	return p
+}
+
+func (s *RangeMemberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RangeMemberContext) INT() antlr.TerminalNode {
+	return s.GetToken(nevaParserINT, 0)
+}
+
+func (s *RangeMemberContext) FLOAT() antlr.TerminalNode {
+	return s.GetToken(nevaParserFLOAT, 0)
+}
+
+func (s *RangeMemberContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(nevaParserMINUS, 0)
+}
+
+func (s *RangeMemberContext) SenderConstRef() ISenderConstRefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISenderConstRefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(ISenderConstRefContext)
 }
 
-func (s *RangeMemberContext) GetParser() antlr.Parser { return s.parser }
+func (s *RangeMemberContext) PortAddr() IPortAddrContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPortAddrContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *RangeMemberContext) INT() antlr.TerminalNode {
-	return s.GetToken(nevaParserINT, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *RangeMemberContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(nevaParserMINUS, 0)
+	return t.(IPortAddrContext)
 }
 
 func (s *RangeMemberContext) GetRuleContext() antlr.RuleContext {
@@ -14337,35 +16557,65 @@ func (s *RangeMemberContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) RangeMember() (localctx IRangeMemberContext) {
 	localctx = NewRangeMemberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, nevaParserRULE_rangeMember)
+	p.EnterRule(localctx, 174, nevaParserRULE_rangeMember)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(989)
+	p.SetState(1193)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == nevaParserMINUS {
+	switch p.GetTokenStream().LA(1) {
+	case nevaParserINT, nevaParserMINUS, nevaParserFLOAT:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(1188)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == nevaParserMINUS {
+			{
+				p.SetState(1187)
+				p.Match(nevaParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
 		{
-			p.SetState(988)
-			p.Match(nevaParserMINUS)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			p.SetState(1190)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == nevaParserINT || _la == nevaParserFLOAT) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
 			}
 		}
 
-	}
-	{
-		p.SetState(991)
-		p.Match(nevaParserINT)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	case nevaParserT__48:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1191)
+			p.SenderConstRef()
+		}
+
+	case nevaParserT__7, nevaParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1192)
+			p.PortAddr()
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -14516,39 +16766,39 @@ func (s *PortAddrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) PortAddr() (localctx IPortAddrContext) {
 	localctx = NewPortAddrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, nevaParserRULE_portAddr)
-	p.SetState(997)
+	p.EnterRule(localctx, 176, nevaParserRULE_portAddr)
+	p.SetState(1199)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 128, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 157, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(993)
+			p.SetState(1195)
 			p.SinglePortAddr()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(994)
+			p.SetState(1196)
 			p.ArrPortAddr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(995)
+			p.SetState(1197)
 			p.LonelySinglePortAddr()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(996)
+			p.SetState(1198)
 			p.LonelyArrPortAddr()
 		}
 
@@ -14653,10 +16903,10 @@ func (s *LonelySinglePortAddrContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *nevaParser) LonelySinglePortAddr() (localctx ILonelySinglePortAddrContext) {
 	localctx = NewLonelySinglePortAddrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, nevaParserRULE_lonelySinglePortAddr)
+	p.EnterRule(localctx, 178, nevaParserRULE_lonelySinglePortAddr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(999)
+		p.SetState(1201)
 		p.PortAddrNode()
 	}
 
@@ -14774,14 +17024,14 @@ func (s *LonelyArrPortAddrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) LonelyArrPortAddr() (localctx ILonelyArrPortAddrContext) {
 	localctx = NewLonelyArrPortAddrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, nevaParserRULE_lonelyArrPortAddr)
+	p.EnterRule(localctx, 180, nevaParserRULE_lonelyArrPortAddr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1001)
+		p.SetState(1203)
 		p.PortAddrNode()
 	}
 	{
-		p.SetState(1002)
+		p.SetState(1204)
 		p.PortAddrIdx()
 	}
 
@@ -14899,11 +17149,11 @@ func (s *SinglePortAddrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) SinglePortAddr() (localctx ISinglePortAddrContext) {
 	localctx = NewSinglePortAddrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, nevaParserRULE_singlePortAddr)
+	p.EnterRule(localctx, 182, nevaParserRULE_singlePortAddr)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1005)
+	p.SetState(1207)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14912,13 +17162,13 @@ func (p *nevaParser) SinglePortAddr() (localctx ISinglePortAddrContext) {
 
 	if _la == nevaParserIDENTIFIER {
 		{
-			p.SetState(1004)
+			p.SetState(1206)
 			p.PortAddrNode()
 		}
 
 	}
 	{
-		p.SetState(1007)
+		p.SetState(1209)
 		p.Match(nevaParserT__7)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14926,7 +17176,7 @@ func (p *nevaParser) SinglePortAddr() (localctx ISinglePortAddrContext) {
 		}
 	}
 	{
-		p.SetState(1008)
+		p.SetState(1210)
 		p.PortAddrPort()
 	}
 
@@ -15061,11 +17311,11 @@ func (s *ArrPortAddrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *nevaParser) ArrPortAddr() (localctx IArrPortAddrContext) {
 	localctx = NewArrPortAddrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, nevaParserRULE_arrPortAddr)
+	p.EnterRule(localctx, 184, nevaParserRULE_arrPortAddr)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1011)
+	p.SetState(1213)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15074,13 +17324,13 @@ func (p *nevaParser) ArrPortAddr() (localctx IArrPortAddrContext) {
 
 	if _la == nevaParserIDENTIFIER {
 		{
-			p.SetState(1010)
+			p.SetState(1212)
 			p.PortAddrNode()
 		}
 
 	}
 	{
-		p.SetState(1013)
+		p.SetState(1215)
 		p.Match(nevaParserT__7)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15088,11 +17338,11 @@ func (p *nevaParser) ArrPortAddr() (localctx IArrPortAddrContext) {
 		}
 	}
 	{
-		p.SetState(1014)
+		p.SetState(1216)
 		p.PortAddrPort()
 	}
 	{
-		p.SetState(1015)
+		p.SetState(1217)
 		p.PortAddrIdx()
 	}
 
@@ -15131,61 +17381,269 @@ type PortAddrNodeContext struct {
 func NewEmptyPortAddrNodeContext() *PortAddrNodeContext {
 	var p = new(PortAddrNodeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrNode
+	p.RuleIndex = nevaParserRULE_portAddrNode
+	return p
+}
+
+func InitEmptyPortAddrNodeContext(p *PortAddrNodeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_portAddrNode
+}
+
+func (*PortAddrNodeContext) IsPortAddrNodeContext() {}
+
+func NewPortAddrNodeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrNodeContext {
+	var p = new(PortAddrNodeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_portAddrNode
+
+	return p
+}
+
+func (s *PortAddrNodeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PortAddrNodeContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *PortAddrNodeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PortAddrNodeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PortAddrNodeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterPortAddrNode(s)
+	}
+}
+
+func (s *PortAddrNodeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitPortAddrNode(s)
+	}
+}
+
+func (p *nevaParser) PortAddrNode() (localctx IPortAddrNodeContext) {
+	localctx = NewPortAddrNodeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 186, nevaParserRULE_portAddrNode)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1219)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPortAddrPortContext is an interface to support dynamic dispatch.
+type IPortAddrPortContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+
+	// IsPortAddrPortContext differentiates from other interfaces.
+	IsPortAddrPortContext()
+}
+
+type PortAddrPortContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPortAddrPortContext() *PortAddrPortContext {
+	var p = new(PortAddrPortContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_portAddrPort
+	return p
+}
+
+func InitEmptyPortAddrPortContext(p *PortAddrPortContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_portAddrPort
+}
+
+func (*PortAddrPortContext) IsPortAddrPortContext() {}
+
+func NewPortAddrPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrPortContext {
+	var p = new(PortAddrPortContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = nevaParserRULE_portAddrPort
+
+	return p
+}
+
+func (s *PortAddrPortContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PortAddrPortContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *PortAddrPortContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PortAddrPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PortAddrPortContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.EnterPortAddrPort(s)
+	}
+}
+
+func (s *PortAddrPortContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(nevaListener); ok {
+		listenerT.ExitPortAddrPort(s)
+	}
+}
+
+func (p *nevaParser) PortAddrPort() (localctx IPortAddrPortContext) {
+	localctx = NewPortAddrPortContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 188, nevaParserRULE_portAddrPort)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1221)
+		p.Match(nevaParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPortAddrIdxContext is an interface to support dynamic dispatch.
+type IPortAddrIdxContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	INT() antlr.TerminalNode
+
+	// IsPortAddrIdxContext differentiates from other interfaces.
+	IsPortAddrIdxContext()
+}
+
+type PortAddrIdxContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPortAddrIdxContext() *PortAddrIdxContext {
+	var p = new(PortAddrIdxContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = nevaParserRULE_portAddrIdx
 	return p
 }
 
-func InitEmptyPortAddrNodeContext(p *PortAddrNodeContext) {
+func InitEmptyPortAddrIdxContext(p *PortAddrIdxContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrNode
+	p.RuleIndex = nevaParserRULE_portAddrIdx
 }
 
-func (*PortAddrNodeContext) IsPortAddrNodeContext() {}
+func (*PortAddrIdxContext) IsPortAddrIdxContext() {}
 
-func NewPortAddrNodeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrNodeContext {
-	var p = new(PortAddrNodeContext)
+func NewPortAddrIdxContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrIdxContext {
+	var p = new(PortAddrIdxContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_portAddrNode
+	p.RuleIndex = nevaParserRULE_portAddrIdx
 
 	return p
 }
 
-func (s *PortAddrNodeContext) GetParser() antlr.Parser { return s.parser }
+func (s *PortAddrIdxContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PortAddrNodeContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *PortAddrIdxContext) INT() antlr.TerminalNode {
+	return s.GetToken(nevaParserINT, 0)
 }
 
-func (s *PortAddrNodeContext) GetRuleContext() antlr.RuleContext {
+func (s *PortAddrIdxContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PortAddrNodeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PortAddrIdxContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PortAddrNodeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PortAddrIdxContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPortAddrNode(s)
+		listenerT.EnterPortAddrIdx(s)
 	}
 }
 
-func (s *PortAddrNodeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PortAddrIdxContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPortAddrNode(s)
+		listenerT.ExitPortAddrIdx(s)
 	}
 }
 
-func (p *nevaParser) PortAddrNode() (localctx IPortAddrNodeContext) {
-	localctx = NewPortAddrNodeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, nevaParserRULE_portAddrNode)
+func (p *nevaParser) PortAddrIdx() (localctx IPortAddrIdxContext) {
+	localctx = NewPortAddrIdxContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 190, nevaParserRULE_portAddrIdx)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1017)
-		p.Match(nevaParserIDENTIFIER)
+		p.SetState(1223)
+		p.Match(nevaParserT__20)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1224)
+		p.Match(nevaParserINT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1225)
+		p.Match(nevaParserT__21)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -15205,88 +17663,135 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPortAddrPortContext is an interface to support dynamic dispatch.
-type IPortAddrPortContext interface {
+// IStructSelectorsContext is an interface to support dynamic dispatch.
+type IStructSelectorsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
 
-	// IsPortAddrPortContext differentiates from other interfaces.
-	IsPortAddrPortContext()
+	// IsStructSelectorsContext differentiates from other interfaces.
+	IsStructSelectorsContext()
 }
 
-type PortAddrPortContext struct {
+type StructSelectorsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPortAddrPortContext() *PortAddrPortContext {
-	var p = new(PortAddrPortContext)
+func NewEmptyStructSelectorsContext() *StructSelectorsContext {
+	var p = new(StructSelectorsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrPort
+	p.RuleIndex = nevaParserRULE_structSelectors
 	return p
 }
 
-func InitEmptyPortAddrPortContext(p *PortAddrPortContext) {
+func InitEmptyStructSelectorsContext(p *StructSelectorsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrPort
+	p.RuleIndex = nevaParserRULE_structSelectors
 }
 
-func (*PortAddrPortContext) IsPortAddrPortContext() {}
+func (*StructSelectorsContext) IsStructSelectorsContext() {}
 
-func NewPortAddrPortContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrPortContext {
-	var p = new(PortAddrPortContext)
+func NewStructSelectorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructSelectorsContext {
+	var p = new(StructSelectorsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_portAddrPort
+	p.RuleIndex = nevaParserRULE_structSelectors
 
 	return p
 }
 
-func (s *PortAddrPortContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructSelectorsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PortAddrPortContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, 0)
+func (s *StructSelectorsContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserIDENTIFIER)
 }
 
-func (s *PortAddrPortContext) GetRuleContext() antlr.RuleContext {
+func (s *StructSelectorsContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, i)
+}
+
+func (s *StructSelectorsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PortAddrPortContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructSelectorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PortAddrPortContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructSelectorsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPortAddrPort(s)
+		listenerT.EnterStructSelectors(s)
 	}
 }
 
-func (s *PortAddrPortContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructSelectorsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPortAddrPort(s)
+		listenerT.ExitStructSelectors(s)
 	}
 }
 
-func (p *nevaParser) PortAddrPort() (localctx IPortAddrPortContext) {
-	localctx = NewPortAddrPortContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, nevaParserRULE_portAddrPort)
+func (p *nevaParser) StructSelectors() (localctx IStructSelectorsContext) {
+	localctx = NewStructSelectorsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 192, nevaParserRULE_structSelectors)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1019)
+		p.SetState(1227)
+		p.Match(nevaParserT__10)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1228)
 		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(1233)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserT__10 {
+		{
+			p.SetState(1229)
+			p.Match(nevaParserT__10)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1230)
+			p.Match(nevaParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(1235)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
 
 errorExit:
 	if p.HasError() {
@@ -15301,103 +17806,179 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPortAddrIdxContext is an interface to support dynamic dispatch.
-type IPortAddrIdxContext interface {
+// ISingleReceiverSideContext is an interface to support dynamic dispatch.
+type ISingleReceiverSideContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	INT() antlr.TerminalNode
+	ChainedNormConn() IChainedNormConnContext
+	PortAddr() IPortAddrContext
+	DeferredConn() IDeferredConnContext
+	SwitchStmt() ISwitchStmtContext
 
-	// IsPortAddrIdxContext differentiates from other interfaces.
-	IsPortAddrIdxContext()
+	// IsSingleReceiverSideContext differentiates from other interfaces.
+	IsSingleReceiverSideContext()
 }
 
-type PortAddrIdxContext struct {
+type SingleReceiverSideContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPortAddrIdxContext() *PortAddrIdxContext {
-	var p = new(PortAddrIdxContext)
+func NewEmptySingleReceiverSideContext() *SingleReceiverSideContext {
+	var p = new(SingleReceiverSideContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrIdx
+	p.RuleIndex = nevaParserRULE_singleReceiverSide
 	return p
 }
 
-func InitEmptyPortAddrIdxContext(p *PortAddrIdxContext) {
+func InitEmptySingleReceiverSideContext(p *SingleReceiverSideContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_portAddrIdx
+	p.RuleIndex = nevaParserRULE_singleReceiverSide
 }
 
-func (*PortAddrIdxContext) IsPortAddrIdxContext() {}
+func (*SingleReceiverSideContext) IsSingleReceiverSideContext() {}
 
-func NewPortAddrIdxContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PortAddrIdxContext {
-	var p = new(PortAddrIdxContext)
+func NewSingleReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleReceiverSideContext {
+	var p = new(SingleReceiverSideContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_portAddrIdx
+	p.RuleIndex = nevaParserRULE_singleReceiverSide
 
 	return p
 }
 
-func (s *PortAddrIdxContext) GetParser() antlr.Parser { return s.parser }
+func (s *SingleReceiverSideContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PortAddrIdxContext) INT() antlr.TerminalNode {
-	return s.GetToken(nevaParserINT, 0)
+func (s *SingleReceiverSideContext) ChainedNormConn() IChainedNormConnContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IChainedNormConnContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IChainedNormConnContext)
 }
 
-func (s *PortAddrIdxContext) GetRuleContext() antlr.RuleContext {
+func (s *SingleReceiverSideContext) PortAddr() IPortAddrContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPortAddrContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPortAddrContext)
+}
+
+func (s *SingleReceiverSideContext) DeferredConn() IDeferredConnContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeferredConnContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeferredConnContext)
+}
+
+func (s *SingleReceiverSideContext) SwitchStmt() ISwitchStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitchStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchStmtContext)
+}
+
+func (s *SingleReceiverSideContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PortAddrIdxContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SingleReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PortAddrIdxContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SingleReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterPortAddrIdx(s)
+		listenerT.EnterSingleReceiverSide(s)
 	}
 }
 
-func (s *PortAddrIdxContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SingleReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitPortAddrIdx(s)
+		listenerT.ExitSingleReceiverSide(s)
 	}
 }
 
-func (p *nevaParser) PortAddrIdx() (localctx IPortAddrIdxContext) {
-	localctx = NewPortAddrIdxContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, nevaParserRULE_portAddrIdx)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(1021)
-		p.Match(nevaParserT__18)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+func (p *nevaParser) SingleReceiverSide() (localctx ISingleReceiverSideContext) {
+	localctx = NewSingleReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 194, nevaParserRULE_singleReceiverSide)
+	p.SetState(1240)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(1022)
-		p.Match(nevaParserINT)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 161, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1236)
+			p.ChainedNormConn()
 		}
-	}
-	{
-		p.SetState(1023)
-		p.Match(nevaParserT__19)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1237)
+			p.PortAddr()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1238)
+			p.DeferredConn()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1239)
+			p.SwitchStmt()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -15413,135 +17994,250 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStructSelectorsContext is an interface to support dynamic dispatch.
-type IStructSelectorsContext interface {
+// IMultipleReceiverSideContext is an interface to support dynamic dispatch.
+type IMultipleReceiverSideContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIDENTIFIER() []antlr.TerminalNode
-	IDENTIFIER(i int) antlr.TerminalNode
+	AllSingleReceiverSide() []ISingleReceiverSideContext
+	SingleReceiverSide(i int) ISingleReceiverSideContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsStructSelectorsContext differentiates from other interfaces.
-	IsStructSelectorsContext()
+	// IsMultipleReceiverSideContext differentiates from other interfaces.
+	IsMultipleReceiverSideContext()
 }
 
-type StructSelectorsContext struct {
+type MultipleReceiverSideContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStructSelectorsContext() *StructSelectorsContext {
-	var p = new(StructSelectorsContext)
+func NewEmptyMultipleReceiverSideContext() *MultipleReceiverSideContext {
+	var p = new(MultipleReceiverSideContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structSelectors
+	p.RuleIndex = nevaParserRULE_multipleReceiverSide
 	return p
 }
 
-func InitEmptyStructSelectorsContext(p *StructSelectorsContext) {
+func InitEmptyMultipleReceiverSideContext(p *MultipleReceiverSideContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_structSelectors
+	p.RuleIndex = nevaParserRULE_multipleReceiverSide
 }
 
-func (*StructSelectorsContext) IsStructSelectorsContext() {}
+func (*MultipleReceiverSideContext) IsMultipleReceiverSideContext() {}
 
-func NewStructSelectorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructSelectorsContext {
-	var p = new(StructSelectorsContext)
+func NewMultipleReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultipleReceiverSideContext {
+	var p = new(MultipleReceiverSideContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_structSelectors
+	p.RuleIndex = nevaParserRULE_multipleReceiverSide
 
 	return p
 }
 
-func (s *StructSelectorsContext) GetParser() antlr.Parser { return s.parser }
+func (s *MultipleReceiverSideContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructSelectorsContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserIDENTIFIER)
+func (s *MultipleReceiverSideContext) AllSingleReceiverSide() []ISingleReceiverSideContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISingleReceiverSideContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISingleReceiverSideContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISingleReceiverSideContext); ok {
+			tst[i] = t.(ISingleReceiverSideContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *StructSelectorsContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserIDENTIFIER, i)
+func (s *MultipleReceiverSideContext) SingleReceiverSide(i int) ISingleReceiverSideContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleReceiverSideContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleReceiverSideContext)
 }
 
-func (s *StructSelectorsContext) GetRuleContext() antlr.RuleContext {
+func (s *MultipleReceiverSideContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *MultipleReceiverSideContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *MultipleReceiverSideContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StructSelectorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MultipleReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StructSelectorsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MultipleReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterStructSelectors(s)
+		listenerT.EnterMultipleReceiverSide(s)
 	}
 }
 
-func (s *StructSelectorsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MultipleReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitStructSelectors(s)
+		listenerT.ExitMultipleReceiverSide(s)
 	}
 }
 
-func (p *nevaParser) StructSelectors() (localctx IStructSelectorsContext) {
-	localctx = NewStructSelectorsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, nevaParserRULE_structSelectors)
+func (p *nevaParser) MultipleReceiverSide() (localctx IMultipleReceiverSideContext) {
+	localctx = NewMultipleReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 196, nevaParserRULE_multipleReceiverSide)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1025)
-		p.Match(nevaParserT__10)
+		p.SetState(1242)
+		p.Match(nevaParserT__20)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(1026)
-		p.Match(nevaParserIDENTIFIER)
+	p.SetState(1246)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(1243)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(1248)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1249)
+		p.SingleReceiverSide()
 	}
-	p.SetState(1031)
+	p.SetState(1266)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserT__10 {
+	for _la == nevaParserT__2 {
 		{
-			p.SetState(1027)
-			p.Match(nevaParserT__10)
+			p.SetState(1250)
+			p.Match(nevaParserT__2)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+		p.SetState(1254)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(1251)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1256)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
 		{
-			p.SetState(1028)
-			p.Match(nevaParserIDENTIFIER)
+			p.SetState(1257)
+			p.SingleReceiverSide()
+		}
+		p.SetState(1261)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == nevaParserNEWLINE {
+			{
+				p.SetState(1258)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1263)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
+			_la = p.GetTokenStream().LA(1)
 		}
 
-		p.SetState(1033)
+		p.SetState(1268)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
+	{
+		p.SetState(1269)
+		p.Match(nevaParserT__21)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -15556,77 +18252,87 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISingleReceiverSideContext is an interface to support dynamic dispatch.
-type ISingleReceiverSideContext interface {
+// ISwitchStmtContext is an interface to support dynamic dispatch.
+type ISwitchStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ChainedNormConn() IChainedNormConnContext
-	PortAddr() IPortAddrContext
-	DeferredConn() IDeferredConnContext
-	SwitchStmt() ISwitchStmtContext
+	AllNormConnDef() []INormConnDefContext
+	NormConnDef(i int) INormConnDefContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	DefaultCase() IDefaultCaseContext
 
-	// IsSingleReceiverSideContext differentiates from other interfaces.
-	IsSingleReceiverSideContext()
+	// IsSwitchStmtContext differentiates from other interfaces.
+	IsSwitchStmtContext()
 }
 
-type SingleReceiverSideContext struct {
+type SwitchStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
-
-func NewEmptySingleReceiverSideContext() *SingleReceiverSideContext {
-	var p = new(SingleReceiverSideContext)
+
+func NewEmptySwitchStmtContext() *SwitchStmtContext {
+	var p = new(SwitchStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singleReceiverSide
+	p.RuleIndex = nevaParserRULE_switchStmt
 	return p
 }
 
-func InitEmptySingleReceiverSideContext(p *SingleReceiverSideContext) {
+func InitEmptySwitchStmtContext(p *SwitchStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_singleReceiverSide
+	p.RuleIndex = nevaParserRULE_switchStmt
 }
 
-func (*SingleReceiverSideContext) IsSingleReceiverSideContext() {}
+func (*SwitchStmtContext) IsSwitchStmtContext() {}
 
-func NewSingleReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleReceiverSideContext {
-	var p = new(SingleReceiverSideContext)
+func NewSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStmtContext {
+	var p = new(SwitchStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_singleReceiverSide
+	p.RuleIndex = nevaParserRULE_switchStmt
 
 	return p
 }
 
-func (s *SingleReceiverSideContext) GetParser() antlr.Parser { return s.parser }
+func (s *SwitchStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SingleReceiverSideContext) ChainedNormConn() IChainedNormConnContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IChainedNormConnContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *SwitchStmtContext) AllNormConnDef() []INormConnDefContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INormConnDefContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]INormConnDefContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INormConnDefContext); ok {
+			tst[i] = t.(INormConnDefContext)
+			i++
+		}
 	}
 
-	return t.(IChainedNormConnContext)
+	return tst
 }
 
-func (s *SingleReceiverSideContext) PortAddr() IPortAddrContext {
+func (s *SwitchStmtContext) NormConnDef(i int) INormConnDefContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPortAddrContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(INormConnDefContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -15634,29 +18340,21 @@ func (s *SingleReceiverSideContext) PortAddr() IPortAddrContext {
 		return nil
 	}
 
-	return t.(IPortAddrContext)
+	return t.(INormConnDefContext)
 }
 
-func (s *SingleReceiverSideContext) DeferredConn() IDeferredConnContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDeferredConnContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *SwitchStmtContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
 
-	return t.(IDeferredConnContext)
+func (s *SwitchStmtContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
 }
 
-func (s *SingleReceiverSideContext) SwitchStmt() ISwitchStmtContext {
+func (s *SwitchStmtContext) DefaultCase() IDefaultCaseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISwitchStmtContext); ok {
+		if _, ok := ctx.(IDefaultCaseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -15666,69 +18364,223 @@ func (s *SingleReceiverSideContext) SwitchStmt() ISwitchStmtContext {
 		return nil
 	}
 
-	return t.(ISwitchStmtContext)
+	return t.(IDefaultCaseContext)
 }
 
-func (s *SingleReceiverSideContext) GetRuleContext() antlr.RuleContext {
+func (s *SwitchStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SingleReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SingleReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterSingleReceiverSide(s)
+		listenerT.EnterSwitchStmt(s)
 	}
 }
 
-func (s *SingleReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitSingleReceiverSide(s)
+		listenerT.ExitSwitchStmt(s)
 	}
 }
 
-func (p *nevaParser) SingleReceiverSide() (localctx ISingleReceiverSideContext) {
-	localctx = NewSingleReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, nevaParserRULE_singleReceiverSide)
-	p.SetState(1038)
+func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
+	localctx = NewSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 198, nevaParserRULE_switchStmt)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1271)
+		p.Match(nevaParserT__50)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1275)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 132, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(1034)
-			p.ChainedNormConn()
+			p.SetState(1272)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+		p.SetState(1277)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1278)
+		p.Match(nevaParserT__5)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1282)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(1035)
-			p.PortAddr()
+			p.SetState(1279)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+		p.SetState(1284)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1285)
+		p.NormConnDef()
+	}
+	p.SetState(1294)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 169, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(1287)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for ok := true; ok; ok = _la == nevaParserNEWLINE {
+				{
+					p.SetState(1286)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+				p.SetState(1289)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(1291)
+				p.NormConnDef()
+			}
+
+		}
+		p.SetState(1296)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 169, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(1303)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 171, p.GetParserRuleContext()) == 1 {
+		p.SetState(1298)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == nevaParserNEWLINE {
+			{
+				p.SetState(1297)
+				p.Match(nevaParserNEWLINE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1300)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
 		{
-			p.SetState(1036)
-			p.DeferredConn()
+			p.SetState(1302)
+			p.DefaultCase()
 		}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	p.SetState(1308)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(1037)
-			p.SwitchStmt()
+			p.SetState(1305)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(1310)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1311)
+		p.Match(nevaParserT__6)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -15744,86 +18596,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMultipleReceiverSideContext is an interface to support dynamic dispatch.
-type IMultipleReceiverSideContext interface {
+// IDefaultCaseContext is an interface to support dynamic dispatch.
+type IDefaultCaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllSingleReceiverSide() []ISingleReceiverSideContext
-	SingleReceiverSide(i int) ISingleReceiverSideContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
+	ReceiverSide() IReceiverSideContext
 
-	// IsMultipleReceiverSideContext differentiates from other interfaces.
-	IsMultipleReceiverSideContext()
+	// IsDefaultCaseContext differentiates from other interfaces.
+	IsDefaultCaseContext()
 }
 
-type MultipleReceiverSideContext struct {
+type DefaultCaseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMultipleReceiverSideContext() *MultipleReceiverSideContext {
-	var p = new(MultipleReceiverSideContext)
+func NewEmptyDefaultCaseContext() *DefaultCaseContext {
+	var p = new(DefaultCaseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_multipleReceiverSide
+	p.RuleIndex = nevaParserRULE_defaultCase
 	return p
 }
 
-func InitEmptyMultipleReceiverSideContext(p *MultipleReceiverSideContext) {
+func InitEmptyDefaultCaseContext(p *DefaultCaseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_multipleReceiverSide
+	p.RuleIndex = nevaParserRULE_defaultCase
 }
 
-func (*MultipleReceiverSideContext) IsMultipleReceiverSideContext() {}
+func (*DefaultCaseContext) IsDefaultCaseContext() {}
 
-func NewMultipleReceiverSideContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultipleReceiverSideContext {
-	var p = new(MultipleReceiverSideContext)
+func NewDefaultCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DefaultCaseContext {
+	var p = new(DefaultCaseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_multipleReceiverSide
+	p.RuleIndex = nevaParserRULE_defaultCase
 
 	return p
 }
 
-func (s *MultipleReceiverSideContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MultipleReceiverSideContext) AllSingleReceiverSide() []ISingleReceiverSideContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISingleReceiverSideContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ISingleReceiverSideContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISingleReceiverSideContext); ok {
-			tst[i] = t.(ISingleReceiverSideContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *DefaultCaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MultipleReceiverSideContext) SingleReceiverSide(i int) ISingleReceiverSideContext {
+func (s *DefaultCaseContext) ReceiverSide() IReceiverSideContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleReceiverSideContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IReceiverSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -15831,162 +18655,52 @@ func (s *MultipleReceiverSideContext) SingleReceiverSide(i int) ISingleReceiverS
 		return nil
 	}
 
-	return t.(ISingleReceiverSideContext)
-}
-
-func (s *MultipleReceiverSideContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *MultipleReceiverSideContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
+	return t.(IReceiverSideContext)
 }
 
-func (s *MultipleReceiverSideContext) GetRuleContext() antlr.RuleContext {
+func (s *DefaultCaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MultipleReceiverSideContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DefaultCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MultipleReceiverSideContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DefaultCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterMultipleReceiverSide(s)
+		listenerT.EnterDefaultCase(s)
 	}
 }
 
-func (s *MultipleReceiverSideContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DefaultCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitMultipleReceiverSide(s)
+		listenerT.ExitDefaultCase(s)
 	}
 }
 
-func (p *nevaParser) MultipleReceiverSide() (localctx IMultipleReceiverSideContext) {
-	localctx = NewMultipleReceiverSideContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, nevaParserRULE_multipleReceiverSide)
-	var _la int
-
+func (p *nevaParser) DefaultCase() (localctx IDefaultCaseContext) {
+	localctx = NewDefaultCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 200, nevaParserRULE_defaultCase)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1040)
-		p.Match(nevaParserT__18)
+		p.SetState(1313)
+		p.Match(nevaParserT__51)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1044)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(1041)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-		p.SetState(1046)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
 	{
-		p.SetState(1047)
-		p.SingleReceiverSide()
-	}
-	p.SetState(1064)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserT__2 {
-		{
-			p.SetState(1048)
-			p.Match(nevaParserT__2)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		p.SetState(1052)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == nevaParserNEWLINE {
-			{
-				p.SetState(1049)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-			p.SetState(1054)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(1055)
-			p.SingleReceiverSide()
-		}
-		p.SetState(1059)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == nevaParserNEWLINE {
-			{
-				p.SetState(1056)
-				p.Match(nevaParserNEWLINE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-			p.SetState(1061)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
-		p.SetState(1066)
-		p.GetErrorHandler().Sync(p)
+		p.SetState(1314)
+		p.Match(nevaParserT__30)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1067)
-		p.Match(nevaParserT__19)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(1315)
+		p.ReceiverSide()
 	}
 
 errorExit:
@@ -16002,70 +18716,77 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISwitchStmtContext is an interface to support dynamic dispatch.
-type ISwitchStmtContext interface {
+// IListSenderLitContext is an interface to support dynamic dispatch.
+type IListSenderLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNormConnDef() []INormConnDefContext
-	NormConnDef(i int) INormConnDefContext
 	AllNEWLINE() []antlr.TerminalNode
 	NEWLINE(i int) antlr.TerminalNode
-	DefaultCase() IDefaultCaseContext
+	AllConstLit() []IConstLitContext
+	ConstLit(i int) IConstLitContext
 
-	// IsSwitchStmtContext differentiates from other interfaces.
-	IsSwitchStmtContext()
+	// IsListSenderLitContext differentiates from other interfaces.
+	IsListSenderLitContext()
 }
 
-type SwitchStmtContext struct {
+type ListSenderLitContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySwitchStmtContext() *SwitchStmtContext {
-	var p = new(SwitchStmtContext)
+func NewEmptyListSenderLitContext() *ListSenderLitContext {
+	var p = new(ListSenderLitContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_switchStmt
+	p.RuleIndex = nevaParserRULE_listSenderLit
 	return p
 }
 
-func InitEmptySwitchStmtContext(p *SwitchStmtContext) {
+func InitEmptyListSenderLitContext(p *ListSenderLitContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_switchStmt
+	p.RuleIndex = nevaParserRULE_listSenderLit
 }
 
-func (*SwitchStmtContext) IsSwitchStmtContext() {}
+func (*ListSenderLitContext) IsListSenderLitContext() {}
 
-func NewSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStmtContext {
-	var p = new(SwitchStmtContext)
+func NewListSenderLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListSenderLitContext {
+	var p = new(ListSenderLitContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_switchStmt
+	p.RuleIndex = nevaParserRULE_listSenderLit
 
 	return p
 }
 
-func (s *SwitchStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ListSenderLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SwitchStmtContext) AllNormConnDef() []INormConnDefContext {
+func (s *ListSenderLitContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *ListSenderLitContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *ListSenderLitContext) AllConstLit() []IConstLitContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(INormConnDefContext); ok {
+		if _, ok := ctx.(IConstLitContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]INormConnDefContext, len)
+	tst := make([]IConstLitContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(INormConnDefContext); ok {
-			tst[i] = t.(INormConnDefContext)
+		if t, ok := ctx.(IConstLitContext); ok {
+			tst[i] = t.(IConstLitContext)
 			i++
 		}
 	}
@@ -16073,40 +18794,16 @@ func (s *SwitchStmtContext) AllNormConnDef() []INormConnDefContext {
 	return tst
 }
 
-func (s *SwitchStmtContext) NormConnDef(i int) INormConnDefContext {
+func (s *ListSenderLitContext) ConstLit(i int) IConstLitContext {
 	var t antlr.RuleContext
 	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INormConnDefContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INormConnDefContext)
-}
-
-func (s *SwitchStmtContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(nevaParserNEWLINE)
-}
-
-func (s *SwitchStmtContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(nevaParserNEWLINE, i)
-}
-
-func (s *SwitchStmtContext) DefaultCase() IDefaultCaseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDefaultCaseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstLitContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -16114,46 +18811,44 @@ func (s *SwitchStmtContext) DefaultCase() IDefaultCaseContext {
 		return nil
 	}
 
-	return t.(IDefaultCaseContext)
+	return t.(IConstLitContext)
 }
 
-func (s *SwitchStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ListSenderLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ListSenderLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ListSenderLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterSwitchStmt(s)
+		listenerT.EnterListSenderLit(s)
 	}
 }
 
-func (s *SwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ListSenderLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitSwitchStmt(s)
+		listenerT.ExitListSenderLit(s)
 	}
 }
 
-func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
-	localctx = NewSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, nevaParserRULE_switchStmt)
+func (p *nevaParser) ListSenderLit() (localctx IListSenderLitContext) {
+	localctx = NewListSenderLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 202, nevaParserRULE_listSenderLit)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1069)
-		p.Match(nevaParserT__47)
+		p.SetState(1317)
+		p.Match(nevaParserT__20)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1073)
+	p.SetState(1321)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -16162,7 +18857,7 @@ func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
 
 	for _la == nevaParserNEWLINE {
 		{
-			p.SetState(1070)
+			p.SetState(1318)
 			p.Match(nevaParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16170,70 +18865,51 @@ func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
 			}
 		}
 
-		p.SetState(1075)
+		p.SetState(1323)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(1076)
-		p.Match(nevaParserT__5)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(1080)
+	p.SetState(1344)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == nevaParserNEWLINE {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1116892707690643520) != 0 {
 		{
-			p.SetState(1077)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(1324)
+			p.ConstLit()
 		}
-
-		p.SetState(1082)
+		p.SetState(1341)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(1083)
-		p.NormConnDef()
-	}
-	p.SetState(1092)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 140, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			p.SetState(1085)
+
+		for _la == nevaParserT__2 {
+			{
+				p.SetState(1325)
+				p.Match(nevaParserT__2)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(1329)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
 
-			for ok := true; ok; ok = _la == nevaParserNEWLINE {
+			for _la == nevaParserNEWLINE {
 				{
-					p.SetState(1084)
+					p.SetState(1326)
 					p.Match(nevaParserNEWLINE)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -16241,7 +18917,7 @@ func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
 					}
 				}
 
-				p.SetState(1087)
+				p.SetState(1331)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -16249,84 +18925,46 @@ func (p *nevaParser) SwitchStmt() (localctx ISwitchStmtContext) {
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(1089)
-				p.NormConnDef()
+				p.SetState(1332)
+				p.ConstLit()
 			}
+			p.SetState(1336)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 
-		}
-		p.SetState(1094)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 140, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-	}
-	p.SetState(1101)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 142, p.GetParserRuleContext()) == 1 {
-		p.SetState(1096)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+			for _la == nevaParserNEWLINE {
+				{
+					p.SetState(1333)
+					p.Match(nevaParserNEWLINE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
 
-		for ok := true; ok; ok = _la == nevaParserNEWLINE {
-			{
-				p.SetState(1095)
-				p.Match(nevaParserNEWLINE)
+				p.SetState(1338)
+				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
-					// Recognition error - abort rule
 					goto errorExit
 				}
+				_la = p.GetTokenStream().LA(1)
 			}
 
-			p.SetState(1098)
+			p.SetState(1343)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
 		}
-		{
-			p.SetState(1100)
-			p.DefaultCase()
-		}
-
-	} else if p.HasError() { // JIM
-		goto errorExit
-	}
-	p.SetState(1106)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == nevaParserNEWLINE {
-		{
-			p.SetState(1103)
-			p.Match(nevaParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
 
-		p.SetState(1108)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1109)
-		p.Match(nevaParserT__6)
+		p.SetState(1346)
+		p.Match(nevaParserT__21)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -16346,56 +18984,60 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDefaultCaseContext is an interface to support dynamic dispatch.
-type IDefaultCaseContext interface {
+// IUnionSenderContext is an interface to support dynamic dispatch.
+type IUnionSenderContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ReceiverSide() IReceiverSideContext
+	EntityRef() IEntityRefContext
+	IDENTIFIER() antlr.TerminalNode
+	SingleSenderSide() ISingleSenderSideContext
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
 
-	// IsDefaultCaseContext differentiates from other interfaces.
-	IsDefaultCaseContext()
+	// IsUnionSenderContext differentiates from other interfaces.
+	IsUnionSenderContext()
 }
 
-type DefaultCaseContext struct {
+type UnionSenderContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDefaultCaseContext() *DefaultCaseContext {
-	var p = new(DefaultCaseContext)
+func NewEmptyUnionSenderContext() *UnionSenderContext {
+	var p = new(UnionSenderContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_defaultCase
+	p.RuleIndex = nevaParserRULE_unionSender
 	return p
 }
 
-func InitEmptyDefaultCaseContext(p *DefaultCaseContext) {
+func InitEmptyUnionSenderContext(p *UnionSenderContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = nevaParserRULE_defaultCase
+	p.RuleIndex = nevaParserRULE_unionSender
 }
 
-func (*DefaultCaseContext) IsDefaultCaseContext() {}
+func (*UnionSenderContext) IsUnionSenderContext() {}
 
-func NewDefaultCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DefaultCaseContext {
-	var p = new(DefaultCaseContext)
+func NewUnionSenderContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionSenderContext {
+	var p = new(UnionSenderContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = nevaParserRULE_defaultCase
+	p.RuleIndex = nevaParserRULE_unionSender
 
 	return p
 }
 
-func (s *DefaultCaseContext) GetParser() antlr.Parser { return s.parser }
+func (s *UnionSenderContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DefaultCaseContext) ReceiverSide() IReceiverSideContext {
+func (s *UnionSenderContext) EntityRef() IEntityRefContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IReceiverSideContext); ok {
+		if _, ok := ctx.(IEntityRefContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -16405,52 +19047,150 @@ func (s *DefaultCaseContext) ReceiverSide() IReceiverSideContext {
 		return nil
 	}
 
-	return t.(IReceiverSideContext)
+	return t.(IEntityRefContext)
 }
 
-func (s *DefaultCaseContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionSenderContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(nevaParserIDENTIFIER, 0)
+}
+
+func (s *UnionSenderContext) SingleSenderSide() ISingleSenderSideContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleSenderSideContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleSenderSideContext)
+}
+
+func (s *UnionSenderContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(nevaParserNEWLINE)
+}
+
+func (s *UnionSenderContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(nevaParserNEWLINE, i)
+}
+
+func (s *UnionSenderContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DefaultCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnionSenderContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DefaultCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionSenderContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.EnterDefaultCase(s)
+		listenerT.EnterUnionSender(s)
 	}
 }
 
-func (s *DefaultCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionSenderContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(nevaListener); ok {
-		listenerT.ExitDefaultCase(s)
+		listenerT.ExitUnionSender(s)
 	}
 }
 
-func (p *nevaParser) DefaultCase() (localctx IDefaultCaseContext) {
-	localctx = NewDefaultCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, nevaParserRULE_defaultCase)
+func (p *nevaParser) UnionSender() (localctx IUnionSenderContext) {
+	localctx = NewUnionSenderContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 204, nevaParserRULE_unionSender)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1111)
-		p.Match(nevaParserT__48)
+		p.SetState(1348)
+		p.EntityRef()
+	}
+	{
+		p.SetState(1349)
+		p.Match(nevaParserT__26)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(1112)
-		p.Match(nevaParserT__28)
+		p.SetState(1350)
+		p.Match(nevaParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(1113)
-		p.ReceiverSide()
+		p.SetState(1351)
+		p.Match(nevaParserT__1)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1355)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(1352)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(1357)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1358)
+		p.SingleSenderSide()
+	}
+	p.SetState(1362)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == nevaParserNEWLINE {
+		{
+			p.SetState(1359)
+			p.Match(nevaParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(1364)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1365)
+		p.Match(nevaParserT__3)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
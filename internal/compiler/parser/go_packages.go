@@ -0,0 +1,109 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+
+	"github.com/nevalang/neva/internal/compiler"
+	src "github.com/nevalang/neva/internal/compiler/sourcecode"
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+)
+
+// goRegistryFuncName is the name of the function that every Go package of the module must declare.
+// It must return map literal with runtime function refs as keys and func creators as values.
+const goRegistryFuncName = "Registry"
+
+// ParseGoPackages finds out which runtime functions are implemented by module's Go packages.
+// It doesn't type-check Go code, that's done by Go compiler after backend emits the program.
+func (p Parser) ParseGoPackages(
+	modRef core.ModuleRef,
+	rawPkgs map[string]compiler.RawPackage,
+) (map[string]src.GoPackage, *compiler.Error) {
+	if len(rawPkgs) == 0 {
+		return nil, nil
+	}
+
+	goPkgs := make(map[string]src.GoPackage, len(rawPkgs))
+
+	for pkgPath, files := range rawPkgs {
+		externs, err := p.parseGoPackage(files)
+		if err != nil {
+			return nil, &compiler.Error{
+				Message: fmt.Sprintf("go package %v: %v", pkgPath, err),
+				Meta: &core.Meta{
+					Location: core.Location{ModRef: modRef},
+				},
+			}
+		}
+
+		goPkgs[pkgPath] = src.GoPackage{
+			Files:   files,
+			Externs: externs,
+		}
+	}
+
+	return goPkgs, nil
+}
+
+func (Parser) parseGoPackage(files map[string][]byte) ([]string, error) {
+	fset := token.NewFileSet()
+
+	var (
+		externs []string
+		found   bool
+	)
+
+	for fileName, content := range files {
+		file, err := goparser.ParseFile(fset, fileName, content, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != goRegistryFuncName {
+				continue
+			}
+
+			found = true
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				lit, ok := n.(*ast.CompositeLit)
+				if !ok {
+					return true
+				}
+				if _, isMap := lit.Type.(*ast.MapType); !isMap {
+					return true
+				}
+				for _, el := range lit.Elts {
+					kv, ok := el.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					key, ok := kv.Key.(*ast.BasicLit)
+					if !ok || key.Kind != token.STRING {
+						continue
+					}
+					ref, err := strconv.Unquote(key.Value)
+					if err != nil {
+						continue
+					}
+					externs = append(externs, ref)
+				}
+				return false
+			})
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%v function not found", goRegistryFuncName)
+	}
+
+	sort.Strings(externs)
+
+	return externs, nil
+}
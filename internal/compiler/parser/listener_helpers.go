@@ -2,8 +2,10 @@ package parser
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/nevalang/neva/internal/compiler"
@@ -53,12 +55,19 @@ func (s *treeShapeListener) parseImport(actx generated.IImportDefContext) (src.I
 func (s *treeShapeListener) parseTypeParams(
 	params generated.ITypeParamsContext,
 ) (src.TypeParams, *compiler.Error) {
-	if params == nil || params.TypeParamList() == nil {
+	if params == nil {
 		return src.TypeParams{}, nil
 	}
 
-	typeParams := params.TypeParamList().AllTypeParam()
-	result := make([]ts.Param, 0, len(typeParams))
+	// last param with nested type arguments closed by '>>' is parsed separately
+	typeParams := params.AllTypeParam()
+	if list := params.TypeParamList(); list != nil {
+		typeParams = list.AllTypeParam()
+	} else if params.OpenTypeExpr() == nil {
+		return src.TypeParams{}, nil
+	}
+
+	result := make([]ts.Param, 0, len(typeParams)+1)
 	for _, typeParam := range typeParams {
 		v, err := s.parseTypeExpr(typeParam.TypeExpr())
 		if err != nil {
@@ -70,6 +79,17 @@ func (s *treeShapeListener) parseTypeParams(
 		})
 	}
 
+	if openExpr := params.OpenTypeExpr(); openExpr != nil {
+		v, err := s.parseOpenTypeExpr(openExpr)
+		if err != nil {
+			return src.TypeParams{}, err
+		}
+		result = append(result, ts.Param{
+			Name:   params.IDENTIFIER().GetText(),
+			Constr: v,
+		})
+	}
+
 	return src.TypeParams{
 		Params: result,
 		Meta: core.Meta{
@@ -222,12 +242,15 @@ func (s *treeShapeListener) parseUnionExpr(unionExpr generated.IUnionTypeExprCon
 func (s *treeShapeListener) parseLitExpr(litExpr generated.ITypeLitExprContext) (*ts.Expr, *compiler.Error) {
 	enumExpr := litExpr.EnumTypeExpr()
 	structExpr := litExpr.StructTypeExpr()
+	unionExpr := litExpr.TaggedUnionTypeExpr()
 
 	switch {
 	case enumExpr != nil:
 		return s.parseEnumExpr(enumExpr), nil
 	case structExpr != nil:
 		return s.parseStructExpr(structExpr)
+	case unionExpr != nil:
+		return s.parseTaggedUnionExpr(unionExpr)
 	}
 
 	return nil, &compiler.Error{
@@ -314,6 +337,45 @@ func (s *treeShapeListener) parseStructExpr(
 	return &result, nil
 }
 
+func (s *treeShapeListener) parseTaggedUnionExpr(
+	unionExpr generated.ITaggedUnionTypeExprContext,
+) (*ts.Expr, *compiler.Error) {
+	result := ts.Expr{
+		Lit: &ts.LitExpr{
+			Tagged: []ts.UnionTag{},
+		},
+	}
+
+	if unionTags := unionExpr.UnionTags(); unionTags != nil {
+		for _, tag := range unionTags.AllUnionTag() {
+			parsedTag := ts.UnionTag{Name: tag.IDENTIFIER().GetText()}
+			if tag.TypeExpr() != nil {
+				v, err := s.parseTypeExpr(tag.TypeExpr())
+				if err != nil {
+					return nil, err
+				}
+				parsedTag.Type = &v
+			}
+			result.Lit.Tagged = append(result.Lit.Tagged, parsedTag)
+		}
+	}
+
+	result.Meta = core.Meta{
+		Text: unionExpr.GetText(),
+		Start: core.Position{
+			Line:   unionExpr.GetStart().GetLine(),
+			Column: unionExpr.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   unionExpr.GetStop().GetLine(),
+			Column: unionExpr.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	return &result, nil
+}
+
 func (s *treeShapeListener) parseTypeInstExpr(instExpr generated.ITypeInstExprContext) (*ts.Expr, *compiler.Error) {
 	parsedRef, err := s.parseEntityRef(instExpr.EntityRef())
 	if err != nil {
@@ -345,14 +407,9 @@ func (s *treeShapeListener) parseTypeInstExpr(instExpr generated.ITypeInstExprCo
 		return &result, nil
 	}
 
-	argExprs := args.AllTypeExpr()
-	parsedArgs := make([]ts.Expr, 0, len(argExprs))
-	for _, arg := range argExprs {
-		v, err := s.parseTypeExpr(arg)
-		if err != nil {
-			return nil, err
-		}
-		parsedArgs = append(parsedArgs, v)
+	parsedArgs, err := s.parseTypeArgs(args)
+	if err != nil {
+		return nil, err
 	}
 	result.Inst.Args = parsedArgs
 
@@ -372,6 +429,101 @@ func (s *treeShapeListener) parseTypeInstExpr(instExpr generated.ITypeInstExprCo
 	return &result, nil
 }
 
+func (s *treeShapeListener) parseTypeArgs(args generated.ITypeArgsContext) ([]ts.Expr, *compiler.Error) {
+	result, err := s.parseTypeExprs(args.AllTypeExpr())
+	if err != nil {
+		return nil, err
+	}
+
+	if openExpr := args.OpenTypeExpr(); openExpr != nil {
+		v, err := s.parseOpenTypeExpr(openExpr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+// parseOpenTypeExpr parses expression like `int | list<string` where the last
+// type arguments are closed by '>>' that also closes the enclosing list.
+func (s *treeShapeListener) parseOpenTypeExpr(expr generated.IOpenTypeExprContext) (ts.Expr, *compiler.Error) {
+	ref, err := s.parseEntityRef(expr.EntityRef())
+	if err != nil {
+		return ts.Expr{}, err
+	}
+
+	args, err := s.parseTypeExprs(expr.AllTypeExpr())
+	if err != nil {
+		return ts.Expr{}, err
+	}
+
+	argTexts := make([]string, 0, len(args))
+	for _, arg := range expr.AllTypeExpr() {
+		argTexts = append(argTexts, arg.GetText())
+	}
+
+	stop := core.Position{
+		Line:   expr.GetStop().GetLine(),
+		Column: expr.GetStop().GetColumn(),
+	}
+
+	result := ts.Expr{
+		Inst: &ts.InstExpr{
+			Ref:  ref,
+			Args: args,
+		},
+		Meta: core.Meta{
+			Text: expr.EntityRef().GetText() + "<" + strings.Join(argTexts, ",") + ">",
+			Start: core.Position{
+				Line:   expr.EntityRef().GetStart().GetLine(),
+				Column: expr.EntityRef().GetStart().GetColumn(),
+			},
+			Stop:     stop,
+			Location: s.loc,
+		},
+	}
+
+	subExprs := expr.AllNonUnionTypeExpr()
+	if len(subExprs) == 0 {
+		return result, nil
+	}
+
+	union := make([]ts.Expr, 0, len(subExprs)+1)
+	for _, subExpr := range subExprs {
+		if instExpr := subExpr.TypeInstExpr(); instExpr != nil {
+			v, err := s.parseTypeInstExpr(instExpr)
+			if err != nil {
+				return ts.Expr{}, err
+			}
+			union = append(union, *v)
+		}
+		if litExpr := subExpr.TypeLitExpr(); litExpr != nil {
+			v, err := s.parseLitExpr(litExpr)
+			if err != nil {
+				return ts.Expr{}, err
+			}
+			union = append(union, *v)
+		}
+	}
+
+	return ts.Expr{
+		Lit: &ts.LitExpr{
+			Union: append(union, result),
+		},
+		Meta: core.Meta{
+			Text: expr.GetText() + ">",
+			Start: core.Position{
+				Line:   expr.GetStart().GetLine(),
+				Column: expr.GetStart().GetColumn(),
+			},
+			Stop:     stop,
+			Location: s.loc,
+		},
+	}, nil
+}
+
 func (s *treeShapeListener) parseEntityRef(expr generated.IEntityRefContext) (core.EntityRef, *compiler.Error) {
 	meta := core.Meta{
 		Text: expr.GetText(),
@@ -501,17 +653,30 @@ func (s *treeShapeListener) parseInterfaceDef(
 	}, nil
 }
 
+// parseNodes parses node definitions. Path is used to name anonymous components,
+// it's component name for root level nodes and slash separated path of the node for DI arguments.
 func (s *treeShapeListener) parseNodes(
 	actx generated.ICompNodesDefBodyContext,
+	path string,
 	isRootLevel bool,
 ) (map[string]src.Node, *compiler.Error) {
 	result := map[string]src.Node{}
 
 	for _, node := range actx.AllCompNodeDef() {
-		nodeInst := node.NodeInst()
-
 		directives := s.parseCompilerDirectives(node.CompilerDirectives())
 
+		if anonDef := node.AnonCompDef(); anonDef != nil {
+			nodeName, anonNode, err := s.parseAnonNode(node, anonDef, path, isRootLevel)
+			if err != nil {
+				return nil, err
+			}
+			anonNode.Directives = directives
+			result[nodeName] = anonNode
+			continue
+		}
+
+		nodeInst := node.NodeInst()
+
 		parsedRef, err := s.parseEntityRef(nodeInst.EntityRef())
 		if err != nil {
 			return nil, &compiler.Error{
@@ -533,7 +698,7 @@ func (s *treeShapeListener) parseNodes(
 
 		var typeArgs []ts.Expr
 		if args := nodeInst.TypeArgs(); args != nil {
-			v, err := s.parseTypeExprs(args.AllTypeExpr())
+			v, err := s.parseTypeArgs(args)
 			if err != nil {
 				return nil, err
 			}
@@ -545,22 +710,22 @@ func (s *treeShapeListener) parseNodes(
 			errGuard = true
 		}
 
+		var nodeName string
+		if id := node.IDENTIFIER(); id != nil {
+			nodeName = id.GetText()
+		} else if isRootLevel {
+			nodeName = strings.ToLower(string(parsedRef.Name[0])) + parsedRef.Name[1:]
+		}
+
 		var deps map[string]src.Node
 		if diArgs := nodeInst.NodeDIArgs(); diArgs != nil {
-			v, err := s.parseNodes(diArgs.CompNodesDefBody(), false)
+			v, err := s.parseNodes(diArgs.CompNodesDefBody(), path+"/"+nodeName, false)
 			if err != nil {
 				return nil, err
 			}
 			deps = v
 		}
 
-		var nodeName string
-		if id := node.IDENTIFIER(); id != nil {
-			nodeName = id.GetText()
-		} else if isRootLevel {
-			nodeName = strings.ToLower(string(parsedRef.Name[0])) + parsedRef.Name[1:]
-		}
-
 		result[nodeName] = src.Node{
 			Directives: directives,
 			EntityRef:  parsedRef,
@@ -585,6 +750,82 @@ func (s *treeShapeListener) parseNodes(
 	return result, nil
 }
 
+// parseAnonNode hoists anonymous component `def(...) (...) { ... }` into the file
+// as a private entity and returns node that refers to it.
+func (s *treeShapeListener) parseAnonNode(
+	node generated.ICompNodeDefContext,
+	anonDef generated.IAnonCompDefContext,
+	path string,
+	isRootLevel bool,
+) (string, src.Node, *compiler.Error) {
+	meta := core.Meta{
+		Text: node.GetText(),
+		Start: core.Position{
+			Line:   node.GetStart().GetLine(),
+			Column: node.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   node.GetStop().GetLine(),
+			Column: node.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	if isRootLevel {
+		return "", src.Node{}, &compiler.Error{
+			Message: "Anonymous component can only be used as dependency injection argument",
+			Meta:    &meta,
+		}
+	}
+
+	var nodeName string
+	if id := node.IDENTIFIER(); id != nil {
+		nodeName = id.GetText()
+	}
+	// node names can't contain slash so paths are unique, unnamed argument is an empty segment
+	path = path + "/" + nodeName
+
+	in, err := s.parsePorts(anonDef.InPortsDef().PortsDef().AllPortDef())
+	if err != nil {
+		return "", src.Node{}, err
+	}
+	out, err := s.parsePorts(anonDef.OutPortsDef().PortsDef().AllPortDef())
+	if err != nil {
+		return "", src.Node{}, err
+	}
+
+	iface := src.Interface{
+		IO: src.IO{
+			In:   in,
+			Out:  out,
+			Meta: meta,
+		},
+		Meta: meta,
+	}
+
+	entity, err := s.parseCompBody(anonDef.CompBody(), iface, path, meta)
+	if err != nil {
+		return "", src.Node{}, err
+	}
+
+	entityName := "__anon__" + path
+	if _, ok := s.state.Entities[entityName]; ok {
+		return "", src.Node{}, &compiler.Error{
+			Message: fmt.Sprintf("Entity %v already exists", entityName),
+			Meta:    &meta,
+		}
+	}
+	s.state.Entities[entityName] = entity
+
+	return nodeName, src.Node{
+		EntityRef: core.EntityRef{
+			Name: entityName,
+			Meta: meta,
+		},
+		Meta: meta,
+	}, nil
+}
+
 func (s *treeShapeListener) parseTypeExprs(
 	in []generated.ITypeExprContext,
 ) ([]ts.Expr, *compiler.Error) {
@@ -813,16 +1054,11 @@ func (s *treeShapeListener) parsePrimitiveConstLiteral(
 		}
 		parsedConst.Value.Message.Float = &parsedFloat
 	case lit.STRING() != nil:
-		parsedConst.Value.Message.Str = compiler.Pointer(
-			strings.Trim(
-				strings.ReplaceAll(
-					lit.STRING().GetText(),
-					"\\n",
-					"\n",
-				),
-				"'",
-			),
-		)
+		str, err := s.parseStringLit(lit.STRING())
+		if err != nil {
+			return src.Const{}, err
+		}
+		parsedConst.Value.Message.Str = &str
 		parsedConst.TypeExpr.Inst = &ts.InstExpr{
 			Ref: core.EntityRef{Name: "string"},
 		}
@@ -932,16 +1168,11 @@ func (s *treeShapeListener) parseMessage(
 		}
 		msg.Float = &parsedFloat
 	case constVal.STRING() != nil:
-		msg.Str = compiler.Pointer(
-			strings.Trim(
-				strings.ReplaceAll(
-					constVal.STRING().GetText(),
-					"\\n",
-					"\n",
-				),
-				"'",
-			),
-		)
+		str, err := s.parseStringLit(constVal.STRING())
+		if err != nil {
+			return src.MsgLiteral{}, err
+		}
+		msg.Str = &str
 	case constVal.EnumLit() != nil:
 		parsedEnumRef, err := s.parseEntityRef(constVal.EnumLit().EntityRef())
 		if err != nil {
@@ -955,7 +1186,7 @@ func (s *treeShapeListener) parseMessage(
 		listItems := constVal.ListLit().ListItems()
 		if listItems == nil { // empty list []
 			msg.List = []src.ConstValue{}
-			return src.MsgLiteral{}, nil
+			return msg, nil
 		}
 		items := listItems.AllCompositeItem()
 		msg.List = make([]src.ConstValue, 0, len(items))
@@ -991,35 +1222,8 @@ func (s *treeShapeListener) parseMessage(
 			msg.List = append(msg.List, constant.Value)
 		}
 	case constVal.StructLit() != nil:
-		fields := constVal.StructLit().StructValueFields()
-		if fields == nil { // empty struct {}
-			msg.DictOrStruct = map[string]src.ConstValue{}
-			return msg, nil
-		}
-		fieldValues := fields.AllStructValueField()
-		msg.DictOrStruct = make(map[string]src.ConstValue, len(fieldValues))
-		for _, field := range fieldValues {
-			if field.IDENTIFIER() == nil {
-				panic("field.GetText()")
-			}
-			name := field.IDENTIFIER().GetText()
-			if field.CompositeItem().EntityRef() != nil {
-				parsedRef, err := s.parseEntityRef(field.CompositeItem().EntityRef())
-				if err != nil {
-					return src.MsgLiteral{}, err
-				}
-				msg.DictOrStruct[name] = src.ConstValue{
-					Ref: &parsedRef,
-				}
-			} else {
-				value, err := s.parseMessage(field.CompositeItem().ConstLit())
-				if err != nil {
-					return src.MsgLiteral{}, err
-				}
-				msg.DictOrStruct[name] = src.ConstValue{
-					Message: &value,
-				}
-			}
+		if err := s.parseStructLit(constVal.StructLit(), &msg); err != nil {
+			return src.MsgLiteral{}, err
 		}
 	default:
 		panic("unknown const: " + constVal.GetText())
@@ -1028,87 +1232,453 @@ func (s *treeShapeListener) parseMessage(
 	return msg, nil
 }
 
-func (s *treeShapeListener) parseCompilerDirectives(actx generated.ICompilerDirectivesContext) map[src.Directive][]string {
-	if actx == nil {
+// parseStructLit fills either dict or struct field of the message.
+// Literal with quoted keys is a dict, literal with identifier keys is a struct.
+// Empty literal {} is parsed as struct, analyzer turns it into dict if dict is expected.
+func (s *treeShapeListener) parseStructLit(
+	lit generated.IStructLitContext,
+	msg *src.MsgLiteral,
+) *compiler.Error {
+	fields := lit.StructValueFields()
+	if fields == nil { // empty struct {}
+		msg.Struct = map[string]src.ConstValue{}
 		return nil
 	}
 
-	directives := actx.AllCompilerDirective()
-	result := make(map[src.Directive][]string, len(directives))
-	for _, directive := range directives {
-		id := directive.IDENTIFIER()
-		if directive.CompilerDirectivesArgs() == nil {
-			result[src.Directive(id.GetText())] = []string{}
-			continue
-		}
-		args := directive.CompilerDirectivesArgs().AllCompiler_directive_arg()
-		ss := make([]string, 0, len(args))
-		for _, arg := range args {
-			s := ""
-			ids := arg.AllIDENTIFIER()
-			for i, id := range ids {
-				s += id.GetText()
-				if i < len(ids)-1 {
-					s += " "
+	fieldValues := fields.AllStructValueField()
+	values := make(map[string]src.ConstValue, len(fieldValues))
+	isDict := fieldValues[0].STRING() != nil
+
+	for _, field := range fieldValues {
+		var name string
+		if field.STRING() != nil {
+			if !isDict {
+				return &compiler.Error{
+					Message: "Literal cannot mix quoted dict keys and struct field names",
+					Meta:    &msg.Meta,
 				}
 			}
-			ss = append(ss, s)
+			key, err := s.parseStringLit(field.STRING())
+			if err != nil {
+				return err
+			}
+			name = key
+		} else {
+			if isDict {
+				return &compiler.Error{
+					Message: "Literal cannot mix quoted dict keys and struct field names",
+					Meta:    &msg.Meta,
+				}
+			}
+			name = field.IDENTIFIER().GetText()
 		}
-		result[src.Directive(id.GetText())] = ss
-	}
 
-	return result
-}
+		if _, ok := values[name]; ok {
+			return &compiler.Error{
+				Message: fmt.Sprintf("Duplicate key in literal: %v", name),
+				Meta:    &msg.Meta,
+			}
+		}
 
-func (s *treeShapeListener) parseTypeDef(
-	actx generated.ITypeDefContext,
-) (src.Entity, *compiler.Error) {
-	var body *ts.Expr
-	if expr := actx.TypeExpr(); expr != nil {
-		v, err := s.parseTypeExpr(actx.TypeExpr())
-		if err != nil {
-			return src.Entity{}, err
+		if field.CompositeItem().EntityRef() != nil {
+			parsedRef, err := s.parseEntityRef(field.CompositeItem().EntityRef())
+			if err != nil {
+				return err
+			}
+			values[name] = src.ConstValue{
+				Ref: &parsedRef,
+			}
+		} else {
+			value, err := s.parseMessage(field.CompositeItem().ConstLit())
+			if err != nil {
+				return err
+			}
+			values[name] = src.ConstValue{
+				Message: &value,
+			}
 		}
-		body = compiler.Pointer(v)
 	}
 
-	v, err := s.parseTypeParams(actx.TypeParams())
-	if err != nil {
-		return src.Entity{}, err
+	if isDict {
+		msg.Dict = values
+	} else {
+		msg.Struct = values
 	}
 
-	return src.Entity{
-		Kind: src.TypeEntity,
-		Type: ts.Def{
-			Params:   v.Params,
-			BodyExpr: body,
-			// CanBeUsedForRecursiveDefinitions: body == nil,
-			Meta: core.Meta{
-				Text: actx.GetText(),
-				Start: core.Position{
-					Line:   actx.GetStart().GetLine(),
-					Column: actx.GetStart().GetColumn(),
-				},
-				Stop: core.Position{
-					Line:   actx.GetStop().GetLine(),
-					Column: actx.GetStop().GetColumn(),
-				},
-				Location: s.loc,
-			},
-		},
-	}, nil
+	return nil
 }
 
-func (s *treeShapeListener) parseConstDef(
-	actx generated.IConstDefContext,
-) (src.Entity, *compiler.Error) {
-	constLit := actx.ConstLit()
-	entityRef := actx.EntityRef()
-
-	if constLit == nil && entityRef == nil {
-		panic("constVal == nil && entityRef == nil")
+// parseCompositeLitSender parses inline list or struct/dict literal used as a sender.
+// Its type is not known at this point and is inferred by analyzer.
+func (s *treeShapeListener) parseCompositeLitSender(
+	senderSide generated.ISingleSenderSideContext,
+) (src.Const, *compiler.Error) {
+	meta := core.Meta{
+		Text: senderSide.GetText(),
+		Start: core.Position{
+			Line:   senderSide.GetStart().GetLine(),
+			Column: senderSide.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   senderSide.GetStop().GetLine(),
+			Column: senderSide.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	msg := src.MsgLiteral{Meta: meta}
+
+	if structLit := senderSide.StructLit(); structLit != nil {
+		if err := s.parseStructLit(structLit, &msg); err != nil {
+			return src.Const{}, err
+		}
+	} else {
+		items := senderSide.ListSenderLit().AllConstLit()
+		msg.List = make([]src.ConstValue, 0, len(items))
+		for _, item := range items {
+			parsedItem, err := s.parseMessage(item)
+			if err != nil {
+				return src.Const{}, err
+			}
+			msg.List = append(msg.List, src.ConstValue{Message: &parsedItem})
+		}
+	}
+
+	return src.Const{
+		Value: src.ConstValue{Message: &msg},
+		Meta:  meta,
+	}, nil
+}
+
+// parseRangeExpr parses `from..to` or `from..to..step` range expression.
+func (s *treeShapeListener) parseRangeExpr(
+	rangeExprSender generated.IRangeExprContext,
+) (*src.Range, *compiler.Error) {
+	rangeMeta := core.Meta{
+		Text: rangeExprSender.GetText(),
+		Start: core.Position{
+			Line:   rangeExprSender.GetStart().GetLine(),
+			Column: rangeExprSender.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   rangeExprSender.GetStop().GetLine(),
+			Column: rangeExprSender.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	members := rangeExprSender.AllRangeMember()
+	if len(members) != 2 && len(members) != 3 {
+		return nil, &compiler.Error{
+			Message: "Range expression must have two or three members",
+			Meta:    &rangeMeta,
+		}
+	}
+
+	parsedMembers := make([]src.ConnectionSender, 0, len(members))
+	for _, member := range members {
+		parsedMember, err := s.parseRangeMember(member)
+		if err != nil {
+			return nil, err
+		}
+		parsedMembers = append(parsedMembers, parsedMember)
+	}
+
+	result := &src.Range{
+		From: parsedMembers[0],
+		To:   parsedMembers[1],
+		Meta: rangeMeta,
+	}
+	if len(parsedMembers) == 3 {
+		result.Step = &parsedMembers[2]
 	}
 
+	return result, nil
+}
+
+// parseRangeMember parses range member which is number literal, constant reference or port address.
+func (s *treeShapeListener) parseRangeMember(
+	member generated.IRangeMemberContext,
+) (src.ConnectionSender, *compiler.Error) {
+	meta := core.Meta{
+		Text: member.GetText(),
+		Start: core.Position{
+			Line:   member.GetStart().GetLine(),
+			Column: member.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   member.GetStop().GetLine(),
+			Column: member.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	if constRef := member.SenderConstRef(); constRef != nil {
+		parsedRef, err := s.parseEntityRef(constRef.EntityRef())
+		if err != nil {
+			return src.ConnectionSender{}, err
+		}
+		return src.ConnectionSender{
+			Const: &src.Const{
+				Value: src.ConstValue{Ref: &parsedRef},
+				Meta:  meta,
+			},
+			Meta: meta,
+		}, nil
+	}
+
+	if portAddr := member.PortAddr(); portAddr != nil {
+		parsedPortAddr, err := s.parsePortAddr(portAddr, "in")
+		if err != nil {
+			return src.ConnectionSender{}, err
+		}
+		return src.ConnectionSender{
+			PortAddr: &parsedPortAddr,
+			Meta:     meta,
+		}, nil
+	}
+
+	msg := src.MsgLiteral{Meta: meta}
+	typeName := "int"
+
+	if member.FLOAT() != nil {
+		parsedFloat, err := strconv.ParseFloat(member.GetText(), 64)
+		if err != nil {
+			return src.ConnectionSender{}, &compiler.Error{
+				Message: fmt.Sprintf("Invalid range member: %v", err),
+				Meta:    &meta,
+			}
+		}
+		msg.Float = &parsedFloat
+		typeName = "float"
+	} else {
+		parsedInt, err := strconv.Atoi(member.GetText())
+		if err != nil {
+			return src.ConnectionSender{}, &compiler.Error{
+				Message: fmt.Sprintf("Invalid range member: %v", err),
+				Meta:    &meta,
+			}
+		}
+		msg.Int = &parsedInt
+	}
+
+	return src.ConnectionSender{
+		Const: &src.Const{
+			TypeExpr: ts.Expr{
+				Inst: &ts.InstExpr{Ref: core.EntityRef{Name: typeName}},
+			},
+			Value: src.ConstValue{Message: &msg},
+			Meta:  meta,
+		},
+		Meta: meta,
+	}, nil
+}
+
+// parseUnionSender parses tagged union value with payload, e.g. `Input::Int(42)` or `Input::Int(:x)`.
+// If payload is a constant then the whole value is a constant, otherwise it's a union sender.
+func (s *treeShapeListener) parseUnionSender(
+	unionSender generated.IUnionSenderContext,
+) (*src.Const, *src.Union, *compiler.Error) {
+	parsedRef, err := s.parseEntityRef(unionSender.EntityRef())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := s.parseSingleSender(unionSender.SingleSenderSide())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := core.Meta{
+		Text: unionSender.GetText(),
+		Start: core.Position{
+			Line:   unionSender.GetStart().GetLine(),
+			Column: unionSender.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   unionSender.GetStop().GetLine(),
+			Column: unionSender.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	tag := unionSender.IDENTIFIER().GetText()
+
+	if data.Const != nil {
+		return &src.Const{
+			TypeExpr: ts.Expr{
+				Inst: &ts.InstExpr{Ref: parsedRef},
+				Meta: parsedRef.Meta,
+			},
+			Value: src.ConstValue{
+				Message: &src.MsgLiteral{
+					Union: &src.UnionLiteral{
+						EntityRef: parsedRef,
+						Tag:       tag,
+						Data:      &data.Const.Value,
+					},
+					Meta: meta,
+				},
+			},
+			Meta: meta,
+		}, nil, nil
+	}
+
+	return nil, &src.Union{
+		EntityRef: parsedRef,
+		Tag:       tag,
+		Data:      &data,
+		Meta:      meta,
+	}, nil
+}
+
+// parseStringLit returns value of the string literal with escape sequences decoded.
+// Raw (backtick) strings are returned as is, except for carriage returns that are removed like in Go.
+func (s *treeShapeListener) parseStringLit(lit antlr.TerminalNode) (string, *compiler.Error) {
+	text := lit.GetText()
+	quote, body := text[0], text[1:len(text)-1]
+
+	if quote == '`' {
+		return strings.ReplaceAll(body, "\r", ""), nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(body); {
+		if body[i] != '\\' {
+			b.WriteByte(body[i])
+			i++
+			continue
+		}
+
+		// both kinds of quotes can be escaped in both kinds of strings
+		if i+1 < len(body) && (body[i+1] == '\'' || body[i+1] == '"') {
+			b.WriteByte(body[i+1])
+			i += 2
+			continue
+		}
+
+		value, multibyte, tail, err := strconv.UnquoteChar(body[i:], 0)
+		if err != nil {
+			_, size := utf8.DecodeRuneInString(body[i+1:])
+			seq := body[i : i+1+size]
+			start := i + 1 // +1 for the opening quote
+			return "", &compiler.Error{
+				Message: fmt.Sprintf("Invalid escape sequence in string literal: %v", seq),
+				Meta: &core.Meta{
+					Text:     seq,
+					Start:    positionInToken(lit.GetSymbol(), start),
+					Stop:     positionInToken(lit.GetSymbol(), start+len(seq)),
+					Location: s.loc,
+				},
+			}
+		}
+
+		if value < utf8.RuneSelf || !multibyte {
+			b.WriteByte(byte(value))
+		} else {
+			b.WriteRune(value)
+		}
+		i = len(body) - len(tail)
+	}
+
+	return b.String(), nil
+}
+
+// positionInToken returns position of the byte with given offset in the token's text.
+func positionInToken(token antlr.Token, offset int) core.Position {
+	pos := core.Position{
+		Line:   token.GetLine(),
+		Column: token.GetColumn(),
+	}
+	for _, r := range token.GetText()[:offset] {
+		if r == '\n' {
+			pos.Line++
+			pos.Column = 0
+		} else {
+			pos.Column++
+		}
+	}
+	return pos
+}
+
+func (s *treeShapeListener) parseCompilerDirectives(actx generated.ICompilerDirectivesContext) map[src.Directive][]string {
+	if actx == nil {
+		return nil
+	}
+
+	directives := actx.AllCompilerDirective()
+	result := make(map[src.Directive][]string, len(directives))
+	for _, directive := range directives {
+		id := directive.IDENTIFIER()
+		if directive.CompilerDirectivesArgs() == nil {
+			result[src.Directive(id.GetText())] = []string{}
+			continue
+		}
+		args := directive.CompilerDirectivesArgs().AllCompiler_directive_arg()
+		ss := make([]string, 0, len(args))
+		for _, arg := range args {
+			if i := arg.INT(); i != nil {
+				ss = append(ss, i.GetText())
+				continue
+			}
+			s := ""
+			ids := arg.AllIDENTIFIER()
+			for i, id := range ids {
+				s += id.GetText()
+				if i < len(ids)-1 {
+					s += " "
+				}
+			}
+			ss = append(ss, s)
+		}
+		result[src.Directive(id.GetText())] = ss
+	}
+
+	return result
+}
+
+func (s *treeShapeListener) parseTypeDef(
+	actx generated.ITypeDefContext,
+) (src.Entity, *compiler.Error) {
+	var body *ts.Expr
+	if expr := actx.TypeExpr(); expr != nil {
+		v, err := s.parseTypeExpr(actx.TypeExpr())
+		if err != nil {
+			return src.Entity{}, err
+		}
+		body = compiler.Pointer(v)
+	}
+
+	v, err := s.parseTypeParams(actx.TypeParams())
+	if err != nil {
+		return src.Entity{}, err
+	}
+
+	return src.Entity{
+		Kind: src.TypeEntity,
+		Type: ts.Def{
+			Params:   v.Params,
+			BodyExpr: body,
+			// CanBeUsedForRecursiveDefinitions: body == nil,
+			Meta: core.Meta{
+				Text: actx.GetText(),
+				Start: core.Position{
+					Line:   actx.GetStart().GetLine(),
+					Column: actx.GetStart().GetColumn(),
+				},
+				Stop: core.Position{
+					Line:   actx.GetStop().GetLine(),
+					Column: actx.GetStop().GetColumn(),
+				},
+				Location: s.loc,
+			},
+		},
+	}, nil
+}
+
+func (s *treeShapeListener) parseConstDef(
+	actx generated.IConstDefContext,
+) (src.Entity, *compiler.Error) {
 	meta := core.Meta{
 		Text: actx.GetText(),
 		Start: core.Position{
@@ -1130,46 +1700,100 @@ func (s *treeShapeListener) parseConstDef(
 		}
 	}
 
-	parsedConst := src.Const{
-		TypeExpr: parsedTypeExpr,
-		Meta:     meta,
+	parsedValue, err := s.parseConstExpr(actx.ConstExpr())
+	if err != nil {
+		return src.Entity{}, &compiler.Error{
+			Message: err.Error(),
+			Meta:    &meta,
+		}
 	}
 
-	if entityRef != nil {
-		parsedRef, err := s.parseEntityRef(entityRef)
+	return src.Entity{
+		Kind: src.ConstEntity,
+		Const: src.Const{
+			TypeExpr: parsedTypeExpr,
+			Value:    parsedValue,
+			Meta:     meta,
+		},
+	}, nil
+}
+
+// parseConstExpr parses `a op b op c` constant expression into a tree of binary expressions.
+// Single operand without operators is parsed as a regular reference or literal.
+func (s *treeShapeListener) parseConstExpr(
+	actx generated.IConstExprContext,
+) (src.ConstValue, *compiler.Error) {
+	meta := core.Meta{
+		Text: actx.GetText(),
+		Start: core.Position{
+			Line:   actx.GetStart().GetLine(),
+			Column: actx.GetStart().GetColumn(),
+		},
+		Stop: core.Position{
+			Line:   actx.GetStop().GetLine(),
+			Column: actx.GetStop().GetColumn(),
+		},
+		Location: s.loc,
+	}
+
+	operands := make([]src.ConstValue, 0, len(actx.AllConstOperand()))
+	for _, operand := range actx.AllConstOperand() {
+		parsedOperand, err := s.parseConstOperand(operand)
 		if err != nil {
-			return src.Entity{}, &compiler.Error{
-				Message: err.Error(),
-				Meta:    &meta,
+			return src.ConstValue{}, err
+		}
+		operands = append(operands, parsedOperand)
+	}
+
+	ops := make([]src.BinaryOperator, 0, len(actx.AllBinaryOp()))
+	for _, op := range actx.AllBinaryOp() {
+		ops = append(ops, parseBinaryOp(op.GetText()))
+	}
+
+	// operators with higher precedence are grouped first, equal ones from left to right
+	for len(ops) > 0 {
+		i := 0
+		for j := range ops {
+			if ops[j].Precedence() > ops[i].Precedence() {
+				i = j
 			}
 		}
-		parsedConst.Value.Ref = &parsedRef
-		return src.Entity{
-			Kind:  src.ConstEntity,
-			Const: parsedConst,
-		}, nil
+		grouped := src.ConstValue{
+			Binary: &src.Binary{
+				Left:     src.ConnectionSender{Const: &src.Const{Value: operands[i], Meta: meta}, Meta: meta},
+				Right:    src.ConnectionSender{Const: &src.Const{Value: operands[i+1], Meta: meta}, Meta: meta},
+				Operator: ops[i],
+				Meta:     meta,
+			},
+		}
+		operands = slices.Replace(operands, i, i+2, grouped)
+		ops = slices.Delete(ops, i, i+1)
 	}
 
-	parsedMsgLit, err := s.parseMessage(constLit)
-	if err != nil {
-		return src.Entity{}, &compiler.Error{
-			Message: err.Error(),
-			Meta:    &meta,
+	return operands[0], nil
+}
+
+func (s *treeShapeListener) parseConstOperand(
+	actx generated.IConstOperandContext,
+) (src.ConstValue, *compiler.Error) {
+	if expr := actx.ConstExpr(); expr != nil {
+		return s.parseConstExpr(expr)
+	}
+
+	if entityRef := actx.EntityRef(); entityRef != nil {
+		parsedRef, err := s.parseEntityRef(entityRef)
+		if err != nil {
+			return src.ConstValue{}, err
 		}
+		return src.ConstValue{Ref: &parsedRef}, nil
 	}
 
-	parsedConst = src.Const{
-		TypeExpr: parsedTypeExpr,
-		Value: src.ConstValue{
-			Message: &parsedMsgLit,
-		},
-		Meta: meta,
+	parsedMsgLit, err := s.parseMessage(actx.ConstLit())
+	if err != nil {
+		return src.ConstValue{}, err
 	}
 
-	return src.Entity{
-		Kind:  src.ConstEntity,
-		Const: parsedConst,
-	}, nil
+	return src.ConstValue{Message: &parsedMsgLit}, nil
 }
 
 func (s *treeShapeListener) parseCompDef(actx generated.ICompDefContext) (src.Entity, *compiler.Error) {
@@ -1178,8 +1802,6 @@ func (s *treeShapeListener) parseCompDef(actx generated.ICompDefContext) (src.En
 		return src.Entity{}, err
 	}
 
-	body := actx.CompBody()
-
 	meta := core.Meta{
 		Text: actx.GetText(),
 		Start: core.Position{
@@ -1193,6 +1815,21 @@ func (s *treeShapeListener) parseCompDef(actx generated.ICompDefContext) (src.En
 		Location: s.loc,
 	}
 
+	return s.parseCompBody(
+		actx.CompBody(),
+		parsedInterfaceDef,
+		actx.InterfaceDef().IDENTIFIER().GetText(),
+		meta,
+	)
+}
+
+// parseCompBody parses nodes and network of the component, body is nil for extern components.
+func (s *treeShapeListener) parseCompBody(
+	body generated.ICompBodyContext,
+	parsedInterfaceDef src.Interface,
+	path string,
+	meta core.Meta,
+) (src.Entity, *compiler.Error) {
 	if body == nil {
 		return src.Entity{
 			Kind: src.ComponentEntity,
@@ -1204,7 +1841,7 @@ func (s *treeShapeListener) parseCompDef(actx generated.ICompDefContext) (src.En
 	}
 
 	parsedConnections := []src.Connection{}
-	connections := actx.CompBody().ConnDefList()
+	connections := body.ConnDefList()
 	if connections != nil {
 		parsedNet, err := s.parseConnections(connections)
 		if err != nil {
@@ -1225,7 +1862,7 @@ func (s *treeShapeListener) parseCompDef(actx generated.ICompDefContext) (src.En
 		}, nil
 	}
 
-	parsedNodes, err := s.parseNodes(nodesDef.CompNodesDefBody(), true)
+	parsedNodes, err := s.parseNodes(nodesDef.CompNodesDefBody(), path, true)
 	if err != nil {
 		return src.Entity{}, err
 	}
@@ -1604,10 +2241,14 @@ func (s *treeShapeListener) parseSingleSender(
 	rangeExprSender := senderSide.RangeExpr()
 	ternaryExprSender := senderSide.TernaryExpr()
 	binaryExprSender := senderSide.BinaryExpr()
+	compositeLitSender := senderSide.StructLit() != nil || senderSide.ListSenderLit() != nil
+	unionSender := senderSide.UnionSender()
 
 	if portSender == nil &&
 		constRefSender == nil &&
 		primitiveConstLitSender == nil &&
+		!compositeLitSender &&
+		unionSender == nil &&
 		rangeExprSender == nil &&
 		structSelectors == nil &&
 		ternaryExprSender == nil &&
@@ -1659,78 +2300,30 @@ func (s *treeShapeListener) parseSingleSender(
 		constant = &parsedPrimitiveConstLiteralSender
 	}
 
-	var rangeExpr *src.Range
-	if rangeExprSender != nil {
-		rangeMeta := &core.Meta{
-			Text: rangeExprSender.GetText(),
-			Start: core.Position{
-				Line:   rangeExprSender.GetStart().GetLine(),
-				Column: rangeExprSender.GetStart().GetColumn(),
-			},
-			Stop: core.Position{
-				Line:   rangeExprSender.GetStop().GetLine(),
-				Column: rangeExprSender.GetStop().GetColumn(),
-			},
-			Location: s.loc,
-		}
-
-		members := rangeExprSender.AllRangeMember()
-		if len(members) != 2 {
-			return src.ConnectionSender{}, &compiler.Error{
-				Message: "Range expression must have exactly two members",
-				Meta:    rangeMeta,
-			}
-		}
-
-		fromCtx := members[0]
-		fromText := fromCtx.GetText()
-
-		from, err := strconv.ParseInt(fromText, 10, 64)
+	if compositeLitSender {
+		parsedCompositeLitSender, err := s.parseCompositeLitSender(senderSide)
 		if err != nil {
-			return src.ConnectionSender{}, &compiler.Error{
-				Message: fmt.Sprintf("Invalid range 'from' value: %v", err),
-				Meta: &core.Meta{
-					Text: rangeExprSender.GetText(),
-					Start: core.Position{
-						Line:   rangeExprSender.GetStart().GetLine(),
-						Column: rangeExprSender.GetStart().GetColumn(),
-					},
-					Stop: core.Position{
-						Line:   rangeExprSender.GetStop().GetLine(),
-						Column: rangeExprSender.GetStop().GetColumn(),
-					},
-					Location: s.loc,
-				},
-			}
+			return src.ConnectionSender{}, err
 		}
+		constant = &parsedCompositeLitSender
+	}
 
-		toCtx := members[1]
-		toText := toCtx.GetText()
-
-		to, err := strconv.ParseInt(toText, 10, 64)
+	var union *src.Union
+	if unionSender != nil {
+		parsedConst, parsedUnion, err := s.parseUnionSender(unionSender)
 		if err != nil {
-			return src.ConnectionSender{}, &compiler.Error{
-				Message: fmt.Sprintf("Invalid range 'to' value: %v", err),
-				Meta: &core.Meta{
-					Text: rangeExprSender.GetText(),
-					Start: core.Position{
-						Line:   rangeExprSender.GetStart().GetLine(),
-						Column: rangeExprSender.GetStart().GetColumn(),
-					},
-					Stop: core.Position{
-						Line:   rangeExprSender.GetStop().GetLine(),
-						Column: rangeExprSender.GetStop().GetColumn(),
-					},
-					Location: s.loc,
-				},
-			}
+			return src.ConnectionSender{}, err
 		}
+		constant, union = parsedConst, parsedUnion
+	}
 
-		rangeExpr = &src.Range{
-			From: from,
-			To:   to,
-			Meta: *rangeMeta,
+	var rangeExpr *src.Range
+	if rangeExprSender != nil {
+		parsedRange, err := s.parseRangeExpr(rangeExprSender)
+		if err != nil {
+			return src.ConnectionSender{}, err
 		}
+		rangeExpr = parsedRange
 	}
 
 	var senderSelectors []string
@@ -1788,6 +2381,7 @@ func (s *treeShapeListener) parseSingleSender(
 		StructSelector: senderSelectors,
 		Ternary:        ternaryExpr,
 		Binary:         binaryExpr,
+		Union:          union,
 		Meta: core.Meta{
 			Text: senderSide.GetText(),
 			Start: core.Position{
@@ -1834,8 +2428,42 @@ func (s *treeShapeListener) parsePortAddrReceiver(
 }
 
 func (s *treeShapeListener) parseBinaryExpr(ctx generated.IBinaryExprContext) *src.Binary {
+	op := parseBinaryOp(ctx.BinaryOp().GetText())
+
+	senders := ctx.AllSingleSenderSide()
+
+	left, err := s.parseSingleSender(senders[0])
+	if err != nil {
+		return nil
+	}
+
+	right, err := s.parseSingleSender(senders[1])
+	if err != nil {
+		return nil
+	}
+
+	return &src.Binary{
+		Left:     left,
+		Right:    right,
+		Operator: op,
+		Meta: core.Meta{
+			Text: ctx.GetText(),
+			Start: core.Position{
+				Line:   ctx.GetStart().GetLine(),
+				Column: ctx.GetStart().GetColumn(),
+			},
+			Stop: core.Position{
+				Line:   ctx.GetStop().GetLine(),
+				Column: ctx.GetStop().GetColumn(),
+			},
+			Location: s.loc,
+		},
+	}
+}
+
+func parseBinaryOp(text string) src.BinaryOperator {
 	var op src.BinaryOperator
-	switch ctx.BinaryOp().GetText() {
+	switch text {
 	// Arithmetic
 	case "+":
 		op = src.AddOp
@@ -1879,34 +2507,5 @@ func (s *treeShapeListener) parseBinaryExpr(ctx generated.IBinaryExprContext) *s
 	case ">>":
 		op = src.BitRshOp
 	}
-
-	senders := ctx.AllSingleSenderSide()
-
-	left, err := s.parseSingleSender(senders[0])
-	if err != nil {
-		return nil
-	}
-
-	right, err := s.parseSingleSender(senders[1])
-	if err != nil {
-		return nil
-	}
-
-	return &src.Binary{
-		Left:     left,
-		Right:    right,
-		Operator: op,
-		Meta: core.Meta{
-			Text: ctx.GetText(),
-			Start: core.Position{
-				Line:   ctx.GetStart().GetLine(),
-				Column: ctx.GetStart().GetColumn(),
-			},
-			Stop: core.Position{
-				Line:   ctx.GetStop().GetLine(),
-				Column: ctx.GetStop().GetColumn(),
-			},
-			Location: s.loc,
-		},
-	}
+	return op
 }
@@ -16,7 +16,7 @@ compilerDirectives: (compilerDirective NEWLINE)+;
 compilerDirective: '#' IDENTIFIER compilerDirectivesArgs?;
 compilerDirectivesArgs:
 	'(' compiler_directive_arg (',' compiler_directive_arg)* ')';
-compiler_directive_arg: IDENTIFIER+;
+compiler_directive_arg: IDENTIFIER+ | INT;
 
 // Imports
 importStmt: 'import' NEWLINE* '{' NEWLINE* importDef* '}';
@@ -38,14 +38,22 @@ entityName: IDENTIFIER;
 // Types
 typeStmt: PUB_KW? 'type' typeDef;
 typeDef: IDENTIFIER typeParams? typeExpr? COMMENT?;
-typeParams: '<' NEWLINE* typeParamList? '>';
+typeParams:
+	'<' NEWLINE* typeParamList? '>'
+	| '<' NEWLINE* (typeParam ',' NEWLINE*)* IDENTIFIER openTypeExpr NEWLINE* '>>';
 typeParamList: typeParam (',' NEWLINE* typeParam)*;
 typeParam: IDENTIFIER typeExpr? NEWLINE*;
 typeExpr: typeInstExpr | typeLitExpr | unionTypeExpr;
 typeInstExpr: entityRef typeArgs?;
 typeArgs:
-	'<' NEWLINE* typeExpr (',' NEWLINE* typeExpr)* NEWLINE* '>';
-typeLitExpr: enumTypeExpr | structTypeExpr;
+	'<' NEWLINE* typeExpr (',' NEWLINE* typeExpr)* NEWLINE* '>'
+	| '<' NEWLINE* (typeExpr ',' NEWLINE*)* openTypeExpr NEWLINE* '>>';
+// type expression with unclosed type arguments, they are closed by '>>' of the enclosing list
+openTypeExpr:
+	(nonUnionTypeExpr NEWLINE* '|' NEWLINE*)* entityRef '<' NEWLINE* typeExpr (
+		',' NEWLINE* typeExpr
+	)*;
+typeLitExpr: enumTypeExpr | structTypeExpr | taggedUnionTypeExpr;
 enumTypeExpr:
 	'enum' NEWLINE* '{' NEWLINE* IDENTIFIER (
 		',' NEWLINE* IDENTIFIER
@@ -54,6 +62,10 @@ structTypeExpr:
 	'struct' NEWLINE* '{' NEWLINE* structFields? '}';
 structFields: structField (NEWLINE+ structField)*;
 structField: IDENTIFIER typeExpr NEWLINE*;
+taggedUnionTypeExpr:
+	'union' NEWLINE* '{' NEWLINE* unionTags? '}';
+unionTags: unionTag (NEWLINE+ unionTag)*;
+unionTag: IDENTIFIER typeExpr? NEWLINE*; // tag without type has no payload
 unionTypeExpr:
 	nonUnionTypeExpr (NEWLINE* '|' NEWLINE* nonUnionTypeExpr)+;
 nonUnionTypeExpr:
@@ -74,8 +86,9 @@ arrayPortDef: NEWLINE* '[' IDENTIFIER ']' typeExpr? NEWLINE*;
 
 // const
 constStmt: PUB_KW? 'const' constDef;
-constDef:
-	IDENTIFIER typeExpr '=' (entityRef | constLit) NEWLINE*;
+constDef: IDENTIFIER typeExpr '=' constExpr NEWLINE*;
+constExpr: constOperand (binaryOp constOperand)*; // e.g. 5 * time.second
+constOperand: entityRef | constLit | '(' constExpr ')';
 constLit:
 	bool
 	| MINUS? INT
@@ -101,7 +114,7 @@ structLit:
 	'{' NEWLINE* structValueFields? '}'; // same for struct and dict
 structValueFields:
 	structValueField (',' NEWLINE* structValueField)*;
-structValueField: IDENTIFIER ':' compositeItem NEWLINE*;
+structValueField: (IDENTIFIER | STRING) ':' compositeItem NEWLINE*; // quoted keys for dict
 
 // def (component)
 compStmt: compilerDirectives? PUB_KW? 'def' compDef;
@@ -114,11 +127,12 @@ compBody:
 // nodes
 compNodesDef: compNodesDefBody NEWLINE+ '---';
 compNodesDefBody: ((compNodeDef ','? | COMMENT) NEWLINE*)+;
-compNodeDef: compilerDirectives? IDENTIFIER? nodeInst;
+compNodeDef: compilerDirectives? IDENTIFIER? (nodeInst | anonCompDef);
 nodeInst:
 	entityRef NEWLINE* typeArgs? NEWLINE* nodeDIArgs? errGuard?;
 errGuard: '?';
 nodeDIArgs: '{' NEWLINE* compNodesDefBody '}';
+anonCompDef: 'def' inPortsDef outPortsDef NEWLINE* compBody; // only as DI argument
 
 // network
 connDefList: (connDef | COMMENT) (NEWLINE* (connDef | COMMENT))*;
@@ -138,7 +152,10 @@ singleSenderSide:
 	| structSelectors
 	| unaryExpr
 	| binaryExpr
-	| ternaryExpr;
+	| ternaryExpr
+	| structLit
+	| listSenderLit
+	| unionSender;
 unaryExpr: unaryOp singleSenderSide;
 unaryOp: '!' | '++' | '--' | '-';
 ternaryExpr:
@@ -165,14 +182,16 @@ binaryOp:
 	// Bitwise
 	| '&'
 	| '|'
-	| '^';
+	| '^'
+	| '<<'
+	| '>>';
 // TODO: refactor - `singleReceiverSide | multipleReceiverSide` (chained must be inside single)
 receiverSide: singleReceiverSide | multipleReceiverSide;
 chainedNormConn: normConnDef;
 deferredConn: '{' NEWLINE* connDef NEWLINE* '}';
 senderConstRef: '$' entityRef;
-rangeExpr: rangeMember '..' rangeMember;
-rangeMember: MINUS? INT;
+rangeExpr: rangeMember '..' rangeMember ('..' rangeMember)?; // from..to..step
+rangeMember: MINUS? (INT | FLOAT) | senderConstRef | portAddr;
 portAddr:
 	singlePortAddr
 	| arrPortAddr
@@ -204,6 +223,14 @@ switchStmt:
 
 defaultCase: '_' '->' receiverSide;
 
+// inline list literal as a sender, items are const literals so [a, b] is still a fan-in
+listSenderLit:
+	'[' NEWLINE* (constLit (',' NEWLINE* constLit NEWLINE*)*)? ']';
+
+// tagged union value with payload taken from another sender, e.g. Input::Int(:x) or Input::Int(42)
+unionSender:
+	entityRef '::' IDENTIFIER '(' NEWLINE* singleSenderSide NEWLINE* ')';
+
 /* LEXER */
 
 COMMENT: '//' ~( '\r' | '\n')*;
@@ -213,6 +240,9 @@ fragment LETTER: [a-zA-Z_];
 INT: [0-9]+; // one or more (positive) integer digits
 MINUS: '-';
 FLOAT: [0-9]* '.' [0-9]+;
-STRING: '\'' .*? '\'';
+STRING:
+	'\'' ('\\' . | ~['\\])* '\''
+	| '"' ('\\' . | ~["\\])* '"'
+	| '`' ~'`'* '`'; // raw string, escape sequences are not decoded
 NEWLINE: '\r'? '\n'; // `\r\n` on windows and `\n` on unix
 WS: [ \t]+ -> channel(HIDDEN); // ignore whitespace
\ No newline at end of file
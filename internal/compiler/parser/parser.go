@@ -27,9 +27,15 @@ func (p Parser) ParseModules(
 			return nil, err
 		}
 
+		goPkgs, err := p.ParseGoPackages(modRef, rawMod.GoPackages)
+		if err != nil {
+			return nil, err
+		}
+
 		parsedMods[modRef] = src.Module{
-			Manifest: rawMod.Manifest,
-			Packages: parsedPkgs,
+			Manifest:   rawMod.Manifest,
+			Packages:   parsedPkgs,
+			GoPackages: goPkgs,
 		}
 	}
 
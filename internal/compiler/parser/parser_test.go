@@ -350,6 +350,28 @@ func TestParser_ParseFile_Directives(t *testing.T) {
 	require.Equal(t, true, ok)
 }
 
+func TestParser_ParseFile_BufferDirective(t *testing.T) {
+	text := []byte(`
+		def C1() () {
+			#buffer(16)
+			n1 C2
+
+			#buffer(size)
+			n2 C2
+			---
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	c1 := got.Entities["C1"].Component
+	require.Equal(t, []string{"16"}, c1.Nodes["n1"].Directives[compiler.BufferDirective])
+	require.Equal(t, []string{"size"}, c1.Nodes["n2"].Directives[compiler.BufferDirective])
+}
+
 func TestParser_ParseFile_IONodes(t *testing.T) {
 	text := []byte(`
 		def C1(start any) (stop any) {
@@ -394,6 +416,115 @@ func TestParser_ParseFile_AnonymousNodes(t *testing.T) {
 	require.Equal(t, true, ok)
 }
 
+func TestParser_ParseFile_AnonymousComponents(t *testing.T) {
+	text := []byte(`
+		def C1(start any) (stop any) {
+			m Map<int, int>{
+				def(x int) (res int) {
+					(:x * 2) -> :res
+				}
+			}
+			r Reduce<int, int>{
+				reducer def(left int, right int) (res int) {
+					(:left + :right) -> :res
+				}
+			}
+			---
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	nodes := got.Entities["C1"].Component.Nodes
+	require.Equal(t, "__anon__C1/m/", nodes["m"].DIArgs[""].EntityRef.Name)
+	require.Equal(t, "__anon__C1/r/reducer", nodes["r"].DIArgs["reducer"].EntityRef.Name)
+
+	anon, ok := got.Entities["__anon__C1/m/"]
+	require.True(t, ok)
+	require.False(t, anon.IsPublic)
+	require.Contains(t, anon.Component.Interface.IO.In, "x")
+	require.Contains(t, anon.Component.Interface.IO.Out, "res")
+	require.Len(t, anon.Component.Net, 1)
+	require.NotNil(t, anon.Component.Net[0].Normal.Senders[0].Binary)
+
+	_, ok = got.Entities["__anon__C1/r/reducer"]
+	require.True(t, ok)
+}
+
+func TestParser_ParseFile_AnonymousComponentsWithSimilarPaths(t *testing.T) {
+	text := []byte(`
+		def C1(start any) (stop any) {
+			m_handler Map<int, int>{
+				def(x int) (res int) {
+					(:x * 2) -> :res
+				}
+			}
+			m Map<int, int>{
+				handler def(x int) (res int) {
+					(:x * 3) -> :res
+				}
+			}
+			---
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	nodes := got.Entities["C1"].Component.Nodes
+	first := nodes["m_handler"].DIArgs[""].EntityRef.Name
+	second := nodes["m"].DIArgs["handler"].EntityRef.Name
+	require.NotEqual(t, first, second)
+	require.Contains(t, got.Entities, first)
+	require.Contains(t, got.Entities, second)
+}
+
+func TestParser_ParseFile_AnonymousComponentNameCollision(t *testing.T) {
+	text := []byte(`
+		def C1(start any) (stop any) {
+			m Map<int, int>{
+				def(x int) (res int) {
+					(:x * 2) -> :res
+				}
+			}
+			m Map<int, int>{
+				def(x int) (res int) {
+					(:x * 3) -> :res
+				}
+			}
+			---
+		}
+	`)
+
+	p := New()
+
+	_, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Entity __anon__C1/m/ already exists")
+}
+
+func TestParser_ParseFile_AnonymousComponentAsNode(t *testing.T) {
+	text := []byte(`
+		def C1(start any) (stop any) {
+			d def(x int) (res int) {
+				:x -> :res
+			}
+			---
+		}
+	`)
+
+	p := New()
+
+	_, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.NotNil(t, err)
+	require.Contains(t, err.Message, "Anonymous component can only be used as dependency injection argument")
+}
+
 func TestParser_ParseFile_EnumLiterals(t *testing.T) {
 	text := []byte(`
 		const c0 Enum = Enum1::Foo
@@ -443,6 +574,173 @@ func TestParser_ParseFile_EnumLiteralSenders(t *testing.T) {
 	require.Equal(t, "Baz", senderEnum.MemberName)
 }
 
+func TestParser_ParseFile_TaggedUnions(t *testing.T) {
+	text := []byte(`
+		type Input union {
+			Int int
+			List list<string>
+			None
+		}
+		def C1() () {
+			Input::Int(42) -> :out
+			Input::Int(:x) -> :out
+			Input::None -> :out
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil, err)
+
+	tags := got.Entities["Input"].Type.BodyExpr.Lit.Tagged
+	require.Len(t, tags, 3)
+	require.Equal(t, "Int", tags[0].Name)
+	require.Equal(t, "int", tags[0].Type.Inst.Ref.Name)
+	require.Equal(t, "list", tags[1].Type.Inst.Ref.Name)
+	require.Equal(t, "None", tags[2].Name)
+	require.Nil(t, tags[2].Type)
+
+	net := got.Entities["C1"].Component.Net
+
+	lit := net[0].Normal.Senders[0].Const.Value.Message.Union
+	require.Equal(t, "Input", lit.EntityRef.Name)
+	require.Equal(t, "Int", lit.Tag)
+	require.Equal(t, 42, *lit.Data.Message.Int)
+
+	union := net[1].Normal.Senders[0].Union
+	require.Equal(t, "Input", union.EntityRef.Name)
+	require.Equal(t, "Int", union.Tag)
+	require.Equal(t, "x", union.Data.PortAddr.Port)
+
+	enum := net[2].Normal.Senders[0].Const.Value.Message.Enum
+	require.Equal(t, "None", enum.MemberName)
+}
+
+func TestParser_ParseFile_StringLiterals(t *testing.T) {
+	text := []byte(`
+		const c0 string = 'it\'s\tok\n'
+		const c1 string = "say \"hi\" \u00e9\x41\\"
+		const c2 string = ` + "`" + `first\n
+second` + "`" + `
+		def C1() () {
+			'a\'b' -> :out
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	require.Equal(t, "it's\tok\n", *got.Entities["c0"].Const.Value.Message.Str)
+	require.Equal(t, "say \"hi\" \u00e9A\\", *got.Entities["c1"].Const.Value.Message.Str)
+	require.Equal(t, "first\\n\nsecond", *got.Entities["c2"].Const.Value.Message.Str)
+
+	conn := got.Entities["C1"].Component.Net[0]
+	require.Equal(t, "a'b", *conn.Normal.Senders[0].Const.Value.Message.Str)
+}
+
+func TestParser_ParseFile_CompositeLiterals(t *testing.T) {
+	text := []byte(`
+		const d dict<int> = { "a": 1, "b": 2 }
+		const s User = { name: 'John' }
+		def C1() () {
+			[1, 2] -> :out
+			{ "x": 'a' } -> :out
+			{ x: [1] } -> :out
+			[a, b] -> :out
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	d := got.Entities["d"].Const.Value.Message
+	require.Nil(t, d.Struct)
+	require.Equal(t, 2, *d.Dict["b"].Message.Int)
+
+	s := got.Entities["s"].Const.Value.Message
+	require.Nil(t, s.Dict)
+	require.Equal(t, "John", *s.Struct["name"].Message.Str)
+
+	net := got.Entities["C1"].Component.Net
+
+	list := net[0].Normal.Senders[0].Const.Value.Message.List
+	require.Len(t, list, 2)
+	require.Equal(t, 2, *list[1].Message.Int)
+
+	dict := net[1].Normal.Senders[0].Const.Value.Message.Dict
+	require.Equal(t, "a", *dict["x"].Message.Str)
+
+	strct := net[2].Normal.Senders[0].Const.Value.Message.Struct
+	require.Equal(t, 1, *strct["x"].Message.List[0].Message.Int)
+
+	// list of port addresses is still a fan-in
+	require.Len(t, net[3].Normal.Senders, 2)
+	require.Equal(t, "a", net[3].Normal.Senders[0].PortAddr.Node)
+}
+
+func TestParser_ParseFile_ConstExpressions(t *testing.T) {
+	text := []byte(`
+		const c1 int = 42
+		const c2 time.Duration = 5 * time.second
+		const c3 int = 1 + 2 * 3 - 4
+		const c4 int = (1 + 2) * 3
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.True(t, err == nil)
+
+	c1 := got.Entities["c1"].Const.Value
+	require.Nil(t, c1.Binary)
+	require.Equal(t, 42, *c1.Message.Int)
+
+	c2 := got.Entities["c2"].Const.Value.Binary
+	require.Equal(t, src.MulOp, c2.Operator)
+	require.Equal(t, 5, *c2.Left.Const.Value.Message.Int)
+	require.Equal(t, "time", c2.Right.Const.Value.Ref.Pkg)
+	require.Equal(t, "second", c2.Right.Const.Value.Ref.Name)
+
+	// ((1 + (2 * 3)) - 4)
+	c3 := got.Entities["c3"].Const.Value.Binary
+	require.Equal(t, src.SubOp, c3.Operator)
+	require.Equal(t, 4, *c3.Right.Const.Value.Message.Int)
+	require.Equal(t, src.AddOp, c3.Left.Const.Value.Binary.Operator)
+	require.Equal(t, src.MulOp, c3.Left.Const.Value.Binary.Right.Const.Value.Binary.Operator)
+
+	// ((1 + 2) * 3)
+	c4 := got.Entities["c4"].Const.Value.Binary
+	require.Equal(t, src.MulOp, c4.Operator)
+	require.Equal(t, src.AddOp, c4.Left.Const.Value.Binary.Operator)
+}
+
+func TestParser_ParseFile_MixedCompositeLiteralKeys(t *testing.T) {
+	text := []byte(`
+		const c dict<int> = { a: 1, "b": 2 }
+	`)
+
+	p := New()
+
+	_, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Literal cannot mix quoted dict keys and struct field names")
+}
+
+func TestParser_ParseFile_InvalidEscapeSequence(t *testing.T) {
+	text := []byte("const c0 string = 'ok'\nconst c1 string = 'multi\nline \\q'")
+
+	p := New()
+
+	_, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.NotNil(t, err)
+	require.Contains(t, err.Message, "test.neva:3:5: Invalid escape sequence in string literal: \\q")
+}
+
 func TestParser_ParseFile_Range(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -459,8 +757,8 @@ func TestParser_ParseFile_Range(t *testing.T) {
 			check: func(t *testing.T, net []src.Connection) {
 				conn := net[0].Normal
 				require.NotNil(t, conn.Senders[0].Range)
-				require.Equal(t, int64(1), conn.Senders[0].Range.From)
-				require.Equal(t, int64(10), conn.Senders[0].Range.To)
+				require.Equal(t, 1, *conn.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 10, *conn.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out", conn.Receivers[0].PortAddr.Port)
 			},
 		},
@@ -477,14 +775,14 @@ func TestParser_ParseFile_Range(t *testing.T) {
 
 				conn1 := net[0].Normal
 				require.NotNil(t, conn1.Senders[0].Range)
-				require.Equal(t, int64(1), conn1.Senders[0].Range.From)
-				require.Equal(t, int64(5), conn1.Senders[0].Range.To)
+				require.Equal(t, 1, *conn1.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 5, *conn1.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out1", conn1.Receivers[0].PortAddr.Port)
 
 				conn2 := net[1].Normal
 				require.NotNil(t, conn2.Senders[0].Range)
-				require.Equal(t, int64(10), conn2.Senders[0].Range.From)
-				require.Equal(t, int64(20), conn2.Senders[0].Range.To)
+				require.Equal(t, 10, *conn2.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 20, *conn2.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out2", conn2.Receivers[0].PortAddr.Port)
 			},
 		},
@@ -500,8 +798,8 @@ func TestParser_ParseFile_Range(t *testing.T) {
 
 				conn := net[0].Normal
 				require.NotNil(t, conn.Senders[0].Range)
-				require.Equal(t, int64(-5), conn.Senders[0].Range.From)
-				require.Equal(t, int64(5), conn.Senders[0].Range.To)
+				require.Equal(t, -5, *conn.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 5, *conn.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out", conn.Receivers[0].PortAddr.Port)
 			},
 		},
@@ -517,8 +815,8 @@ func TestParser_ParseFile_Range(t *testing.T) {
 
 				conn := net[0].Normal
 				require.NotNil(t, conn.Senders[0].Range)
-				require.Equal(t, int64(1), conn.Senders[0].Range.From)
-				require.Equal(t, int64(-5), conn.Senders[0].Range.To)
+				require.Equal(t, 1, *conn.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, -5, *conn.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out", conn.Receivers[0].PortAddr.Port)
 			},
 		},
@@ -536,8 +834,8 @@ func TestParser_ParseFile_Range(t *testing.T) {
 
 				conn1 := net[0].Normal
 				require.NotNil(t, conn1.Senders[0].Range)
-				require.Equal(t, int64(1), conn1.Senders[0].Range.From)
-				require.Equal(t, int64(10), conn1.Senders[0].Range.To)
+				require.Equal(t, 1, *conn1.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 10, *conn1.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out1", conn1.Receivers[0].PortAddr.Port)
 
 				conn2 := net[1].Normal
@@ -547,11 +845,39 @@ func TestParser_ParseFile_Range(t *testing.T) {
 
 				conn3 := net[2].Normal
 				require.NotNil(t, conn3.Senders[0].Range)
-				require.Equal(t, int64(20), conn3.Senders[0].Range.From)
-				require.Equal(t, int64(30), conn3.Senders[0].Range.To)
+				require.Equal(t, 20, *conn3.Senders[0].Range.From.Const.Value.Message.Int)
+				require.Equal(t, 30, *conn3.Senders[0].Range.To.Const.Value.Message.Int)
 				require.Equal(t, "out3", conn3.Receivers[0].PortAddr.Port)
 			},
 		},
+		{
+			name: "float range with step",
+			text: `
+				def C1() () {
+					0.5..-2.5..0.5 -> :out
+				}
+			`,
+			check: func(t *testing.T, net []src.Connection) {
+				rangeExpr := net[0].Normal.Senders[0].Range
+				require.Equal(t, 0.5, *rangeExpr.From.Const.Value.Message.Float)
+				require.Equal(t, -2.5, *rangeExpr.To.Const.Value.Message.Float)
+				require.Equal(t, 0.5, *rangeExpr.Step.Const.Value.Message.Float)
+			},
+		},
+		{
+			name: "const ref and port addr members",
+			text: `
+				def C1() () {
+					$from..:to..2 -> :out
+				}
+			`,
+			check: func(t *testing.T, net []src.Connection) {
+				rangeExpr := net[0].Normal.Senders[0].Range
+				require.Equal(t, "from", rangeExpr.From.Const.Value.Ref.Name)
+				require.Equal(t, "to", rangeExpr.To.PortAddr.Port)
+				require.Equal(t, 2, *rangeExpr.Step.Const.Value.Message.Int)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -683,6 +1009,24 @@ func TestParser_ParseFile_Binary(t *testing.T) {
 			`,
 			operator: "<=",
 		},
+		{
+			name: "left shift",
+			text: `
+				def C1() () {
+					(a << b) -> receiver
+				}
+			`,
+			operator: "<<",
+		},
+		{
+			name: "right shift",
+			text: `
+				def C1() () {
+					(a >> b) -> receiver
+				}
+			`,
+			operator: ">>",
+		},
 	}
 
 	for _, tt := range tests {
@@ -709,6 +1053,62 @@ func TestParser_ParseFile_Binary(t *testing.T) {
 	}
 }
 
+func TestParser_ParseFile_SplitShiftOperator(t *testing.T) {
+	for _, text := range []string{
+		"def C1() () {\n\t(a < < b) -> receiver\n}",
+		"def C1() () {\n\t(a > > b) -> receiver\n}",
+	} {
+		p := New()
+
+		_, err := p.parseFile(location.ModRef, location.Package, location.Filename, []byte(text))
+		require.NotNil(t, err, text)
+	}
+}
+
+func TestParser_ParseFile_NestedTypeArgs(t *testing.T) {
+	text := []byte(`
+		type t1 list<list<int>>
+		type t2 dict<list<list<int>>>
+		type t3 list<list<list<list<int>>>>
+		type t4 list<string | list<int>>
+		type t5<T int | list<int>> list<T>
+
+		def C1() () {
+			n Foo<list<int>>
+			---
+			:start -> n
+		}
+	`)
+
+	p := New()
+
+	got, err := p.parseFile(location.ModRef, location.Package, location.Filename, text)
+	require.Nil(t, err)
+
+	tests := []struct {
+		entity string
+		want   string
+	}{
+		{"t1", "list<list<int>>"},
+		{"t2", "dict<list<list<int>>>"},
+		{"t3", "list<list<list<list<int>>>>"},
+		{"t4", "list<string | list<int>>"},
+		{"t5", "list<T>"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, got.Entities[tt.entity].Type.BodyExpr.String(), tt.entity)
+	}
+
+	params := got.Entities["t5"].Type.Params
+	require.Equal(t, 1, len(params))
+	require.Equal(t, "T", params[0].Name)
+	require.Equal(t, "int | list<int>", params[0].Constr.String())
+
+	args := got.Entities["C1"].Component.Nodes["n"].TypeArgs
+	require.Equal(t, 1, len(args))
+	require.Equal(t, "list<int>", args[0].String())
+}
+
 func TestParser_ParseFile_ComplexBinaryAndTernary(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -1014,3 +1414,39 @@ func TestParser_ParseFile_Switch(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseGoPackages(t *testing.T) {
+	rawPkgs := map[string]compiler.RawPackage{
+		".": {
+			"registry.go": []byte(`
+				package externs
+
+				func Registry() map[string]runtime.FuncCreator {
+					return map[string]runtime.FuncCreator{
+						"foo": foo{},
+						"bar": bar{},
+					}
+				}
+			`),
+		},
+	}
+
+	p := New()
+
+	got, err := p.ParseGoPackages(location.ModRef, rawPkgs)
+	require.Nil(t, err)
+	require.Equal(t, []string{"bar", "foo"}, got["."].Externs)
+}
+
+func TestParser_ParseGoPackages_NoRegistry(t *testing.T) {
+	rawPkgs := map[string]compiler.RawPackage{
+		".": {
+			"foo.go": []byte(`package externs`),
+		},
+	}
+
+	p := New()
+
+	_, err := p.ParseGoPackages(location.ModRef, rawPkgs)
+	require.NotNil(t, err)
+}
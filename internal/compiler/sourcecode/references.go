@@ -0,0 +1,50 @@
+package sourcecode
+
+import "github.com/nevalang/neva/internal/compiler/sourcecode/core"
+
+// References is a collection of references resolved by scope.
+// It's used to find imports and entities that are never used.
+// Methods of nil collection are no-op, so scope doesn't need to check it.
+type References struct {
+	imports  map[core.Location]map[string]struct{} // file location -> used import aliases
+	entities map[core.Location]map[string]struct{} // package location -> used local entities
+}
+
+func NewReferences() *References {
+	return &References{
+		imports:  map[core.Location]map[string]struct{}{},
+		entities: map[core.Location]map[string]struct{}{},
+	}
+}
+
+// IsImportUsed tells whether import with given alias was used in the file.
+func (r *References) IsImportUsed(file core.Location, alias string) bool {
+	_, ok := r.imports[file][alias]
+	return ok
+}
+
+// IsEntityUsed tells whether entity was referenced from its own package.
+func (r *References) IsEntityUsed(pkg core.Location, name string) bool {
+	_, ok := r.entities[pkg][name]
+	return ok
+}
+
+func (r *References) addImport(file core.Location, alias string) {
+	if r == nil {
+		return
+	}
+	if r.imports[file] == nil {
+		r.imports[file] = map[string]struct{}{}
+	}
+	r.imports[file][alias] = struct{}{}
+}
+
+func (r *References) addEntity(pkg core.Location, name string) {
+	if r == nil {
+		return
+	}
+	if r.entities[pkg] == nil {
+		r.entities[pkg] = map[string]struct{}{}
+	}
+	r.entities[pkg][name] = struct{}{}
+}
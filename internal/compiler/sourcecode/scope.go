@@ -21,6 +21,13 @@ func NewScope(build Build, location core.Location) Scope {
 type Scope struct {
 	loc   core.Location
 	build Build
+	refs  *References // optional, if set scope records every reference it resolves
+}
+
+// WithReferences returns a new scope that records resolved references into given collection
+func (s Scope) WithReferences(refs *References) Scope {
+	s.refs = refs
+	return s
 }
 
 // Location returns a location of the current scope
@@ -28,11 +35,18 @@ func (s Scope) Location() *core.Location {
 	return &s.loc
 }
 
+// Module returns the module of the current scope's location
+func (s Scope) Module() (Module, bool) {
+	mod, ok := s.build.Modules[s.loc.ModRef]
+	return mod, ok
+}
+
 // Relocate returns a new scope with a given location
 func (s Scope) Relocate(location core.Location) Scope {
 	return Scope{
 		loc:   location,
 		build: s.build,
+		refs:  s.refs,
 	}
 }
 
@@ -92,6 +106,7 @@ func (s Scope) entity(entityRef core.EntityRef) (Entity, core.Location, error) {
 	if entityRef.Pkg == "" { // local reference (current package or builtin)
 		entity, fileName, ok := curPkg.Entity(entityRef.Name)
 		if ok {
+			s.refs.addEntity(core.Location{ModRef: s.loc.ModRef, Package: s.loc.Package}, entityRef.Name)
 			return entity, core.Location{
 				ModRef:   s.loc.ModRef,
 				Package:  s.loc.Package,
@@ -160,6 +175,8 @@ func (s Scope) entity(entityRef core.EntityRef) (Entity, core.Location, error) {
 		return Entity{}, core.Location{}, errors.New("entity is not public")
 	}
 
+	s.refs.addImport(s.loc, entityRef.Pkg)
+
 	return entity, core.Location{
 		ModRef:   modRef,
 		Package:  pkgImport.Package,
@@ -4,6 +4,8 @@ package sourcecode
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
 	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
@@ -18,8 +20,28 @@ type Build struct {
 
 // Module is unit of distribution.
 type Module struct {
-	Manifest ModuleManifest     `json:"manifest,omitempty"`
-	Packages map[string]Package `json:"packages,omitempty"`
+	Manifest   ModuleManifest       `json:"manifest,omitempty"`
+	Packages   map[string]Package   `json:"packages,omitempty"`
+	GoPackages map[string]GoPackage `json:"goPackages,omitempty"`
+}
+
+// GoPackage is a package written in Go that implements module's runtime functions.
+// Components of the module can refer to these functions via #extern directive.
+type GoPackage struct {
+	Files   map[string][]byte `json:"files,omitempty"`
+	Externs []string          `json:"externs,omitempty"` // Keys of the map returned by package's Registry function
+}
+
+// Extern returns path to the Go package that provides runtime function with the given ref.
+func (mod Module) Extern(ref string) (string, bool) {
+	for path, goPkg := range mod.GoPackages {
+		for _, extern := range goPkg.Externs {
+			if extern == ref {
+				return path, true
+			}
+		}
+	}
+	return "", false
 }
 
 func (mod Module) Entity(entityRef core.EntityRef) (entity Entity, filename string, err error) {
@@ -203,24 +225,32 @@ type Const struct {
 type ConstValue struct {
 	Ref     *core.EntityRef `json:"ref,omitempty"`
 	Message *MsgLiteral     `json:"value,omitempty"` // literal
+	// Binary is a constant expression like `5 * time.second`, operands are constants.
+	// It's evaluated by analyzer and replaced with the resulting literal.
+	Binary *Binary `json:"binary,omitempty"`
 }
 
 func (c ConstValue) String() string {
 	if c.Ref != nil {
 		return c.Ref.String()
 	}
+	if c.Binary != nil {
+		return c.Binary.String()
+	}
 	return c.Message.String()
 }
 
 type MsgLiteral struct {
-	Bool         *bool                 `json:"bool,omitempty"`
-	Int          *int                  `json:"int,omitempty"`
-	Float        *float64              `json:"float,omitempty"`
-	Str          *string               `json:"str,omitempty"`
-	List         []ConstValue          `json:"vec,omitempty"`
-	DictOrStruct map[string]ConstValue `json:"dict,omitempty"` // TODO separate map and struct
-	Enum         *EnumMessage          `json:"enum,omitempty"`
-	Meta         core.Meta             `json:"meta,omitempty"`
+	Bool   *bool                 `json:"bool,omitempty"`
+	Int    *int                  `json:"int,omitempty"`
+	Float  *float64              `json:"float,omitempty"`
+	Str    *string               `json:"str,omitempty"`
+	List   []ConstValue          `json:"vec,omitempty"`
+	Dict   map[string]ConstValue `json:"dict,omitempty"`   // {"a": 1}
+	Struct map[string]ConstValue `json:"struct,omitempty"` // {a: 1}
+	Enum   *EnumMessage          `json:"enum,omitempty"`
+	Union  *UnionLiteral         `json:"union,omitempty"`
+	Meta   core.Meta             `json:"meta,omitempty"`
 }
 
 type EnumMessage struct {
@@ -228,6 +258,24 @@ type EnumMessage struct {
 	MemberName string
 }
 
+func (e EnumMessage) String() string {
+	return e.EnumRef.String() + "::" + e.MemberName
+}
+
+// UnionLiteral is a tagged union value, e.g. `Input::None` or `Input::Int(42)`.
+type UnionLiteral struct {
+	EntityRef core.EntityRef `json:"entityRef,omitempty"`
+	Tag       string         `json:"tag,omitempty"`
+	Data      *ConstValue    `json:"data,omitempty"` // nil for tags without payload
+}
+
+func (u UnionLiteral) String() string {
+	if u.Data == nil {
+		return u.EntityRef.String() + "::" + u.Tag
+	}
+	return fmt.Sprintf("%v::%v(%v)", u.EntityRef, u.Tag, u.Data)
+}
+
 func (m MsgLiteral) String() string {
 	switch {
 	case m.Bool != nil:
@@ -247,16 +295,34 @@ func (m MsgLiteral) String() string {
 			}
 		}
 		return s + "]"
-	case len(m.DictOrStruct) != 0:
-		s := "{"
-		for key, value := range m.DictOrStruct {
-			s += fmt.Sprintf("%q: %v", key, value.String())
-		}
-		return s + "}"
+	case m.Dict != nil:
+		return "{" + constValuesString(m.Dict, "%q: %v") + "}"
+	case m.Struct != nil:
+		return "{" + constValuesString(m.Struct, "%s: %v") + "}"
+	case m.Enum != nil:
+		return m.Enum.String()
+	case m.Union != nil:
+		return m.Union.String()
 	}
 	return "message"
 }
 
+// constValuesString formats key-value pairs sorted by key so the output is stable.
+func constValuesString(values map[string]ConstValue, format string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(format, key, values[key].String()))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
 type IO struct {
 	In   map[string]Port `json:"in,omitempty"`
 	Out  map[string]Port `json:"out,omitempty"`
@@ -323,10 +389,30 @@ type ConnectionSender struct {
 	Unary          *Unary    `json:"unary,omitempty"`
 	Binary         *Binary   `json:"binary,omitempty"`
 	Ternary        *Ternary  `json:"ternary,omitempty"`
+	Union          *Union    `json:"union,omitempty"`
 	StructSelector []string  `json:"selector,omitempty"`
 	Meta           core.Meta `json:"meta,omitempty"`
 }
 
+// Union is a sender that wraps data into tagged union, e.g. `Input::Int(:x)`.
+// Data is nil only for switch cases, e.g. `Input::Int -> :y` inside of `switch { ... }`.
+type Union struct {
+	EntityRef core.EntityRef    `json:"entityRef,omitempty"`
+	Tag       string            `json:"tag,omitempty"`
+	Data      *ConnectionSender `json:"data,omitempty"`
+	Meta      core.Meta         `json:"meta,omitempty"`
+	// This field is result of semantic analysis and is unknown at parsing time.
+	// It's resolved union type, desugarer needs it to figure out tag index.
+	AnalyzedType ts.Expr `json:"type,omitempty"`
+}
+
+func (u Union) String() string {
+	if u.Data == nil {
+		return u.EntityRef.String() + "::" + u.Tag
+	}
+	return fmt.Sprintf("%v::%v(%v)", u.EntityRef, u.Tag, u.Data)
+}
+
 type Binary struct {
 	Left     ConnectionSender `json:"left,omitempty"`
 	Right    ConnectionSender `json:"right,omitempty"`
@@ -399,6 +485,27 @@ const (
 	BitRshOp BinaryOperator = ">>"
 )
 
+// Precedence is used to group operators in constant expressions where parentheses are optional.
+// It's the same as in Go except `**` that binds stronger than multiplication.
+func (op BinaryOperator) Precedence() int {
+	switch op {
+	case PowOp:
+		return 6
+	case MulOp, DivOp, ModOp, BitLshOp, BitRshOp, BitAndOp:
+		return 5
+	case AddOp, SubOp, BitOrOp, BitXorOp:
+		return 4
+	case EqOp, NeOp, GtOp, LtOp, GeOp, LeOp:
+		return 3
+	case AndOp:
+		return 2
+	case OrOp:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func (s ConnectionSender) String() string {
 	selectorsString := ""
 	if len(s.StructSelector) != 0 {
@@ -421,19 +528,30 @@ func (s ConnectionSender) String() string {
 		result = s.Binary.String()
 	case s.Ternary != nil:
 		result = s.Ternary.String()
+	case s.Union != nil:
+		result = s.Union.String()
 	}
 
 	return result + selectorsString
 }
 
+// Range is a `from..to` or `from..to..step` expression.
+// Members are int or float literals, constant references or port addresses.
 type Range struct {
-	From int64     `json:"from"`
-	To   int64     `json:"to"`
-	Meta core.Meta `json:"meta,omitempty"`
+	From ConnectionSender  `json:"from"`
+	To   ConnectionSender  `json:"to"`
+	Step *ConnectionSender `json:"step,omitempty"` // nil means step 1
+	Meta core.Meta         `json:"meta,omitempty"`
+	// This field is result of semantic analysis and is unknown at parsing time.
+	// It's type of range members (int or float), desugarer needs it to use overloaded Range.
+	AnalyzedType ts.Expr `json:"type,omitempty"`
 }
 
 func (r Range) String() string {
-	return fmt.Sprintf("%v..%v", r.From, r.To)
+	if r.Step == nil {
+		return fmt.Sprintf("%v..%v", r.From, r.To)
+	}
+	return fmt.Sprintf("%v..%v..%v", r.From, r.To, *r.Step)
 }
 
 type PortAddr struct {
@@ -48,6 +48,20 @@ func (h Helper) Enum(els ...string) Expr {
 	}
 }
 
+func (h Helper) Tagged(tags ...UnionTag) Expr {
+	return Expr{
+		Lit: &LitExpr{Tagged: tags},
+	}
+}
+
+// Tag creates tagged union tag, tag has payload only if its type is passed
+func (h Helper) Tag(name string, payload ...Expr) UnionTag {
+	if len(payload) == 0 {
+		return UnionTag{Name: name}
+	}
+	return UnionTag{Name: name, Type: &payload[0]}
+}
+
 func (h Helper) Union(els ...Expr) Expr {
 	if els == nil { // for !lit.Empty()
 		els = []Expr{}
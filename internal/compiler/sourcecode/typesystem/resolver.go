@@ -12,12 +12,13 @@ var (
 	ErrScope              = errors.New("can't get type def from scope by ref")
 	ErrScopeUpdate        = errors.New("scope update")
 	ErrInstArgsCount      = errors.New("Wrong number of type arguments")
-	ErrIncompatArg        = errors.New("argument is not subtype of the parameter's contraint")
+	ErrIncompatArg        = errors.New("Incompatible type argument")
 	ErrUnresolvedArg      = errors.New("can't resolve argument")
 	ErrConstr             = errors.New("can't resolve constraint")
 	ErrArrType            = errors.New("could not resolve array type")
 	ErrUnionUnresolvedEl  = errors.New("can't resolve union element")
 	ErrRecFieldUnresolved = errors.New("can't resolve struct field")
+	ErrTagUnresolved      = errors.New("can't resolve union tag")
 	ErrInvalidDef         = errors.New("invalid definition")
 	ErrTerminator         = errors.New("recursion terminator")
 )
@@ -151,7 +152,7 @@ func (r Resolver) CheckArgsCompatibility(args []Expr, params []Param, scope Scop
 			resolvedSup,
 			TerminatorParams{Scope: scope},
 		); err != nil {
-			return err
+			return fmt.Errorf("%w: %v does not satisfy %v: %v", ErrIncompatArg, arg, param.Constr, err)
 		}
 	}
 
@@ -192,6 +193,27 @@ func (r Resolver) resolveExpr(
 			return Expr{
 				Lit: &LitExpr{Union: resolvedUnion},
 			}, nil
+		case TaggedUnionLitType:
+			resolvedTags := make([]UnionTag, 0, len(expr.Lit.Tagged))
+			for _, tag := range expr.Lit.Tagged {
+				if tag.Type == nil {
+					resolvedTags = append(resolvedTags, tag)
+					continue
+				}
+				// virtual ref "union" works the same way as "struct" for recursive types
+				newTrace := Trace{
+					prev: trace,
+					cur:  core.EntityRef{Name: "union"},
+				}
+				resolvedTagExpr, err := r.resolveExpr(*tag.Type, scope, frame, &newTrace)
+				if err != nil {
+					return Expr{}, fmt.Errorf("%w: %v: %v", ErrTagUnresolved, tag.Name, err)
+				}
+				resolvedTags = append(resolvedTags, UnionTag{Name: tag.Name, Type: &resolvedTagExpr})
+			}
+			return Expr{
+				Lit: &LitExpr{Tagged: resolvedTags},
+			}, nil
 		case StructLitType:
 			resolvedStruct := make(map[string]Expr, len(expr.Lit.Struct))
 			for field, fieldExpr := range expr.Lit.Struct {
@@ -283,7 +305,13 @@ func (r Resolver) resolveExpr(
 		}
 
 		if err := r.checker.Check(resolvedArg, resolvedConstr, params); err != nil {
-			return Expr{}, fmt.Errorf(" %w: %v", ErrIncompatArg, err)
+			return Expr{}, fmt.Errorf(
+				"%w: %v does not satisfy %v: %v",
+				ErrIncompatArg,
+				expr.Inst.Args[i],
+				param.Constr,
+				err,
+			)
 		}
 	}
 
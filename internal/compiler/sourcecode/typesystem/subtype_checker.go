@@ -19,10 +19,13 @@ var (
 	ErrStructLen     = errors.New("Subtype struct must contain >= fields than supertype")
 	ErrStructField   = errors.New("Subtype struct field must be subtype of corresponding supertype field")
 	ErrStructNoField = errors.New("Subtype struct is missing field of supertype")
-	ErrUnionArg      = errors.New("Subtype must be either union or literal")
+	ErrUnionArg      = errors.New("Subtype must be element of supertype union")
 	ErrUnionsLen     = errors.New("Subtype union must be <= supertype union")
 	ErrUnions        = errors.New("Subtype union el must be subtype of supertype union")
 	ErrDiffLitTypes  = errors.New("Subtype and supertype lits must be of the same type")
+	ErrBigTagged     = errors.New("Subtype tagged union must be <= supertype tagged union")
+	ErrTag           = errors.New("Subtype tagged union tag doesn't match supertype")
+	ErrNotComparable = errors.New("Subtype must be comparable")
 )
 
 type SubtypeChecker struct {
@@ -46,6 +49,10 @@ func (s SubtypeChecker) Check(
 		return nil
 	}
 
+	if isBuiltinInst(constr, "comparable") {
+		return s.checkComparable(expr)
+	}
+
 	isConstraintInstance := constr.Lit.Empty()
 	areKindsDifferent := expr.Lit.Empty() != isConstraintInstance
 	isConstraintUnion := constr.Lit != nil &&
@@ -133,6 +140,22 @@ func (s SubtypeChecker) Check(
 				return fmt.Errorf("%w: #%d got %s, want %s", ErrEnumEl, i, exprEl, constr.Lit.Enum[i])
 			}
 		}
+	case TaggedUnionLitType: // union {a int, b} <: union {a int|str, b, c}
+		if len(expr.Lit.Tagged) > len(constr.Lit.Tagged) {
+			return fmt.Errorf("%w: got %d, want %d", ErrBigTagged, len(expr.Lit.Tagged), len(constr.Lit.Tagged))
+		}
+		for i, exprTag := range expr.Lit.Tagged {
+			constrTag := constr.Lit.Tagged[i]
+			if exprTag.Name != constrTag.Name || (exprTag.Type == nil) != (constrTag.Type == nil) {
+				return fmt.Errorf("%w: #%d got %s, want %s", ErrTag, i, exprTag.Name, constrTag.Name)
+			}
+			if exprTag.Type == nil {
+				continue
+			}
+			if err := s.Check(*exprTag.Type, *constrTag.Type, params); err != nil {
+				return fmt.Errorf("%w: tag '%s': %v", ErrTag, exprTag.Name, err)
+			}
+		}
 	case StructLitType: // {x int, y float} <: {x int|str}
 		if len(expr.Lit.Struct) < len(constr.Lit.Struct) {
 			return fmt.Errorf(
@@ -183,7 +206,7 @@ func (s SubtypeChecker) Check(
 					return nil
 				}
 			}
-			return fmt.Errorf("%w: want %v, got %v", ErrUnionArg, constr, expr)
+			return fmt.Errorf("%w: %v does not satisfy %v", ErrUnionArg, expr, constr)
 		}
 		// If we here, then expr is union
 		if len(expr.Lit.Union) > len(constr.Lit.Union) {
@@ -206,6 +229,56 @@ func (s SubtypeChecker) Check(
 	return nil
 }
 
+// checkComparable checks that messages of resolved type can be compared with each other.
+// Lists, dicts and any are not comparable, other types are comparable if all their elements are.
+func (s SubtypeChecker) checkComparable(expr Expr) error {
+	if expr.Lit.Empty() {
+		if isBuiltinInst(expr, "any") || isBuiltinInst(expr, "list") || isBuiltinInst(expr, "dict") {
+			return fmt.Errorf("%w, got %v", ErrNotComparable, expr)
+		}
+		for _, arg := range expr.Inst.Args { // e.g. maybe<T>
+			if err := s.checkComparable(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch expr.Lit.Type() {
+	case UnionLitType:
+		for _, el := range expr.Lit.Union {
+			if err := s.checkComparable(el); err != nil {
+				return err
+			}
+		}
+	case TaggedUnionLitType:
+		for _, tag := range expr.Lit.Tagged {
+			if tag.Type == nil {
+				continue
+			}
+			if err := s.checkComparable(*tag.Type); err != nil {
+				return fmt.Errorf("tag '%s': %w", tag.Name, err)
+			}
+		}
+	case StructLitType:
+		for fieldName, fieldExpr := range expr.Lit.Struct {
+			if err := s.checkComparable(fieldExpr); err != nil {
+				return fmt.Errorf("field '%s': %w", fieldName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isBuiltinInst reports whether expr is instance of the builtin type with given name.
+func isBuiltinInst(expr Expr, name string) bool {
+	if expr.Inst == nil || expr.Inst.Ref.Name != name {
+		return false
+	}
+	return expr.Inst.Ref.Pkg == "" || expr.Inst.Ref.Pkg == "builtin"
+}
+
 func (SubtypeChecker) getNewTerminatorParams(
 	old TerminatorParams,
 	subRef, supRef core.EntityRef,
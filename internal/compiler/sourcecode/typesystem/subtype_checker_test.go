@@ -153,6 +153,25 @@ func TestCompatChecker_Check(t *testing.T) { //nolint:maintidx
 			superType: h.Enum("a", "b", "c"),
 			wantErr:   nil,
 		},
+		// tagged union
+		{
+			name:      "subtype and supertype are tagged unions, subtype is bigger",
+			subType:   h.Tagged(h.Tag("a"), h.Tag("b")),
+			superType: h.Tagged(h.Tag("a")),
+			wantErr:   ts.ErrBigTagged,
+		},
+		{
+			name:      "subtype and supertype are tagged unions, subtype tag has payload and supertype's doesn't",
+			subType:   h.Tagged(h.Tag("a", h.Enum("x", "y")), h.Tag("b")),
+			superType: h.Tagged(h.Tag("a"), h.Tag("b")),
+			wantErr:   ts.ErrTag,
+		},
+		{
+			name:      "subtype and supertype are tagged unions, subtype payload is subtype of supertype's",
+			subType:   h.Tagged(h.Tag("a", h.Enum("x", "y")), h.Tag("b")),
+			superType: h.Tagged(h.Tag("a", h.Enum("x", "y", "z")), h.Tag("b"), h.Tag("c")),
+			wantErr:   nil,
+		},
 		// struct
 		{
 			name:    "subtype and supertype are structures, subtype has less fields",
@@ -257,6 +276,61 @@ func TestCompatChecker_Check(t *testing.T) { //nolint:maintidx
 			},
 			wantErr: nil,
 		},
+		// COMPARABLE
+		{
+			name:      "primitive satisfies comparable",
+			subType:   h.Inst("int"),
+			superType: h.Inst("comparable"),
+			wantErr:   nil,
+		},
+		{
+			name:      "comparable satisfies comparable", // type parameter constrained with comparable
+			subType:   h.Inst("comparable"),
+			superType: h.Inst("comparable"),
+			wantErr:   nil,
+		},
+		{
+			name:      "list does not satisfy comparable",
+			subType:   h.Inst("list", h.Inst("int")),
+			superType: h.Inst("comparable"),
+			wantErr:   ts.ErrNotComparable,
+		},
+		{
+			name:      "any does not satisfy comparable",
+			subType:   h.Inst("any"),
+			superType: h.Inst("comparable"),
+			wantErr:   ts.ErrNotComparable,
+		},
+		{
+			name:      "maybe of dict does not satisfy comparable",
+			subType:   h.Inst("maybe", h.Inst("dict", h.Inst("int"))),
+			superType: h.Inst("comparable"),
+			wantErr:   ts.ErrNotComparable,
+		},
+		{
+			name: "struct of comparable fields satisfies comparable",
+			subType: h.Struct(map[string]ts.Expr{
+				"a": h.Inst("int"),
+				"b": h.Union(h.Inst("string"), h.Enum("x", "y")),
+			}),
+			superType: h.Inst("comparable"),
+			wantErr:   nil,
+		},
+		{
+			name: "struct with list field does not satisfy comparable",
+			subType: h.Struct(map[string]ts.Expr{
+				"a": h.Inst("int"),
+				"b": h.Inst("list", h.Inst("int")),
+			}),
+			superType: h.Inst("comparable"),
+			wantErr:   ts.ErrNotComparable,
+		},
+		{
+			name:      "tagged union with dict payload does not satisfy comparable",
+			subType:   h.Tagged(h.Tag("None"), h.Tag("Some", h.Inst("dict", h.Inst("int")))),
+			superType: h.Inst("comparable"),
+			wantErr:   ts.ErrNotComparable,
+		},
 	}
 
 	for _, tt := range tests {
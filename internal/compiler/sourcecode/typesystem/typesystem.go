@@ -77,6 +77,20 @@ func (expr Expr) String() string {
 			count++
 		}
 		return str + "}"
+	case TaggedUnionLitType:
+		str += "union {"
+		for i, tag := range expr.Lit.Tagged {
+			str += " " + tag.Name
+			if tag.Type != nil {
+				str += " " + tag.Type.String()
+			}
+			if i == len(expr.Lit.Tagged)-1 {
+				str += " "
+			} else {
+				str += ","
+			}
+		}
+		return str + "}"
 	case UnionLitType:
 		for i, el := range expr.Lit.Union {
 			str += el.String()
@@ -116,13 +130,35 @@ type LitExpr struct {
 	Struct map[string]Expr `json:"struct,omitempty"`
 	Enum   []string        `json:"enum,omitempty"`
 	Union  []Expr          `json:"union,omitempty"`
+	Tagged []UnionTag      `json:"tagged,omitempty"`
+}
+
+// UnionTag is a variant of the tagged union. Tag without type has no payload.
+// Tag's index in the union is its runtime representation.
+type UnionTag struct {
+	Name string `json:"name,omitempty"`
+	Type *Expr  `json:"type,omitempty"`
 }
 
 func (lit *LitExpr) Empty() bool {
 	return lit == nil ||
 		lit.Struct == nil &&
 			lit.Enum == nil &&
-			lit.Union == nil
+			lit.Union == nil &&
+			lit.Tagged == nil
+}
+
+// Tag returns index and payload type (nil if there's no payload) of the tagged union tag.
+func (lit *LitExpr) Tag(name string) (int, *Expr, bool) {
+	if lit == nil {
+		return 0, nil, false
+	}
+	for i, tag := range lit.Tagged {
+		if tag.Name == name {
+			return i, tag.Type, true
+		}
+	}
+	return 0, nil, false
 }
 
 // Always call Validate before
@@ -136,6 +172,8 @@ func (lit *LitExpr) Type() LiteralType {
 		return EnumLitType
 	case lit.Union != nil:
 		return UnionLitType
+	case lit.Tagged != nil:
+		return TaggedUnionLitType
 	}
 	return EmptyLitType // for inst or invalid lit
 }
@@ -147,4 +185,5 @@ const (
 	StructLitType
 	EnumLitType
 	UnionLitType
+	TaggedUnionLitType
 )
@@ -21,22 +21,27 @@ func TestLiteralExpr_Empty(t *testing.T) {
 	}{
 		{
 			name: "all 4 fields: arr, enum, union and struct are empty",
-			lit:  ts.LitExpr{nil, nil, nil},
+			lit:  ts.LitExpr{nil, nil, nil, nil},
 			want: true,
 		},
 		{
 			name: "struct not empty",
-			lit:  ts.LitExpr{map[string]ts.Expr{}, nil, nil},
+			lit:  ts.LitExpr{map[string]ts.Expr{}, nil, nil, nil},
 			want: false,
 		},
 		{
 			name: "enum not empty",
-			lit:  ts.LitExpr{nil, []string{}, nil},
+			lit:  ts.LitExpr{nil, []string{}, nil, nil},
 			want: false,
 		},
 		{
 			name: "union not empty",
-			lit:  ts.LitExpr{nil, nil, []ts.Expr{}},
+			lit:  ts.LitExpr{nil, nil, []ts.Expr{}, nil},
+			want: false,
+		},
+		{
+			name: "tagged union not empty",
+			lit:  ts.LitExpr{nil, nil, nil, []ts.UnionTag{}},
 			want: false,
 		},
 	}
@@ -60,24 +65,29 @@ func TestLiteralExpr_Type(t *testing.T) {
 	}{
 		{
 			name: "unknown",
-			lit:  ts.LitExpr{nil, nil, nil},
+			lit:  ts.LitExpr{nil, nil, nil, nil},
 			want: ts.EmptyLitType,
 		},
 		{
 			name: "struct",
-			lit:  ts.LitExpr{map[string]ts.Expr{}, nil, nil},
+			lit:  ts.LitExpr{map[string]ts.Expr{}, nil, nil, nil},
 			want: ts.StructLitType,
 		},
 		{
 			name: "enum",
-			lit:  ts.LitExpr{nil, []string{}, nil},
+			lit:  ts.LitExpr{nil, []string{}, nil, nil},
 			want: ts.EnumLitType,
 		},
 		{
 			name: "union",
-			lit:  ts.LitExpr{nil, nil, []ts.Expr{}},
+			lit:  ts.LitExpr{nil, nil, []ts.Expr{}, nil},
 			want: ts.UnionLitType,
 		},
+		{
+			name: "tagged union",
+			lit:  ts.LitExpr{nil, nil, nil, []ts.UnionTag{}},
+			want: ts.TaggedUnionLitType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -17,6 +17,8 @@ var (
 	ErrEnumLen             = errors.New("enum len must be >= 2")
 	ErrUnionLen            = errors.New("union len must be >= 2")
 	ErrEnumDupl            = errors.New("enum contains duplicate elements")
+	ErrTaggedUnionLen      = errors.New("tagged union len must be >= 2")
+	ErrTaggedUnionDupl     = errors.New("tagged union contains duplicate tags")
 	ErrParamDuplicate      = errors.New("params must have unique names")
 	ErrParams              = errors.New("bad params")
 )
@@ -42,7 +44,7 @@ func (v Validator) CheckParamUnique(params []Param) error {
 
 // Validate makes shallow validation of expr.
 // It checks that it's inst or literal, not both and not neither; All insts are valid by default;
-// Arr, union and enum must have size >= 2; Enum must have no duplicate elements.
+// Arr, union, tagged union and enum must have size >= 2; Enum and tagged union must have no duplicate elements.
 func (v Validator) Validate(expr Expr) error {
 	if expr.Lit.Empty() == (expr.Inst == nil) {
 		return ErrExprMustBeInstOrLit
@@ -76,6 +78,17 @@ func (v Validator) Validate(expr Expr) error {
 		case expr.Lit.Union != nil, expr.Lit.Struct != nil:
 			return ErrEnumLitKind
 		}
+	case TaggedUnionLitType:
+		if l := len(expr.Lit.Tagged); l < 2 {
+			return fmt.Errorf("%w: got %d", ErrTaggedUnionLen, l)
+		}
+		set := make(map[string]struct{}, len(expr.Lit.Tagged))
+		for _, tag := range expr.Lit.Tagged {
+			if _, ok := set[tag.Name]; ok {
+				return fmt.Errorf("%w: %s", ErrTaggedUnionDupl, tag.Name)
+			}
+			set[tag.Name] = struct{}{}
+		}
 	}
 
 	return nil
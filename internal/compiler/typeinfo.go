@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"fmt"
+
+	ts "github.com/nevalang/neva/internal/compiler/sourcecode/typesystem"
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+// TypeInfo turns resolved type expression into runtime type description.
+// It's used for nodes of components with #typeinfo directive.
+// Only types that have runtime representation are supported, type parameters and untagged unions are not.
+func TypeInfo(expr ts.Expr) (runtime.Type, error) {
+	if expr.Lit != nil {
+		return litTypeInfo(*expr.Lit, expr)
+	}
+
+	if expr.Inst == nil || (expr.Inst.Ref.Pkg != "" && expr.Inst.Ref.Pkg != "builtin") {
+		return runtime.Type{}, fmt.Errorf("type %v has no runtime representation", expr)
+	}
+
+	switch expr.Inst.Ref.Name {
+	case "any":
+		return runtime.Type{Kind: runtime.AnyType}, nil
+	case "bool":
+		return runtime.Type{Kind: runtime.BoolType}, nil
+	case "int":
+		return runtime.Type{Kind: runtime.IntType}, nil
+	case "float":
+		return runtime.Type{Kind: runtime.FloatType}, nil
+	case "string":
+		return runtime.Type{Kind: runtime.StringType}, nil
+	case "list", "dict":
+		if len(expr.Inst.Args) != 1 {
+			return runtime.Type{}, fmt.Errorf("type %v must have one type argument", expr)
+		}
+		elem, err := TypeInfo(expr.Inst.Args[0])
+		if err != nil {
+			return runtime.Type{}, err
+		}
+		kind := runtime.ListType
+		if expr.Inst.Ref.Name == "dict" {
+			kind = runtime.DictType
+		}
+		return runtime.Type{Kind: kind, Elem: &elem}, nil
+	}
+
+	return runtime.Type{}, fmt.Errorf("type %v has no runtime representation", expr)
+}
+
+func litTypeInfo(lit ts.LitExpr, expr ts.Expr) (runtime.Type, error) {
+	switch lit.Type() {
+	case ts.StructLitType:
+		fields := make(map[string]runtime.Type, len(lit.Struct))
+		for name, fieldExpr := range lit.Struct {
+			field, err := TypeInfo(fieldExpr)
+			if err != nil {
+				return runtime.Type{}, fmt.Errorf("field %v: %w", name, err)
+			}
+			fields[name] = field
+		}
+		return runtime.Type{Kind: runtime.StructType, Fields: fields}, nil
+	case ts.EnumLitType:
+		return runtime.Type{Kind: runtime.EnumType, Members: lit.Enum}, nil
+	case ts.TaggedUnionLitType:
+		tags := make([]runtime.TypeTag, 0, len(lit.Tagged))
+		for _, tag := range lit.Tagged {
+			typeTag := runtime.TypeTag{Name: tag.Name}
+			if tag.Type != nil {
+				payload, err := TypeInfo(*tag.Type)
+				if err != nil {
+					return runtime.Type{}, fmt.Errorf("tag %v: %w", tag.Name, err)
+				}
+				typeTag.Type = &payload
+			}
+			tags = append(tags, typeTag)
+		}
+		return runtime.Type{Kind: runtime.UnionType, Tags: tags}, nil
+	}
+
+	return runtime.Type{}, fmt.Errorf("type %v has no runtime representation", expr)
+}
@@ -0,0 +1,35 @@
+package compiler
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/nevalang/neva/internal/compiler/sourcecode/core"
+)
+
+// Warning is a diagnostic that, unlike Error, doesn't prevent compilation.
+type Warning struct {
+	Message string
+	Meta    *core.Meta
+}
+
+func (w Warning) String() string {
+	if w.Meta == nil {
+		return "warning: " + w.Message
+	}
+	return fmt.Sprintf("%v:%v: warning: %v", w.Meta.Location, w.Meta.Start, w.Message)
+}
+
+// sortWarnings sorts warnings by file and position so output doesn't depend on map iteration order.
+func sortWarnings(warnings []Warning) []Warning {
+	slices.SortStableFunc(warnings, func(a, b Warning) int {
+		return cmp.Or(
+			cmp.Compare(a.Meta.Location.String(), b.Meta.Location.String()),
+			cmp.Compare(a.Meta.Start.Line, b.Meta.Start.Line),
+			cmp.Compare(a.Meta.Start.Column, b.Meta.Start.Column),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+	return warnings
+}
@@ -1,7 +0,0 @@
-package internal
-
-import "embed"
-
-//nolint:golint
-//go:embed runtime
-var Efs embed.FS
@@ -0,0 +1,9 @@
+package pkg
+
+import "embed"
+
+// Efs contains runtime source code that is copied into every generated Go program.
+//
+//nolint:golint
+//go:embed runtime
+var Efs embed.FS
@@ -0,0 +1,192 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// deadlockCheckInterval is how often deadlock detector checks function calls.
+// Deadlock is reported after two checks in a row found all of them blocked without progress.
+const deadlockCheckInterval = 500 * time.Millisecond
+
+// DeadlockError is returned by Run when every function call is blocked on its ports
+// and no message was sent or received for a while, so the program can't make progress.
+type DeadlockError struct {
+	Blocked []BlockedFunc
+}
+
+// BlockedFunc describes function call at the moment deadlock was detected.
+type BlockedFunc struct {
+	Ref      string       // Reference to the function in registry.
+	Port     PortSlotAddr // Port the function waits on, empty if function is finished.
+	LastMsg  Msg          // Last message sent or received by the function, nil if there was none.
+	Finished bool         // Function returned and won't send or receive anymore.
+}
+
+func (e *DeadlockError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("deadlock: all functions are blocked")
+	for _, f := range e.Blocked {
+		if f.Finished {
+			fmt.Fprintf(&sb, "\n\t%v: finished", f.Ref)
+		} else {
+			fmt.Fprintf(&sb, "\n\t%v: waits on %v", f.Ref, DebugInterceptor{}.formatPortSlotAddr(f.Port))
+		}
+		if f.LastMsg != nil {
+			fmt.Fprintf(&sb, ", last message: %v", DebugInterceptor{}.formatMsg(f.LastMsg))
+		}
+	}
+	return sb.String()
+}
+
+// funcState is what deadlock detector knows about a running function call.
+// Ports find it in the context passed to the function.
+type funcState struct {
+	ref      string
+	progress *atomic.Uint64 // shared by all function calls of the program
+
+	mu       sync.Mutex
+	waiting  int          // number of ports blocked at the moment
+	port     PortSlotAddr // port that started waiting most recently
+	lastMsg  Msg
+	finished bool
+}
+
+type funcStateKey struct{}
+
+func funcStateFromContext(ctx context.Context) *funcState {
+	state, _ := ctx.Value(funcStateKey{}).(*funcState)
+	return state
+}
+
+// wait is called before port blocks. It's safe to call on nil state.
+func (s *funcState) wait(addr PortSlotAddr) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.waiting++
+	s.port = addr
+	s.mu.Unlock()
+}
+
+// done is called when port stops blocking, whether the message was transferred or not.
+// It's safe to call on nil state.
+func (s *funcState) done() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.waiting--
+	s.mu.Unlock()
+}
+
+// transferred is called after port sent or received the message. It's safe to call on nil state.
+func (s *funcState) transferred(msg Msg) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.lastMsg = msg
+	s.mu.Unlock()
+	s.progress.Add(1)
+}
+
+func (s *funcState) finish() {
+	s.mu.Lock()
+	s.finished = true
+	s.mu.Unlock()
+}
+
+// blocked returns description of the function if it can't make progress by itself.
+func (s *funcState) blocked() (BlockedFunc, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.waiting == 0 && !s.finished {
+		return BlockedFunc{}, false
+	}
+	return BlockedFunc{
+		Ref:      s.ref,
+		Port:     s.port,
+		LastMsg:  s.lastMsg,
+		Finished: s.finished,
+	}, true
+}
+
+func newFuncStates(funcCalls []FuncCall) []*funcState {
+	progress := &atomic.Uint64{}
+	states := make([]*funcState, len(funcCalls))
+	for i, call := range funcCalls {
+		states[i] = &funcState{ref: call.Ref, progress: progress}
+	}
+	return states
+}
+
+// watchDeadlock blocks until context is done, drain is closed or deadlock is detected.
+// Functions that sleep or do I/O are not blocked on ports, so they prevent false positives.
+// Drained program is expected to become idle, so it's not watched anymore.
+func watchDeadlock(
+	ctx context.Context,
+	states []*funcState,
+	interval time.Duration,
+	drain <-chan struct{},
+) *DeadlockError {
+	if len(states) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		suspected    bool
+		lastProgress uint64
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-drain:
+			return nil
+		case <-ticker.C:
+		}
+
+		progress := states[0].progress.Load()
+
+		blocked, ok := blockedFuncs(states)
+		if !ok {
+			suspected = false
+			continue
+		}
+
+		// functions also finish after program is terminated normally
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if suspected && progress == lastProgress {
+			return &DeadlockError{Blocked: blocked}
+		}
+
+		suspected = true
+		lastProgress = progress
+	}
+}
+
+// blockedFuncs returns descriptions of all functions if none of them can make progress by itself.
+func blockedFuncs(states []*funcState) ([]BlockedFunc, bool) {
+	blocked := make([]BlockedFunc, 0, len(states))
+	for _, state := range states {
+		f, ok := state.blocked()
+		if !ok {
+			return nil, false
+		}
+		blocked = append(blocked, f)
+	}
+	return blocked, true
+}
@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanceledPortOperationIsNotDeadlock(t *testing.T) {
+	states := newFuncStates([]FuncCall{{Ref: "test"}})
+	ctx := context.WithValue(context.Background(), funcStateKey{}, states[0])
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	in := NewSingleInport(make(chan OrderedMsg), PortAddr{Path: "in", Port: "data"}, ProdInterceptor{})
+	_, ok := in.Receive(canceledCtx)
+	require.False(t, ok)
+
+	out := NewSingleOutport(PortAddr{Path: "out", Port: "res"}, ProdInterceptor{}, make(chan OrderedMsg))
+	require.False(t, out.Send(canceledCtx, NewIntMsg(42)))
+
+	_, blocked := states[0].blocked()
+	require.False(t, blocked)
+
+	// function keeps running (e.g. sleeps) after canceled operations, that's not a deadlock
+	watchCtx, stop := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer stop()
+	require.Nil(t, watchDeadlock(watchCtx, states, time.Millisecond, nil))
+}
+
+func TestBlockedPortOperationIsDeadlock(t *testing.T) {
+	states := newFuncStates([]FuncCall{{Ref: "test"}})
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), funcStateKey{}, states[0]))
+	defer cancel()
+
+	in := NewSingleInport(make(chan OrderedMsg), PortAddr{Path: "in", Port: "data"}, ProdInterceptor{})
+	go in.Receive(ctx)
+
+	watchCtx, stop := context.WithTimeout(context.Background(), 5*time.Second)
+	defer stop()
+	err := watchDeadlock(watchCtx, states, time.Millisecond, nil)
+	require.NotNil(t, err)
+	require.Equal(t, "data", err.Blocked[0].Port.Port)
+}
@@ -0,0 +1,111 @@
+package runtime
+
+import (
+	"strings"
+	"sync"
+)
+
+// flowtraceLimit is the maximum number of steps returned by Flowtrace.
+const flowtraceLimit = 16
+
+// FlowtraceInterceptor records the chain of ports every message travelled through.
+// Message sent by a function continues the chain of the last message that function received,
+// so the chain shows how data flowed through the network to the given port.
+type FlowtraceInterceptor struct {
+	mu    sync.Mutex
+	sent  map[uint64]*flowStep   // message index -> chain of message in flight
+	nodes map[string]*flowStep   // node path -> chain of the last message node received
+	ports map[PortAddr]*flowStep // receiver port -> chain of the last message port received
+}
+
+// flowStep is an immutable linked list, so chains can share their tails.
+type flowStep struct {
+	addr  PortSlotAddr
+	prev  *flowStep
+	depth int
+}
+
+func NewFlowtraceInterceptor() *FlowtraceInterceptor {
+	return &FlowtraceInterceptor{
+		sent:  map[uint64]*flowStep{},
+		nodes: map[string]*flowStep{},
+		ports: map[PortAddr]*flowStep{},
+	}
+}
+
+func (f *FlowtraceInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	prev := f.nodes[DebugInterceptor{}.trimPath(sender.Path)]
+	f.sent[msg.index] = newFlowStep(sender, prev)
+
+	return msg.Msg
+}
+
+func (f *FlowtraceInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	prev := f.sent[msg.index]
+	delete(f.sent, msg.index)
+
+	step := newFlowStep(receiver, prev)
+	f.nodes[DebugInterceptor{}.trimPath(receiver.Path)] = step
+	f.ports[receiver.PortAddr] = step
+
+	return msg.Msg
+}
+
+// Flowtrace returns ports that the last message received by the given port travelled through.
+// The most recent port goes first.
+func (f *FlowtraceInterceptor) Flowtrace(port PortAddr) []PortSlotAddr {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	result := make([]PortSlotAddr, 0, flowtraceLimit)
+	for step := f.ports[port]; step != nil && len(result) < flowtraceLimit; step = step.prev {
+		result = append(result, step.addr)
+	}
+
+	return result
+}
+
+// Format returns flowtrace of the given port with one port per line.
+func (f *FlowtraceInterceptor) Format(port PortAddr) string {
+	var b strings.Builder
+	for _, addr := range f.Flowtrace(port) {
+		b.WriteString("\tat ")
+		b.WriteString(DebugInterceptor{}.formatPortSlotAddr(addr))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// newFlowStep prepends addr to the chain. Chain is truncated when it becomes
+// twice as long as needed, so long-running loops don't accumulate memory.
+func newFlowStep(addr PortSlotAddr, prev *flowStep) *flowStep {
+	if prev != nil && prev.depth+1 >= 2*flowtraceLimit {
+		prev = truncateFlow(prev, flowtraceLimit-1)
+	}
+
+	depth := 1
+	if prev != nil {
+		depth = prev.depth + 1
+	}
+
+	return &flowStep{addr: addr, prev: prev, depth: depth}
+}
+
+// truncateFlow returns a copy of the first n steps of the chain.
+func truncateFlow(step *flowStep, n int) *flowStep {
+	if step == nil || n == 0 {
+		return nil
+	}
+	prev := truncateFlow(step.prev, n-1)
+	depth := 1
+	if prev != nil {
+		depth = prev.depth + 1
+	}
+	return &flowStep{addr: step.addr, prev: prev, depth: depth}
+}
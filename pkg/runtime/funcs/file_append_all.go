@@ -0,0 +1,71 @@
+package funcs
+
+import (
+	"context"
+	"os"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type appendAll struct{}
+
+func (c appendAll) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	filenameIn, err := rio.In.Single("filename")
+	if err != nil {
+		return nil, err
+	}
+
+	dataIn, err := rio.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := rio.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			name, ok := filenameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			data, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if err := appendToFile(name.Str(), data.Str()); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
+
+func appendToFile(filename, data string) error {
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.WriteString(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
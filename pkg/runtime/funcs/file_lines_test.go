@@ -0,0 +1,95 @@
+package funcs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestReadLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "trailing newline", content: "a\nb\n", want: []string{"a", "b"}},
+		{name: "no trailing newline", content: "a\nb", want: []string{"a", "b"}},
+		{name: "crlf", content: "a\r\n\r\nb\r\n", want: []string{"a", "", "b"}},
+		{name: "single empty line", content: "\n", want: []string{""}},
+		{name: "empty file", content: "", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "lines.txt")
+			require.NoError(t, os.WriteFile(filename, []byte(tt.content), 0644))
+
+			resCh := make(chan runtime.OrderedMsg, len(tt.want))
+			resOut := runtime.NewSingleOutport(runtime.PortAddr{Path: "out", Port: "res"}, runtime.ProdInterceptor{}, resCh)
+
+			require.NoError(t, readLines(context.Background(), filename, *resOut))
+			close(resCh)
+
+			got := make([]string, 0, len(tt.want))
+			for msg := range resCh {
+				item := msg.Msg.Struct()
+				require.Equal(t, int64(len(got)), item.Get("idx").Int())
+				require.Equal(t, len(got) == len(tt.want)-1, item.Get("last").Bool())
+				got = append(got, item.Get("data").Str())
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteLines(t *testing.T) {
+	filenameCh, dataCh := make(chan runtime.OrderedMsg), make(chan runtime.OrderedMsg)
+	sigCh, errCh := make(chan runtime.OrderedMsg), make(chan runtime.OrderedMsg)
+
+	write, err := writeLines{}.Create(
+		runtime.IO{
+			In:  testInports(map[string]chan runtime.OrderedMsg{"filename": filenameCh, "data": dataCh}),
+			Out: testOutports(map[string]chan runtime.OrderedMsg{"sig": sigCh, "err": errCh}),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go write(ctx)
+
+	sendStream := func(filename string, lines ...string) {
+		filenameCh <- runtime.OrderedMsg{Msg: runtime.NewStringMsg(filename)}
+		for i, line := range lines {
+			dataCh <- runtime.OrderedMsg{Msg: streamItem(runtime.NewStringMsg(line), int64(i), i == len(lines)-1)}
+		}
+	}
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "lines.txt")
+
+	sendStream(filename, "a", "b")
+	<-sigCh
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, "a\nb\n", string(data))
+
+	// error is sent once after the whole stream is consumed
+	sendStream(filepath.Join(dir, "missing", "lines.txt"), "a", "b", "c")
+	require.Contains(t, (<-errCh).Msg.Struct().Get("text").Str(), "no such file or directory")
+
+	// next stream is written to existing file from scratch
+	sendStream(filename, "c")
+	<-sigCh
+
+	data, err = os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, "c\n", string(data))
+}
@@ -5,7 +5,7 @@ import (
 	"io"
 	"os"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type fileReadAll struct{}
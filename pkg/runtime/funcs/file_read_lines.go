@@ -0,0 +1,96 @@
+package funcs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type fileReadLines struct{}
+
+func (c fileReadLines) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	filenameIn, err := rio.In.Single("filename")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			name, ok := filenameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if err := readLines(ctx, name.Str(), resOut); err != nil {
+				if errors.Is(err, context.Canceled) {
+					return
+				}
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// readLines sends lines of the file as stream items without holding the whole file in memory.
+// Next line is read before current one is sent so we know whether the current one is the last.
+// Nothing is sent for empty file.
+func readLines(ctx context.Context, filename string, resOut runtime.SingleOutport) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	cur, curErr := readLine(r)
+
+	for idx := int64(0); ; idx++ {
+		if curErr == io.EOF {
+			return nil
+		}
+		if curErr != nil {
+			return curErr
+		}
+
+		next, nextErr := readLine(r)
+
+		if !resOut.Send(ctx, streamItem(runtime.NewStringMsg(cur), idx, nextErr == io.EOF)) {
+			return context.Canceled
+		}
+
+		cur, curErr = next, nextErr
+	}
+}
+
+// readLine returns next line without line terminator ("\n" or "\r\n").
+// The last line of the file doesn't have to end with a line terminator.
+// It returns io.EOF only if there's no more lines.
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), nil
+}
@@ -0,0 +1,111 @@
+package funcs
+
+import (
+	"bufio"
+	"context"
+	"os"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type writeLines struct{}
+
+func (c writeLines) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	filenameIn, err := rio.In.Single("filename")
+	if err != nil {
+		return nil, err
+	}
+
+	dataIn, err := rio.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := rio.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			name, ok := filenameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			w := newLinesWriter(name.Str())
+
+			for {
+				item, ok := dataIn.Receive(ctx)
+				if !ok {
+					w.close()
+					return
+				}
+
+				w.writeLine(item.Struct().Get("data").Str())
+
+				if item.Struct().Get("last").Bool() {
+					break
+				}
+			}
+
+			if err := w.close(); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
+
+// linesWriter writes lines to a file through a buffer.
+// After first error it stops writing but keeps accepting lines,
+// so the rest of the stream is consumed and error is reported once.
+type linesWriter struct {
+	f   *os.File
+	w   *bufio.Writer
+	err error
+}
+
+func newLinesWriter(filename string) *linesWriter {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return &linesWriter{err: err}
+	}
+	return &linesWriter{f: f, w: bufio.NewWriter(f)}
+}
+
+func (l *linesWriter) writeLine(s string) {
+	if l.err != nil {
+		return
+	}
+	if _, err := l.w.WriteString(s); err != nil {
+		l.err = err
+		return
+	}
+	l.err = l.w.WriteByte('\n')
+}
+
+// close flushes buffered data and closes the file, it returns the first error that happened.
+func (l *linesWriter) close() error {
+	if l.f == nil {
+		return l.err
+	}
+	if l.err == nil {
+		l.err = l.w.Flush()
+	}
+	if err := l.f.Close(); l.err == nil {
+		l.err = err
+	}
+	return l.err
+}
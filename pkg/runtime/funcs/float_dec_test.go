@@ -0,0 +1,14 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestFloatDec(t *testing.T) {
+	require.Equal(t, 0.5, runDataToRes(t, floatDec{}, runtime.NewFloatMsg(1.5)).Float())
+	require.Equal(t, -1.0, runDataToRes(t, floatDec{}, runtime.NewFloatMsg(0)).Float())
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"sync"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type floatDiv struct{}
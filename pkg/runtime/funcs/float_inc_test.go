@@ -0,0 +1,14 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestFloatInc(t *testing.T) {
+	require.Equal(t, 2.5, runDataToRes(t, floatInc{}, runtime.NewFloatMsg(1.5)).Float())
+	require.Equal(t, 0.0, runDataToRes(t, floatInc{}, runtime.NewFloatMsg(-1)).Float())
+}
@@ -3,7 +3,7 @@ package funcs
 import (
 	"context"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type floatIsGreater struct{}
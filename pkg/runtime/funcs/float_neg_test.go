@@ -0,0 +1,14 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestFloatNeg(t *testing.T) {
+	require.Equal(t, -1.5, runDataToRes(t, floatNeg{}, runtime.NewFloatMsg(1.5)).Float())
+	require.Equal(t, 1.5, runDataToRes(t, floatNeg{}, runtime.NewFloatMsg(-1.5)).Float())
+}
@@ -0,0 +1,58 @@
+package funcs
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type parseFloat struct{}
+
+func (p parseFloat) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	dataIn, err := io.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := io.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := io.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			str, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			parsedNum, err := parseFloatMsg(str.Str())
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, parsedNum) {
+				return
+			}
+		}
+	}, nil
+}
+
+func parseFloatMsg(str string) (runtime.Msg, error) {
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, errors.New(strings.TrimPrefix(err.Error(), "strconv.ParseFloat: "))
+	}
+	return runtime.NewFloatMsg(v), nil
+}
@@ -0,0 +1,33 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFloatMsg(t *testing.T) {
+	tests := []struct {
+		str     string
+		want    float64
+		wantErr string
+	}{
+		{str: "3.14", want: 3.14},
+		{str: "-2", want: -2},
+		{str: "1e3", want: 1000},
+		{str: "abc", wantErr: `parsing "abc": invalid syntax`},
+		{str: "", wantErr: `parsing "": invalid syntax`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			got, err := parseFloatMsg(tt.str)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Float())
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package funcs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func testInports(chans map[string]chan runtime.OrderedMsg) runtime.Inports {
+	result := make(map[string]runtime.Inport, len(chans))
+	for name, ch := range chans {
+		result[name] = runtime.NewInport(
+			nil,
+			runtime.NewSingleInport(ch, runtime.PortAddr{Path: "in", Port: name}, runtime.ProdInterceptor{}),
+		)
+	}
+	return runtime.NewInports(result)
+}
+
+func testOutports(chans map[string]chan runtime.OrderedMsg) runtime.Outports {
+	result := make(map[string]runtime.Outport, len(chans))
+	for name, ch := range chans {
+		result[name] = runtime.NewOutport(
+			runtime.NewSingleOutport(runtime.PortAddr{Path: "out", Port: name}, runtime.ProdInterceptor{}, ch),
+			nil,
+		)
+	}
+	return runtime.NewOutports(result)
+}
+
+// runDataToRes sends data to the "data" inport of the created function and returns what it sends to "res".
+func runDataToRes(t *testing.T, creator runtime.FuncCreator, data runtime.Msg) runtime.Msg {
+	t.Helper()
+
+	dataCh, resCh := make(chan runtime.OrderedMsg), make(chan runtime.OrderedMsg)
+
+	handler, err := creator.Create(
+		runtime.IO{
+			In:  testInports(map[string]chan runtime.OrderedMsg{"data": dataCh}),
+			Out: testOutports(map[string]chan runtime.OrderedMsg{"res": resCh}),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go handler(ctx)
+
+	dataCh <- runtime.OrderedMsg{Msg: data}
+
+	select {
+	case res := <-resCh:
+		return res.Msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for res")
+		return nil
+	}
+}
@@ -0,0 +1,171 @@
+package funcs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type httpGet struct{}
+
+func (httpGet) Create(funcIO runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	urlIn, err := funcIO.In.Single("url")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := funcIO.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			urlMsg, ok := urlIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlMsg.Str(), nil)
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			resp, err := doHTTPRequest(http.DefaultClient, req)
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, resp) {
+				return
+			}
+		}
+	}, nil
+}
+
+type httpDo struct{}
+
+func (httpDo) Create(funcIO runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	reqIn, err := funcIO.In.Single("req")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := funcIO.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			reqMsg, ok := reqIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			resp, err := httpDoRequest(ctx, http.DefaultClient, reqMsg.Struct())
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, resp) {
+				return
+			}
+		}
+	}, nil
+}
+
+// httpDoRequest sends request described by `http.Request` message and returns `http.Response` message.
+// Request is cancelled when context is done or when timeout (in nanoseconds) is over, zero timeout means no timeout.
+func httpDoRequest(ctx context.Context, client *http.Client, reqMsg runtime.StructMsg) (runtime.StructMsg, error) {
+	if timeout := reqMsg.Get("timeout").Int(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout))
+		defer cancel()
+	}
+
+	var body io.Reader
+	if s := reqMsg.Get("body").Str(); s != "" {
+		body = strings.NewReader(s)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		reqMsg.Get("method").Str(),
+		reqMsg.Get("url").Str(),
+		body,
+	)
+	if err != nil {
+		return runtime.StructMsg{}, err
+	}
+
+	for name, values := range reqMsg.Get("headers").Dict() {
+		for _, value := range values.List() {
+			req.Header.Add(name, value.Str())
+		}
+	}
+
+	return doHTTPRequest(client, req)
+}
+
+// doHTTPRequest sends request and reads the whole response body.
+func doHTTPRequest(client *http.Client, req *http.Request) (runtime.StructMsg, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return runtime.StructMsg{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return runtime.StructMsg{}, err
+	}
+
+	return respMsg(resp.StatusCode, resp.Header, body), nil
+}
+
+func respMsg(statusCode int, header http.Header, body []byte) runtime.StructMsg {
+	return runtime.NewStructMsg(
+		[]string{"body", "headers", "statusCode"},
+		[]runtime.Msg{
+			runtime.NewStringMsg(string(body)),
+			headersMsg(header),
+			runtime.NewIntMsg(int64(statusCode)),
+		},
+	)
+}
+
+func headersMsg(header http.Header) runtime.DictMsg {
+	dict := make(map[string]runtime.Msg, len(header))
+	for name, values := range header {
+		list := make([]runtime.Msg, 0, len(values))
+		for _, value := range values {
+			list = append(list, runtime.NewStringMsg(value))
+		}
+		dict[name] = runtime.NewListMsg(list)
+	}
+	return runtime.NewDictMsg(dict)
+}
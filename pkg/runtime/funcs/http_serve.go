@@ -0,0 +1,248 @@
+package funcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+// httpShutdownTimeout is how long server waits for in-flight requests to finish before it's closed.
+const httpShutdownTimeout = 5 * time.Second
+
+// httpRequests correlates requests emitted by http_serve and responses received by http_respond.
+// It is shared by functions of the same program, so concurrently running programs don't see each other's requests.
+type httpRequests struct {
+	lastID  atomic.Int64
+	pending sync.Map // request id to channel that handler waits response from
+}
+
+type httpServe struct {
+	requests *httpRequests
+}
+
+func (h httpServe) Create(funcIO runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	addrIn, err := funcIO.In.Single("addr")
+	if err != nil {
+		return nil, err
+	}
+
+	sigIn, err := funcIO.In.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	listeningOut, err := funcIO.Out.Single("listening")
+	if err != nil {
+		return nil, err
+	}
+
+	reqOut, err := funcIO.Out.Single("req")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			addrMsg, ok := addrIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if _, ok := sigIn.Receive(ctx); !ok {
+				return
+			}
+
+			if err := serveHTTP(ctx, addrMsg.Str(), h.requests, listeningOut, reqOut); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// serveHTTP blocks until context is done or program is draining, then shuts the server down gracefully.
+// Address server listens on is sent once it accepts connections, so port 0 can be used to pick a free one.
+// Every request is sent as a message with unique id and handler waits until response with the same id
+// is received by http_respond, so requests are handled concurrently.
+func serveHTTP(
+	ctx context.Context,
+	addr string,
+	requests *httpRequests,
+	listeningOut runtime.SingleOutport,
+	reqOut runtime.SingleOutport,
+) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handleHTTPRequest(ctx, w, r, requests, reqOut)
+		}),
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	// if context is done, server is shut down below
+	listeningOut.Send(ctx, runtime.NewStringMsg(listener.Addr().String()))
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	case <-runtime.Draining(ctx):
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func handleHTTPRequest(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	requests *httpRequests,
+	reqOut runtime.SingleOutport,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := requests.lastID.Add(1)
+	resCh := make(chan runtime.StructMsg, 1)
+	requests.pending.Store(id, resCh)
+	defer requests.pending.Delete(id)
+
+	// request is not sent if client is gone before program is ready to receive it
+	sendCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(r.Context(), cancel)
+	defer stop()
+
+	if !reqOut.Send(sendCtx, serverRequestMsg(id, r, body)) {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+
+	select {
+	case <-r.Context().Done():
+	case <-ctx.Done():
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+	case res := <-resCh:
+		writeHTTPResponse(w, res)
+	}
+}
+
+func serverRequestMsg(id int64, r *http.Request, body []byte) runtime.StructMsg {
+	return runtime.NewStructMsg(
+		[]string{"body", "headers", "id", "method", "url"},
+		[]runtime.Msg{
+			runtime.NewStringMsg(string(body)),
+			headersMsg(r.Header),
+			runtime.NewIntMsg(id),
+			runtime.NewStringMsg(r.Method),
+			runtime.NewStringMsg(r.URL.String()),
+		},
+	)
+}
+
+func writeHTTPResponse(w http.ResponseWriter, res runtime.StructMsg) {
+	for name, values := range res.Get("headers").Dict() {
+		for _, value := range values.List() {
+			w.Header().Add(name, value.Str())
+		}
+	}
+
+	statusCode := int(res.Get("statusCode").Int())
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	w.WriteHeader(statusCode)
+
+	_, _ = io.WriteString(w, res.Get("body").Str())
+}
+
+type httpRespond struct {
+	requests *httpRequests
+}
+
+func (h httpRespond) Create(funcIO runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	idIn, err := funcIO.In.Single("id")
+	if err != nil {
+		return nil, err
+	}
+
+	resIn, err := funcIO.In.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := funcIO.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			idMsg, ok := idIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			resMsg, ok := resIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			resCh, ok := h.requests.pending.LoadAndDelete(idMsg.Int())
+			if !ok {
+				err := fmt.Errorf("request %v is already responded or cancelled", idMsg.Int())
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			resCh.(chan runtime.StructMsg) <- resMsg.Struct()
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
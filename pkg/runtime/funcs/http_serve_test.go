@@ -0,0 +1,203 @@
+package funcs
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestHTTPServeAndRespond(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := startHTTPServe(t, ctx, &httpRequests{})
+
+	// two concurrent requests are responded in reverse order
+	type result struct {
+		status int
+		body   string
+	}
+	results := make(chan result, 2)
+	for _, name := range []string{"first", "second"} {
+		go func() {
+			resp, err := http.Post("http://"+srv.addr+"/"+name, "text/plain", strings.NewReader(name))
+			if err != nil {
+				results <- result{body: err.Error()}
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			results <- result{status: resp.StatusCode, body: string(body)}
+		}()
+	}
+
+	reqs := make([]runtime.StructMsg, 0, 2)
+	for range 2 {
+		reqs = append(reqs, (<-srv.req).Msg.Struct())
+	}
+	require.NotEqual(t, reqs[0].Get("id").Int(), reqs[1].Get("id").Int())
+
+	for i := len(reqs) - 1; i >= 0; i-- {
+		req := reqs[i]
+		require.Equal(t, "POST", req.Get("method").Str())
+		require.Equal(t, "/"+req.Get("body").Str(), req.Get("url").Str())
+
+		srv.id <- runtime.OrderedMsg{Msg: req.Get("id")}
+		srv.res <- runtime.OrderedMsg{Msg: runtime.NewStructMsg(
+			[]string{"body", "headers", "statusCode"},
+			[]runtime.Msg{
+				runtime.NewStringMsg("hello, " + req.Get("body").Str()),
+				runtime.NewDictMsg(nil),
+				runtime.NewIntMsg(http.StatusAccepted),
+			},
+		)}
+		<-srv.respondSig
+	}
+
+	got := make([]string, 0, 2)
+	for range 2 {
+		r := <-results
+		require.Equal(t, http.StatusAccepted, r.status, r.body)
+		got = append(got, r.body)
+	}
+	require.ElementsMatch(t, []string{"hello, first", "hello, second"}, got)
+
+	// responding twice to the same request is an error
+	srv.id <- runtime.OrderedMsg{Msg: reqs[0].Get("id")}
+	srv.res <- runtime.OrderedMsg{Msg: runtime.NewStructMsg(nil, nil)}
+	require.Contains(t, (<-srv.respondErr).Msg.Struct().Get("text").Str(), "already responded")
+
+	// program termination shuts the server down, in-flight requests are not left hanging
+	go func() {
+		resp, err := http.Post("http://"+srv.addr+"/pending", "text/plain", strings.NewReader("pending"))
+		if err != nil {
+			results <- result{body: err.Error()}
+			return
+		}
+		defer resp.Body.Close()
+		results <- result{status: resp.StatusCode}
+	}()
+	<-srv.req
+	cancel()
+
+	require.Equal(t, http.StatusServiceUnavailable, (<-results).status)
+	<-srv.done
+
+	_, err := net.Dial("tcp", srv.addr)
+	require.Error(t, err)
+}
+
+func TestHTTPServeAndRespond_ConcurrentPrograms(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// every program has its own requests, like registries of two programs do
+	servers := []httpTestServer{
+		startHTTPServe(t, ctx, &httpRequests{}),
+		startHTTPServe(t, ctx, &httpRequests{}),
+	}
+
+	bodies := make([]chan string, len(servers))
+	for i, srv := range servers {
+		bodies[i] = make(chan string, 1)
+		go func() {
+			resp, err := http.Post("http://"+srv.addr, "text/plain", strings.NewReader(""))
+			if err != nil {
+				bodies[i] <- err.Error()
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			bodies[i] <- string(body)
+		}()
+	}
+
+	ids := make([]runtime.Msg, 0, len(servers))
+	for _, srv := range servers {
+		ids = append(ids, (<-srv.req).Msg.Struct().Get("id"))
+	}
+	// ids are the same, so response must be delivered to the request of its own program
+	require.Equal(t, ids[0], ids[1])
+
+	for i := len(servers) - 1; i >= 0; i-- {
+		srv := servers[i]
+		srv.id <- runtime.OrderedMsg{Msg: ids[i]}
+		srv.res <- runtime.OrderedMsg{Msg: runtime.NewStructMsg(
+			[]string{"body", "headers", "statusCode"},
+			[]runtime.Msg{
+				runtime.NewStringMsg(srv.addr),
+				runtime.NewDictMsg(nil),
+				runtime.NewIntMsg(http.StatusOK),
+			},
+		)}
+		<-srv.respondSig
+		require.Equal(t, srv.addr, <-bodies[i])
+	}
+}
+
+// httpTestServer is a set of channels connected to http_serve and http_respond of the same program.
+type httpTestServer struct {
+	addr                   string // address server listens on
+	req, id, res           chan runtime.OrderedMsg
+	respondSig, respondErr chan runtime.OrderedMsg
+	done                   chan struct{} // closed when http_serve is finished
+}
+
+// startHTTPServe runs http_serve and http_respond sharing given requests
+// and waits until server is listening on a free port.
+func startHTTPServe(t *testing.T, ctx context.Context, requests *httpRequests) httpTestServer {
+	t.Helper()
+
+	srv := httpTestServer{
+		req:        make(chan runtime.OrderedMsg),
+		id:         make(chan runtime.OrderedMsg),
+		res:        make(chan runtime.OrderedMsg),
+		respondSig: make(chan runtime.OrderedMsg),
+		respondErr: make(chan runtime.OrderedMsg),
+		done:       make(chan struct{}),
+	}
+
+	addrCh, sigCh := make(chan runtime.OrderedMsg), make(chan runtime.OrderedMsg)
+	listening := make(chan runtime.OrderedMsg)
+
+	serve, err := httpServe{requests: requests}.Create(
+		runtime.IO{
+			In: testInports(map[string]chan runtime.OrderedMsg{"addr": addrCh, "sig": sigCh}),
+			Out: testOutports(map[string]chan runtime.OrderedMsg{
+				"listening": listening,
+				"req":       srv.req,
+				"err":       make(chan runtime.OrderedMsg),
+			}),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	respond, err := httpRespond{requests: requests}.Create(
+		runtime.IO{
+			In:  testInports(map[string]chan runtime.OrderedMsg{"id": srv.id, "res": srv.res}),
+			Out: testOutports(map[string]chan runtime.OrderedMsg{"sig": srv.respondSig, "err": srv.respondErr}),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	go func() {
+		serve(ctx)
+		close(srv.done)
+	}()
+	go respond(ctx)
+
+	addrCh <- runtime.OrderedMsg{Msg: runtime.NewStringMsg("127.0.0.1:0")}
+	sigCh <- runtime.OrderedMsg{Msg: emptyStruct()}
+	srv.addr = (<-listening).Msg.Str()
+
+	return srv
+}
@@ -0,0 +1,97 @@
+package funcs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestHTTPDoRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Add("X-Method", r.Method)
+		w.Header().Add("X-Tag", r.Header.Values("X-Tag")[0])
+		w.Header().Add("X-Tag", r.Header.Values("X-Tag")[1])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("echo: " + string(body)))
+	}))
+	defer server.Close()
+
+	t.Run("method, headers and body", func(t *testing.T) {
+		resp, err := httpDoRequest(
+			context.Background(),
+			server.Client(),
+			requestMsg(http.MethodPost, server.URL, "hello", 0, map[string][]string{
+				"X-Tag": {"a", "b"},
+			}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(http.StatusCreated), resp.Get("statusCode").Int())
+		require.Equal(t, "echo: hello", resp.Get("body").Str())
+
+		headers := resp.Get("headers").Dict()
+		require.Equal(t, "POST", headers["X-Method"].List()[0].Str())
+		require.Len(t, headers["X-Tag"].List(), 2)
+		require.Equal(t, "b", headers["X-Tag"].List()[1].Str())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := httpDoRequest(
+			context.Background(),
+			server.Client(),
+			requestMsg(http.MethodGet, server.URL+"/slow", "", int64(10*time.Millisecond), nil),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		_, err := httpDoRequest(
+			ctx,
+			server.Client(),
+			requestMsg(http.MethodGet, server.URL+"/slow", "", 0, nil),
+		)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func requestMsg(method, url, body string, timeout int64, headers map[string][]string) runtime.StructMsg {
+	headersDict := make(map[string]runtime.Msg, len(headers))
+	for name, values := range headers {
+		list := make([]runtime.Msg, 0, len(values))
+		for _, v := range values {
+			list = append(list, runtime.NewStringMsg(v))
+		}
+		headersDict[name] = runtime.NewListMsg(list)
+	}
+
+	return runtime.NewStructMsg(
+		[]string{"body", "headers", "method", "timeout", "url"},
+		[]runtime.Msg{
+			runtime.NewStringMsg(body),
+			runtime.NewDictMsg(headersDict),
+			runtime.NewStringMsg(method),
+			runtime.NewIntMsg(timeout),
+			runtime.NewStringMsg(url),
+		},
+	)
+}
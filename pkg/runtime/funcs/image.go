@@ -4,7 +4,7 @@ import (
 	"image"
 	"image/color"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 // TODO can't we use uint8 here?
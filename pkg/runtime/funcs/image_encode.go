@@ -5,7 +5,7 @@ import (
 	"image/png"
 	"strings"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type imageEncode struct{}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"image"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type imageNew struct{}
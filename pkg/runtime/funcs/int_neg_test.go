@@ -0,0 +1,15 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestIntNeg(t *testing.T) {
+	require.Equal(t, int64(-5), runDataToRes(t, intNeg{}, runtime.NewIntMsg(5)).Int())
+	require.Equal(t, int64(5), runDataToRes(t, intNeg{}, runtime.NewIntMsg(-5)).Int())
+	require.Equal(t, int64(0), runDataToRes(t, intNeg{}, runtime.NewIntMsg(0)).Int())
+}
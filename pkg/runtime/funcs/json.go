@@ -0,0 +1,430 @@
+package funcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type jsonMarshal struct{}
+
+func (jsonMarshal) Create(funcIO runtime.IO, cfg runtime.Msg) (func(ctx context.Context), error) {
+	typ, err := runtime.ParseType(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dataIn, err := funcIO.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := funcIO.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			dataMsg, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			var buf bytes.Buffer
+			if err := encodeJSON(&buf, dataMsg, typ); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, runtime.NewStringMsg(buf.String())) {
+				return
+			}
+		}
+	}, nil
+}
+
+// encodeJSON writes compact JSON representation of the message.
+// Struct fields and dict keys are sorted, enums are encoded as member names
+// and unions as objects with tag name and optional value.
+func encodeJSON(buf *bytes.Buffer, msg runtime.Msg, typ runtime.Type) error {
+	switch typ.Kind {
+	case runtime.BoolType, runtime.IntType, runtime.StringType:
+		return writeJSON(buf, msg)
+	case runtime.FloatType:
+		return writeJSON(buf, msg.Float())
+	case runtime.EnumType:
+		return writeJSON(buf, msg.Str())
+	case runtime.ListType:
+		buf.WriteByte('[')
+		for i, el := range msg.List() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeJSON(buf, el, *typ.Elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case runtime.DictType:
+		dict := msg.Dict()
+		elems := make(map[string]runtime.Type, len(dict))
+		for key := range dict {
+			elems[key] = *typ.Elem
+		}
+		return encodeJSONObject(buf, dict, elems)
+	case runtime.StructType:
+		fields := make(map[string]runtime.Msg, len(typ.Fields))
+		for name := range typ.Fields {
+			fields[name] = msg.Struct().Get(name)
+		}
+		return encodeJSONObject(buf, fields, typ.Fields)
+	case runtime.UnionType:
+		union := msg.Union()
+		if int(union.Tag()) >= len(typ.Tags) {
+			return fmt.Errorf("union tag out of range: %v", union.Tag())
+		}
+		tag := typ.Tags[union.Tag()]
+		buf.WriteString(`{"tag":`)
+		if err := writeJSON(buf, tag.Name); err != nil {
+			return err
+		}
+		if tag.Type != nil {
+			buf.WriteString(`,"value":`)
+			if err := encodeJSON(buf, union.Value(), *tag.Type); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+
+	// any, type is only known at runtime
+	switch msg := msg.(type) {
+	case runtime.FloatMsg:
+		return writeJSON(buf, msg.Float())
+	case runtime.ListMsg:
+		return encodeJSON(buf, msg, runtime.Type{Kind: runtime.ListType, Elem: &typ})
+	case runtime.DictMsg:
+		return encodeJSON(buf, msg, runtime.Type{Kind: runtime.DictType, Elem: &typ})
+	case runtime.StructMsg:
+		fields := make(map[string]runtime.Type, len(msg.Names()))
+		for _, name := range msg.Names() {
+			fields[name] = typ
+		}
+		return encodeJSON(buf, msg, runtime.Type{Kind: runtime.StructType, Fields: fields})
+	}
+	return writeJSON(buf, msg)
+}
+
+func encodeJSONObject(buf *bytes.Buffer, values map[string]runtime.Msg, types map[string]runtime.Type) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSON(buf, key); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := encodeJSON(buf, values[key], types[key]); err != nil {
+			return fmt.Errorf("%v: %w", key, err)
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeJSON(buf *bytes.Buffer, v any) error {
+	bb, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(bb)
+	return nil
+}
+
+type jsonUnmarshal struct{}
+
+func (jsonUnmarshal) Create(funcIO runtime.IO, cfg runtime.Msg) (func(ctx context.Context), error) {
+	typ, err := runtime.ParseType(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dataIn, err := funcIO.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := funcIO.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := funcIO.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			dataMsg, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			res, err := decodeJSON(dataMsg.Str(), typ)
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, res) {
+				return
+			}
+		}
+	}, nil
+}
+
+// decodeJSON parses JSON document into message of the given type.
+func decodeJSON(data string, typ runtime.Type) (runtime.Msg, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return jsonValueToMsg(v, typ)
+}
+
+func jsonValueToMsg(v any, typ runtime.Type) (runtime.Msg, error) {
+	switch typ.Kind {
+	case runtime.AnyType:
+		return jsonValueToAnyMsg(v)
+	case runtime.BoolType:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		return runtime.NewBoolMsg(b), nil
+	case runtime.IntType:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %v into int", n)
+		}
+		return runtime.NewIntMsg(i), nil
+	case runtime.FloatType:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewFloatMsg(f), nil
+	case runtime.StringType:
+		s, ok := v.(string)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		return runtime.NewStringMsg(s), nil
+	case runtime.EnumType:
+		s, ok := v.(string)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		if !slices.Contains(typ.Members, s) {
+			return nil, fmt.Errorf("unknown enum member %q", s)
+		}
+		return runtime.NewStringMsg(s), nil
+	case runtime.ListType:
+		arr, ok := v.([]any)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		list := make([]runtime.Msg, 0, len(arr))
+		for i, el := range arr {
+			msg, err := jsonValueToMsg(el, *typ.Elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			list = append(list, msg)
+		}
+		return runtime.NewListMsg(list), nil
+	case runtime.DictType:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, jsonTypeError(v, typ)
+		}
+		dict := make(map[string]runtime.Msg, len(obj))
+		for key, el := range obj {
+			msg, err := jsonValueToMsg(el, *typ.Elem)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %w", key, err)
+			}
+			dict[key] = msg
+		}
+		return runtime.NewDictMsg(dict), nil
+	case runtime.StructType:
+		return jsonObjectToStructMsg(v, typ)
+	case runtime.UnionType:
+		return jsonObjectToUnionMsg(v, typ)
+	}
+
+	return nil, fmt.Errorf("unsupported type %v", typ.Kind)
+}
+
+// jsonObjectToStructMsg decodes object into struct, object must have exactly the same fields.
+func jsonObjectToStructMsg(v any, typ runtime.Type) (runtime.Msg, error) {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, jsonTypeError(v, typ)
+	}
+
+	for key := range obj {
+		if _, ok := typ.Fields[key]; !ok {
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+	}
+
+	names := make([]string, 0, len(typ.Fields))
+	for name := range typ.Fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	fields := make([]runtime.Msg, 0, len(names))
+	for _, name := range names {
+		el, ok := obj[name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %q", name)
+		}
+		msg, err := jsonValueToMsg(el, typ.Fields[name])
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", name, err)
+		}
+		fields = append(fields, msg)
+	}
+
+	return runtime.NewStructMsg(names, fields), nil
+}
+
+// jsonObjectToUnionMsg decodes `{"tag": "Name", "value": ...}` object into union.
+func jsonObjectToUnionMsg(v any, typ runtime.Type) (runtime.Msg, error) {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, jsonTypeError(v, typ)
+	}
+
+	tagName, ok := obj["tag"].(string)
+	if !ok {
+		return nil, errors.New("union must have string tag")
+	}
+
+	idx := slices.IndexFunc(typ.Tags, func(tag runtime.TypeTag) bool {
+		return tag.Name == tagName
+	})
+	if idx == -1 {
+		return nil, fmt.Errorf("unknown union tag %q", tagName)
+	}
+
+	tag := typ.Tags[idx]
+	value, hasValue := obj["value"]
+
+	if tag.Type == nil {
+		if hasValue {
+			return nil, fmt.Errorf("union tag %v has no value", tagName)
+		}
+		return runtime.NewUnionMsg(uint8(idx), nil), nil
+	}
+
+	if !hasValue {
+		return nil, fmt.Errorf("union tag %v requires value", tagName)
+	}
+
+	msg, err := jsonValueToMsg(value, *tag.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", tagName, err)
+	}
+
+	return runtime.NewUnionMsg(uint8(idx), msg), nil
+}
+
+// jsonValueToAnyMsg decodes value of unknown type.
+// Objects become dicts and integer numbers become ints, other numbers become floats.
+func jsonValueToAnyMsg(v any) (runtime.Msg, error) {
+	switch v := v.(type) {
+	case bool:
+		return runtime.NewBoolMsg(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return runtime.NewIntMsg(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewFloatMsg(f), nil
+	case string:
+		return runtime.NewStringMsg(v), nil
+	case []any:
+		return jsonValueToMsg(v, runtime.Type{Kind: runtime.ListType, Elem: &runtime.Type{Kind: runtime.AnyType}})
+	case map[string]any:
+		return jsonValueToMsg(v, runtime.Type{Kind: runtime.DictType, Elem: &runtime.Type{Kind: runtime.AnyType}})
+	}
+	return nil, errors.New("null is not supported")
+}
+
+func jsonTypeError(v any, typ runtime.Type) error {
+	var got string
+	switch v.(type) {
+	case nil:
+		got = "null"
+	case bool:
+		got = "bool"
+	case json.Number:
+		got = "number"
+	case string:
+		got = "string"
+	case []any:
+		got = "array"
+	case map[string]any:
+		got = "object"
+	}
+	return fmt.Errorf("cannot decode %v into %v", got, typ.Kind)
+}
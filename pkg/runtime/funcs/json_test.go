@@ -0,0 +1,269 @@
+package funcs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+var (
+	jsonAny    = runtime.Type{Kind: runtime.AnyType}
+	jsonInt    = runtime.Type{Kind: runtime.IntType}
+	jsonFloat  = runtime.Type{Kind: runtime.FloatType}
+	jsonString = runtime.Type{Kind: runtime.StringType}
+	jsonEnum   = runtime.Type{Kind: runtime.EnumType, Members: []string{"Red", "Green"}}
+	jsonStruct = runtime.Type{Kind: runtime.StructType, Fields: map[string]runtime.Type{
+		"name": jsonString,
+		"age":  jsonInt,
+	}}
+	jsonUnion = runtime.Type{Kind: runtime.UnionType, Tags: []runtime.TypeTag{
+		{Name: "None"},
+		{Name: "Int", Type: &jsonInt},
+	}}
+)
+
+func TestDecodeJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		typ     runtime.Type
+		want    runtime.Msg
+		wantErr string
+	}{
+		{
+			name: "int",
+			data: "42",
+			typ:  jsonInt,
+			want: runtime.NewIntMsg(42),
+		},
+		{
+			name: "int into float",
+			data: "42",
+			typ:  jsonFloat,
+			want: runtime.NewFloatMsg(42),
+		},
+		{
+			name:    "float into int",
+			data:    "1.5",
+			typ:     jsonInt,
+			wantErr: "cannot decode 1.5 into int",
+		},
+		{
+			name:    "int overflow",
+			data:    "9223372036854775808",
+			typ:     jsonInt,
+			wantErr: "cannot decode 9223372036854775808 into int",
+		},
+		{
+			name:    "null into int",
+			data:    "null",
+			typ:     jsonInt,
+			wantErr: "cannot decode null into int",
+		},
+		{
+			name:    "null into any",
+			data:    "null",
+			typ:     jsonAny,
+			wantErr: "null is not supported",
+		},
+		{
+			name:    "null struct field",
+			data:    `{"name": null, "age": 1}`,
+			typ:     jsonStruct,
+			wantErr: "name: cannot decode null into string",
+		},
+		{
+			name: "struct",
+			data: `{"name": "John", "age": 32}`,
+			typ:  jsonStruct,
+			want: runtime.NewStructMsg(
+				[]string{"age", "name"},
+				[]runtime.Msg{runtime.NewIntMsg(32), runtime.NewStringMsg("John")},
+			),
+		},
+		{
+			name:    "unknown field",
+			data:    `{"name": "John", "age": 32, "email": ""}`,
+			typ:     jsonStruct,
+			wantErr: `unknown field "email"`,
+		},
+		{
+			name:    "missing field",
+			data:    `{"name": "John"}`,
+			typ:     jsonStruct,
+			wantErr: `missing field "age"`,
+		},
+		{
+			name: "enum",
+			data: `"Green"`,
+			typ:  jsonEnum,
+			want: runtime.NewStringMsg("Green"),
+		},
+		{
+			name:    "unknown enum member",
+			data:    `"Blue"`,
+			typ:     jsonEnum,
+			wantErr: `unknown enum member "Blue"`,
+		},
+		{
+			name: "union with value",
+			data: `{"tag": "Int", "value": 1}`,
+			typ:  jsonUnion,
+			want: runtime.NewUnionMsg(1, runtime.NewIntMsg(1)),
+		},
+		{
+			name: "union without value",
+			data: `{"tag": "None"}`,
+			typ:  jsonUnion,
+			want: runtime.NewUnionMsg(0, nil),
+		},
+		{
+			name:    "unknown union tag",
+			data:    `{"tag": "Float", "value": 1.5}`,
+			typ:     jsonUnion,
+			wantErr: `unknown union tag "Float"`,
+		},
+		{
+			name:    "missing union tag",
+			data:    `{"value": 1}`,
+			typ:     jsonUnion,
+			wantErr: "union must have string tag",
+		},
+		{
+			name:    "union tag is not string",
+			data:    `{"tag": 1}`,
+			typ:     jsonUnion,
+			wantErr: "union must have string tag",
+		},
+		{
+			name:    "missing union value",
+			data:    `{"tag": "Int"}`,
+			typ:     jsonUnion,
+			wantErr: "union tag Int requires value",
+		},
+		{
+			name:    "unexpected union value",
+			data:    `{"tag": "None", "value": 1}`,
+			typ:     jsonUnion,
+			wantErr: "union tag None has no value",
+		},
+		{
+			name:    "trailing data",
+			data:    `1 2`,
+			typ:     jsonInt,
+			wantErr: "unexpected data after top-level value",
+		},
+		{
+			name:    "invalid json",
+			data:    `{"name":`,
+			typ:     jsonStruct,
+			wantErr: "unexpected EOF",
+		},
+		{
+			name: "any",
+			data: `{"list": [1, 2.5, "a", true]}`,
+			typ:  jsonAny,
+			want: runtime.NewDictMsg(map[string]runtime.Msg{
+				"list": runtime.NewListMsg([]runtime.Msg{
+					runtime.NewIntMsg(1),
+					runtime.NewFloatMsg(2.5),
+					runtime.NewStringMsg("a"),
+					runtime.NewBoolMsg(true),
+				}),
+			}),
+		},
+		{
+			name:    "list element error",
+			data:    `[1, "2"]`,
+			typ:     runtime.Type{Kind: runtime.ListType, Elem: &jsonInt},
+			wantErr: "[1]: cannot decode string into int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeJSON(tt.data, tt.typ)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     runtime.Msg
+		typ     runtime.Type
+		want    string
+		wantErr string
+	}{
+		{
+			name: "struct fields are sorted",
+			msg: runtime.NewStructMsg(
+				[]string{"age", "name"},
+				[]runtime.Msg{runtime.NewIntMsg(32), runtime.NewStringMsg("John")},
+			),
+			typ:  jsonStruct,
+			want: `{"age":32,"name":"John"}`,
+		},
+		{
+			name: "whole float",
+			msg:  runtime.NewFloatMsg(2),
+			typ:  jsonFloat,
+			want: `2`,
+		},
+		{
+			name: "enum",
+			msg:  runtime.NewStringMsg("Red"),
+			typ:  jsonEnum,
+			want: `"Red"`,
+		},
+		{
+			name: "union with value",
+			msg:  runtime.NewUnionMsg(1, runtime.NewIntMsg(1)),
+			typ:  jsonUnion,
+			want: `{"tag":"Int","value":1}`,
+		},
+		{
+			name: "union without value",
+			msg:  runtime.NewUnionMsg(0, nil),
+			typ:  jsonUnion,
+			want: `{"tag":"None"}`,
+		},
+		{
+			name:    "union tag out of range",
+			msg:     runtime.NewUnionMsg(2, nil),
+			typ:     jsonUnion,
+			wantErr: "union tag out of range: 2",
+		},
+		{
+			name: "any",
+			msg: runtime.NewDictMsg(map[string]runtime.Msg{
+				"b": runtime.NewListMsg([]runtime.Msg{runtime.NewIntMsg(1), runtime.NewFloatMsg(2.5)}),
+				"a": runtime.NewStringMsg("x"),
+			}),
+			typ:  jsonAny,
+			want: `{"a":"x","b":[1,2.5]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := encodeJSON(&buf, tt.msg, tt.typ)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"slices"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type listPush struct{}
@@ -0,0 +1,152 @@
+package funcs
+
+import "github.com/nevalang/neva/pkg/runtime"
+
+type ports = map[string]runtime.PortKind
+
+const (
+	single = runtime.SinglePort
+	array  = runtime.ArrayPort
+)
+
+// NewManifest returns signatures of all functions from the registry.
+// It must be kept in sync with NewRegistry.
+func NewManifest() map[string]runtime.FuncSignature {
+	return map[string]runtime.FuncSignature{
+		"new":     {Out: ports{"res": single}},
+		"new_v2":  {In: ports{"sig": single}, Out: ports{"res": single}},
+		"del":     {In: ports{"data": single}},
+		"lock":    {In: ports{"sig": single, "data": single}, Out: ports{"data": single}},
+		"unwrap":  {In: ports{"data": single}, Out: ports{"some": single, "none": single}},
+		"fan_out": {In: ports{"data": single}, Out: ports{"data": array}},
+		"fan_in":  {In: ports{"data": array}, Out: ports{"res": single}},
+
+		"panic": {In: ports{"data": single}},
+
+		"switch_router": {In: ports{"data": single, "case": array}, Out: ports{"case": array, "else": single}},
+		"union_switch":  {In: ports{"data": single, "case": array}, Out: ports{"case": array, "else": single}},
+		"union_wrap":    {In: ports{"data": single}, Out: ports{"res": single}},
+		"match":         {In: ports{"data": single, "if": array, "then": array, "else": single}, Out: ports{"res": single}},
+		"select":        {In: ports{"if": array, "then": array}, Out: ports{"res": single}},
+		"ternary":       {In: ports{"if": single, "then": single, "else": single}, Out: ports{"res": single}},
+		"eq":            {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"ne":            {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"cond":          {In: ports{"data": single, "if": single}, Out: ports{"then": single, "else": single}},
+		"not":           {In: ports{"data": single}, Out: ports{"res": single}},
+		"and":           {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"or":            {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"int_is_greater":          {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_is_greater_or_equal": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"int_is_lesser":          {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_is_lesser_or_equal": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"string_is_greater": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"string_is_lesser":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"float_is_greater":          {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_is_greater_or_equal": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"float_is_lesser":          {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_is_lesser_or_equal": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"array_port_to_stream": {In: ports{"port": array}, Out: ports{"data": single}},
+		"list_to_stream":       {In: ports{"data": single}, Out: ports{"res": single}},
+		"stream_int_range":     {In: ports{"from": single, "to": single}, Out: ports{"res": single}},
+		"stream_int_range_v2":  {In: ports{"from": single, "to": single, "step": single, "sig": single}, Out: ports{"res": single}},
+		"stream_float_range":   {In: ports{"from": single, "to": single, "step": single, "sig": single}, Out: ports{"res": single}},
+		"stream_product":       {In: ports{"first": single, "second": single}, Out: ports{"data": single}},
+		"stream_zip":           {In: ports{"first": single, "second": single}, Out: ports{"data": single}},
+
+		"struct_builder": {Out: ports{"res": single}, Autoports: true},
+		"stream_to_list": {In: ports{"data": single}, Out: ports{"res": single}},
+
+		"field": {In: ports{"data": single}, Out: ports{"res": single}},
+
+		"get_dict_value": {In: ports{"dict": single, "key": single}, Out: ports{"res": single, "err": single}},
+
+		"int_add":    {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_sub":    {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_mul":    {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_div":    {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_add":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_sub":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_mul":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_div":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"string_add": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"int_inc":   {In: ports{"data": single}, Out: ports{"res": single}},
+		"int_dec":   {In: ports{"data": single}, Out: ports{"res": single}},
+		"int_neg":   {In: ports{"data": single}, Out: ports{"res": single}},
+		"int_mod":   {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"float_inc": {In: ports{"data": single}, Out: ports{"res": single}},
+		"float_dec": {In: ports{"data": single}, Out: ports{"res": single}},
+		"float_neg": {In: ports{"data": single}, Out: ports{"res": single}},
+
+		"parse_int":   {In: ports{"data": single}, Out: ports{"res": single, "err": single}},
+		"parse_float": {In: ports{"data": single}, Out: ports{"res": single, "err": single}},
+
+		"regexp_submatch": {In: ports{"regexp": single, "data": single}, Out: ports{"res": single, "err": single}},
+
+		"list_at":   {In: ports{"data": single, "idx": single}, Out: ports{"res": single, "err": single}},
+		"list_len":  {In: ports{"data": single}, Out: ports{"res": single}},
+		"list_push": {In: ports{"data": single, "lst": single}, Out: ports{"res": single}},
+		"map_len":   {In: ports{"data": single}, Out: ports{"res": single}},
+		"slice":     {In: ports{"data": single, "from": single, "to": single}, Out: ports{"res": single, "err": single}},
+
+		"time_delay": {In: ports{"dur": single, "data": single}, Out: ports{"res": single}},
+		"time_after": {In: ports{"dur": single}, Out: ports{"sig": single}},
+
+		"string_at":        {In: ports{"data": single, "idx": single}, Out: ports{"res": single, "err": single}},
+		"strings_join":     {In: ports{"data": single}, Out: ports{"res": single}},
+		"strings_split":    {In: ports{"data": single, "delim": single}, Out: ports{"res": single}},
+		"strings_to_upper": {In: ports{"data": single}, Out: ports{"res": single}},
+		"strings_to_lower": {In: ports{"data": single}, Out: ports{"res": single}},
+
+		"scanln":  {In: ports{"sig": single}, Out: ports{"res": single}},
+		"args":    {In: ports{"sig": single}, Out: ports{"data": single}},
+		"println": {In: ports{"data": single}, Out: ports{"res": single}},
+		"printf":  {In: ports{"tpl": single, "args": array}, Out: ports{"sig": single, "err": single}},
+		"print":   {In: ports{"data": single}, Out: ports{"res": single}},
+
+		"read_all":    {In: ports{"filename": single}, Out: ports{"res": single, "err": single}},
+		"read_lines":  {In: ports{"filename": single}, Out: ports{"res": single, "err": single}},
+		"write_all":   {In: ports{"filename": single, "data": single}, Out: ports{"sig": single, "err": single}},
+		"append_all":  {In: ports{"filename": single, "data": single}, Out: ports{"sig": single, "err": single}},
+		"write_lines": {In: ports{"filename": single, "data": single}, Out: ports{"sig": single, "err": single}},
+
+		"os_read_dir":   {In: ports{"name": single}, Out: ports{"res": single, "err": single}},
+		"os_stat":       {In: ports{"name": single}, Out: ports{"res": single, "err": single}},
+		"os_mkdir_all":  {In: ports{"path": single}, Out: ports{"sig": single, "err": single}},
+		"os_remove":     {In: ports{"name": single}, Out: ports{"sig": single, "err": single}},
+		"os_rename":     {In: ports{"from": single, "to": single}, Out: ports{"sig": single, "err": single}},
+		"os_getenv":     {In: ports{"key": single}, Out: ports{"res": single}},
+		"os_lookup_env": {In: ports{"key": single}, Out: ports{"res": single}},
+		"os_environ":    {In: ports{"sig": single}, Out: ports{"res": single}},
+		"os_getwd":      {In: ports{"sig": single}, Out: ports{"res": single, "err": single}},
+		"os_exit":       {In: ports{"code": single}},
+
+		"http_get":     {In: ports{"url": single}, Out: ports{"res": single, "err": single}},
+		"http_do":      {In: ports{"req": single}, Out: ports{"res": single, "err": single}},
+		"http_serve":   {In: ports{"addr": single, "sig": single}, Out: ports{"listening": single, "req": single, "err": single}},
+		"http_respond": {In: ports{"id": single, "res": single}, Out: ports{"sig": single, "err": single}},
+		"image_encode": {In: ports{"img": single}, Out: ports{"data": single, "err": single}},
+		"image_new":    {In: ports{"pixels": single}, Out: ports{"img": single, "err": single}},
+
+		"json_marshal":   {In: ports{"data": single}, Out: ports{"res": single, "err": single}},
+		"json_unmarshal": {In: ports{"data": single}, Out: ports{"res": single, "err": single}},
+
+		"wait_group": {In: ports{"count": single, "sig": single}, Out: ports{"sig": single}},
+
+		"accumulator": {In: ports{"init": single, "upd": single, "last": single}, Out: ports{"cur": single, "res": single}},
+
+		"int_pow": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+
+		"int_bitwise_and": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_bitwise_or":  {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_bitwise_xor": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_bitwise_lsh": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+		"int_bitwise_rsh": {In: ports{"left": single, "right": single}, Out: ports{"res": single}},
+	}
+}
@@ -0,0 +1,118 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+// TestManifest makes sure every function can be created with exactly the ports its signature describes:
+// creation succeeds with all declared ports and fails if any one of them is missing.
+func TestManifest(t *testing.T) {
+	registry := NewRegistry()
+	manifest := NewManifest()
+
+	require.Len(t, manifest, len(registry))
+
+	configs := map[string]runtime.Msg{
+		"new":            runtime.NewIntMsg(42),
+		"new_v2":         runtime.NewIntMsg(42),
+		"field":          runtime.NewListMsg([]runtime.Msg{runtime.NewStringMsg("a")}),
+		"union_wrap":     runtime.NewIntMsg(0),
+		"json_marshal":   runtime.NewStringMsg(`{"kind":"any"}`),
+		"json_unmarshal": runtime.NewStringMsg(`{"kind":"any"}`),
+	}
+
+	for ref, creator := range registry {
+		sig, ok := manifest[ref]
+		require.True(t, ok, "signature not found: %v", ref)
+
+		in := sig.In
+		if sig.Autoports {
+			in = ports{"a": single, "b": single}
+		}
+
+		_, err := creator.Create(
+			runtime.IO{
+				In:  runtime.NewInports(manifestInports(in)),
+				Out: runtime.NewOutports(manifestOutports(sig.Out)),
+			},
+			configs[ref],
+		)
+		require.NoError(t, err, ref)
+
+		if !sig.Autoports {
+			for name := range in {
+				_, err := creator.Create(
+					runtime.IO{
+						In:  runtime.NewInports(manifestInports(without(in, name))),
+						Out: runtime.NewOutports(manifestOutports(sig.Out)),
+					},
+					configs[ref],
+				)
+				require.Error(t, err, "%v: declared inport %v is not used", ref, name)
+			}
+		}
+
+		for name := range sig.Out {
+			_, err := creator.Create(
+				runtime.IO{
+					In:  runtime.NewInports(manifestInports(in)),
+					Out: runtime.NewOutports(manifestOutports(without(sig.Out, name))),
+				},
+				configs[ref],
+			)
+			require.Error(t, err, "%v: declared outport %v is not used", ref, name)
+		}
+	}
+}
+
+func without(sig ports, name string) ports {
+	result := make(ports, len(sig)-1)
+	for k, v := range sig {
+		if k != name {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func manifestInports(sig map[string]runtime.PortKind) map[string]runtime.Inport {
+	result := make(map[string]runtime.Inport, len(sig))
+	for name, kind := range sig {
+		addr := runtime.PortAddr{Path: "in", Port: name}
+		if kind == runtime.ArrayPort {
+			result[name] = runtime.NewInport(
+				runtime.NewArrayInport([]<-chan runtime.OrderedMsg{make(chan runtime.OrderedMsg)}, addr, runtime.ProdInterceptor{}),
+				nil,
+			)
+			continue
+		}
+		result[name] = runtime.NewInport(
+			nil,
+			runtime.NewSingleInport(make(chan runtime.OrderedMsg), addr, runtime.ProdInterceptor{}),
+		)
+	}
+	return result
+}
+
+func manifestOutports(sig map[string]runtime.PortKind) map[string]runtime.Outport {
+	result := make(map[string]runtime.Outport, len(sig))
+	for name, kind := range sig {
+		addr := runtime.PortAddr{Path: "out", Port: name}
+		if kind == runtime.ArrayPort {
+			result[name] = runtime.NewOutport(
+				nil,
+				runtime.NewArrayOutport(addr, runtime.ProdInterceptor{}, []chan<- runtime.OrderedMsg{make(chan runtime.OrderedMsg)}),
+			)
+			continue
+		}
+		result[name] = runtime.NewOutport(
+			runtime.NewSingleOutport(addr, runtime.ProdInterceptor{}, make(chan runtime.OrderedMsg)),
+			nil,
+		)
+	}
+	return result
+}
@@ -0,0 +1,20 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestMapLen(t *testing.T) {
+	empty := runDataToRes(t, mapLen{}, runtime.NewDictMsg(map[string]runtime.Msg{}))
+	require.Equal(t, int64(0), empty.Int())
+
+	dict := runtime.NewDictMsg(map[string]runtime.Msg{
+		"a": runtime.NewIntMsg(1),
+		"b": runtime.NewIntMsg(2),
+	})
+	require.Equal(t, int64(2), runDataToRes(t, mapLen{}, dict).Int())
+}
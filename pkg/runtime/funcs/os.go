@@ -0,0 +1,407 @@
+package funcs
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type osReadDir struct{}
+
+func (osReadDir) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	nameIn, err := rio.In.Single("name")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			nameMsg, ok := nameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			// infos are collected before sending, so stream is never interrupted by an error
+			infos, err := readDir(nameMsg.Str())
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			for idx, info := range infos {
+				item := streamItem(info, int64(idx), idx == len(infos)-1)
+				if !resOut.Send(ctx, item) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// readDir returns info about directory entries sorted by filename.
+func readDir(name string) ([]runtime.Msg, error) {
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]runtime.Msg, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, fileInfoMsg(info))
+	}
+
+	return infos, nil
+}
+
+type osStat struct{}
+
+func (osStat) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	nameIn, err := rio.In.Single("name")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			nameMsg, ok := nameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			info, err := os.Stat(nameMsg.Str())
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, fileInfoMsg(info)) {
+				return
+			}
+		}
+	}, nil
+}
+
+// fileInfoMsg creates `os.FileInfo` message, modification time is unix time in nanoseconds.
+func fileInfoMsg(info fs.FileInfo) runtime.StructMsg {
+	return runtime.NewStructMsg(
+		[]string{"isDir", "modTime", "mode", "name", "size"},
+		[]runtime.Msg{
+			runtime.NewBoolMsg(info.IsDir()),
+			runtime.NewIntMsg(info.ModTime().UnixNano()),
+			runtime.NewIntMsg(int64(info.Mode().Perm())),
+			runtime.NewStringMsg(info.Name()),
+			runtime.NewIntMsg(info.Size()),
+		},
+	)
+}
+
+type osMkdirAll struct{}
+
+func (osMkdirAll) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	pathIn, err := rio.In.Single("path")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := rio.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			pathMsg, ok := pathIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if err := os.MkdirAll(pathMsg.Str(), 0755); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osRemove struct{}
+
+func (osRemove) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	nameIn, err := rio.In.Single("name")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := rio.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			nameMsg, ok := nameIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if err := os.Remove(nameMsg.Str()); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osRename struct{}
+
+func (osRename) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	fromIn, err := rio.In.Single("from")
+	if err != nil {
+		return nil, err
+	}
+
+	toIn, err := rio.In.Single("to")
+	if err != nil {
+		return nil, err
+	}
+
+	sigOut, err := rio.Out.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			fromMsg, ok := fromIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			toMsg, ok := toIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if err := os.Rename(fromMsg.Str(), toMsg.Str()); err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !sigOut.Send(ctx, emptyStruct()) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osGetenv struct{}
+
+func (osGetenv) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	keyIn, err := rio.In.Single("key")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			keyMsg, ok := keyIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if !resOut.Send(ctx, runtime.NewStringMsg(os.Getenv(keyMsg.Str()))) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osLookupEnv struct{}
+
+func (osLookupEnv) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	keyIn, err := rio.In.Single("key")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			keyMsg, ok := keyIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			// nil message is a none value of maybe type (see unwrap)
+			var res runtime.Msg
+			if value, ok := os.LookupEnv(keyMsg.Str()); ok {
+				res = runtime.NewStringMsg(value)
+			}
+
+			if !resOut.Send(ctx, res) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osEnviron struct{}
+
+func (osEnviron) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	sigIn, err := rio.In.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			if _, ok := sigIn.Receive(ctx); !ok {
+				return
+			}
+
+			if !resOut.Send(ctx, environMsg(os.Environ())) {
+				return
+			}
+		}
+	}, nil
+}
+
+// environMsg converts "key=value" pairs to dictionary.
+func environMsg(environ []string) runtime.DictMsg {
+	dict := make(map[string]runtime.Msg, len(environ))
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		dict[key] = runtime.NewStringMsg(value)
+	}
+	return runtime.NewDictMsg(dict)
+}
+
+type osGetwd struct{}
+
+func (osGetwd) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	sigIn, err := rio.In.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := rio.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := rio.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			if _, ok := sigIn.Receive(ctx); !ok {
+				return
+			}
+
+			wd, err := os.Getwd()
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, runtime.NewStringMsg(wd)) {
+				return
+			}
+		}
+	}, nil
+}
+
+type osExit struct{}
+
+func (osExit) Create(rio runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	codeIn, err := rio.In.Single("code")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		codeMsg, ok := codeIn.Receive(ctx)
+		if !ok {
+			return
+		}
+
+		runtime.Exit(ctx, int(codeMsg.Int()))
+	}, nil
+}
@@ -2,10 +2,8 @@ package funcs
 
 import (
 	"context"
-	"fmt"
-	"os"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type panicker struct{}
@@ -25,11 +23,6 @@ func (p panicker) Create(
 			return
 		}
 
-		cancel := ctx.Value("cancel").(context.CancelFunc)
-		cancel()
-
-		if _, err := fmt.Fprintln(os.Stderr, "panic:", panicMsg); err != nil {
-			panic(err)
-		}
+		runtime.Panic(ctx, panicMsg, msgIn.Addr())
 	}, nil
 }
@@ -0,0 +1,95 @@
+package funcs
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type rangeFloat struct{}
+
+func (rangeFloat) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	fromIn, err := io.In.Single("from")
+	if err != nil {
+		return nil, err
+	}
+
+	toIn, err := io.In.Single("to")
+	if err != nil {
+		return nil, err
+	}
+
+	stepIn, err := io.In.Single("step")
+	if err != nil {
+		return nil, err
+	}
+
+	sigIn, err := io.In.Single("sig")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := io.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			// Wait for signal before processing
+			_, ok := sigIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			fromMsg, ok := fromIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			toMsg, ok := toIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			stepMsg, ok := stepIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			var (
+				from = fromMsg.Float()
+				to   = toMsg.Float()
+				step = stepMsg.Float()
+			)
+
+			if !(step > 0) { // NaN is invalid too
+				err := fmt.Errorf("range step must be positive: %v", step)
+				runtime.Panic(ctx, errFromErr(err), stepIn.Addr())
+				return
+			}
+
+			// items are computed from index instead of accumulating step
+			// so rounding errors don't add up, e.g. 0..1..0.1 has exactly 10 items.
+			// Empty range (from == to) sends nothing, just like empty list is streamed
+			count := int64(math.Ceil(math.Abs(to-from)/step - 1e-9))
+			if from > to {
+				step = -step
+			}
+
+			for idx := int64(0); idx < count; idx++ {
+				item := streamItem(
+					runtime.NewFloatMsg(from+float64(idx)*step),
+					idx,
+					idx == count-1,
+				)
+
+				if !resOut.Send(ctx, item) {
+					return
+				}
+			}
+		}
+	}, nil
+}
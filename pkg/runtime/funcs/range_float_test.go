@@ -0,0 +1,69 @@
+package funcs
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestRangeFloat(t *testing.T) {
+	tests := []struct {
+		name           string
+		from, to, step float64
+		want           []float64
+	}{
+		{name: "ascending", from: 0, to: 1, step: 0.25, want: []float64{0, 0.25, 0.5, 0.75}},
+		{name: "descending", from: 1, to: 0, step: 0.25, want: []float64{1, 0.75, 0.5, 0.25}},
+		{name: "step does not divide range", from: 0, to: 1, step: 0.4, want: []float64{0, 0.4, 0.8}},
+		{name: "negative", from: -1, to: 0, step: 0.5, want: []float64{-1, -0.5}},
+		{name: "step bigger than range", from: 0, to: 0.5, step: 1, want: []float64{0}},
+	}
+
+	send := startRange(t, rangeFloat{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := send(runtime.NewFloatMsg(tt.from), runtime.NewFloatMsg(tt.to), runtime.NewFloatMsg(tt.step))
+
+			want := make([]runtime.Msg, 0, len(tt.want))
+			for _, v := range tt.want {
+				want = append(want, runtime.NewFloatMsg(v))
+			}
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRangeFloat_NoAccumulatedError(t *testing.T) {
+	send := startRange(t, rangeFloat{})
+
+	got := send(runtime.NewFloatMsg(0), runtime.NewFloatMsg(1), runtime.NewFloatMsg(0.1))
+	require.Len(t, got, 10)
+	require.InDelta(t, 0.9, got[9].Float(), 1e-9)
+}
+
+func TestRangeFloat_Empty(t *testing.T) {
+	send := startRange(t, rangeFloat{})
+
+	// nothing is sent for empty range so the next range starts from the first item
+	require.Empty(t, send(runtime.NewFloatMsg(0.5), runtime.NewFloatMsg(0.5), runtime.NewFloatMsg(0.1)))
+	require.Equal(
+		t,
+		[]runtime.Msg{runtime.NewFloatMsg(0), runtime.NewFloatMsg(0.5)},
+		send(runtime.NewFloatMsg(0), runtime.NewFloatMsg(1), runtime.NewFloatMsg(0.5)),
+	)
+}
+
+func TestRangeFloat_InvalidStep(t *testing.T) {
+	for _, step := range []float64{0, -0.5, math.NaN()} {
+		err := runRangeProgram(t, rangeFloat{}, runtime.NewFloatMsg(0), runtime.NewFloatMsg(1), runtime.NewFloatMsg(step))
+
+		var panicErr *runtime.PanicError
+		require.True(t, errors.As(err, &panicErr), "step %v: %v", step, err)
+		require.Equal(t, "step", panicErr.Port.Port)
+	}
+}
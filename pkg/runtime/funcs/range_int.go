@@ -2,8 +2,9 @@ package funcs
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type rangeInt struct{}
@@ -88,8 +89,6 @@ func (rangeInt) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context),
 	}, nil
 }
 
-// Add this new struct and function after the existing rangeInt implementation
-
 type rangeIntV2 struct{}
 
 func (rangeIntV2) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
@@ -103,6 +102,11 @@ func (rangeIntV2) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context
 		return nil, err
 	}
 
+	stepIn, err := io.In.Single("step")
+	if err != nil {
+		return nil, err
+	}
+
 	sigIn, err := io.In.Single("sig")
 	if err != nil {
 		return nil, err
@@ -131,53 +135,44 @@ func (rangeIntV2) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context
 				return
 			}
 
+			stepMsg, ok := stepIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
 			var (
 				from = fromMsg.Int()
 				to   = toMsg.Int()
-
-				idx  int64 = 0
-				last bool  = false
-				data int64 = from
+				step = stepMsg.Int()
 			)
 
-			if from < to { // example: 0..10
-				for !last {
-					if data == to-1 {
-						last = true
-					}
+			// step is a distance between items, direction is defined by from and to
+			if step <= 0 {
+				err := fmt.Errorf("range step must be positive: %v", step)
+				runtime.Panic(ctx, errFromErr(err), stepIn.Addr())
+				return
+			}
+			if from > to { // example: 10..0
+				step = -step
+			}
 
-					item := streamItem(
-						runtime.NewIntMsg(data),
-						idx,
-						last,
-					)
+			// empty range (from == to) sends nothing, just like empty list is streamed
+			var idx int64 = 0
+			for data := from; data != to && (data < to) == (step > 0); data += step {
+				next := data + step
+				last := next == to || (next < to) != (step > 0)
 
-					if !resOut.Send(ctx, item) {
-						return
-					}
+				item := streamItem(
+					runtime.NewIntMsg(data),
+					idx,
+					last,
+				)
 
-					idx++
-					data++
+				if !resOut.Send(ctx, item) {
+					return
 				}
-			} else { // example: 10..0
-				for !last {
-					if data == toMsg.Int()+1 {
-						last = true
-					}
 
-					item := streamItem(
-						runtime.NewIntMsg(data),
-						idx,
-						last,
-					)
-
-					if !resOut.Send(ctx, item) {
-						return
-					}
-
-					idx++
-					data--
-				}
+				idx++
 			}
 		}
 	}, nil
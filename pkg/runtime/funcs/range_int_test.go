@@ -0,0 +1,157 @@
+package funcs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestRangeIntV2(t *testing.T) {
+	tests := []struct {
+		name           string
+		from, to, step int64
+		want           []int64
+	}{
+		{name: "ascending", from: 0, to: 3, step: 1, want: []int64{0, 1, 2}},
+		{name: "descending", from: 3, to: 0, step: 1, want: []int64{3, 2, 1}},
+		{name: "step", from: 0, to: 10, step: 3, want: []int64{0, 3, 6, 9}},
+		{name: "descending step", from: 10, to: 0, step: 3, want: []int64{10, 7, 4, 1}},
+		{name: "negative", from: -3, to: 0, step: 2, want: []int64{-3, -1}},
+		{name: "step bigger than range", from: 0, to: 2, step: 5, want: []int64{0}},
+	}
+
+	send := startRange(t, rangeIntV2{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := send(runtime.NewIntMsg(tt.from), runtime.NewIntMsg(tt.to), runtime.NewIntMsg(tt.step))
+
+			want := make([]runtime.Msg, 0, len(tt.want))
+			for _, v := range tt.want {
+				want = append(want, runtime.NewIntMsg(v))
+			}
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRangeIntV2_Empty(t *testing.T) {
+	send := startRange(t, rangeIntV2{})
+
+	// nothing is sent for empty range so the next range starts from the first item
+	require.Empty(t, send(runtime.NewIntMsg(5), runtime.NewIntMsg(5), runtime.NewIntMsg(1)))
+	require.Equal(
+		t,
+		[]runtime.Msg{runtime.NewIntMsg(1), runtime.NewIntMsg(2)},
+		send(runtime.NewIntMsg(1), runtime.NewIntMsg(3), runtime.NewIntMsg(1)),
+	)
+}
+
+func TestRangeIntV2_InvalidStep(t *testing.T) {
+	for _, step := range []int64{0, -1} {
+		err := runRangeProgram(t, rangeIntV2{}, runtime.NewIntMsg(0), runtime.NewIntMsg(3), runtime.NewIntMsg(step))
+
+		var panicErr *runtime.PanicError
+		require.True(t, errors.As(err, &panicErr), "step %d: %v", step, err)
+		require.Equal(t, "step", panicErr.Port.Port)
+	}
+}
+
+// startRange runs range function and returns function that sends range members
+// and returns items of the stream, or nothing if stream is empty.
+func startRange(t *testing.T, creator runtime.FuncCreator) func(from, to, step runtime.Msg) []runtime.Msg {
+	t.Helper()
+
+	fromCh, toCh := make(chan runtime.OrderedMsg, 1), make(chan runtime.OrderedMsg, 1)
+	stepCh, sigCh := make(chan runtime.OrderedMsg, 1), make(chan runtime.OrderedMsg, 1)
+	resCh := make(chan runtime.OrderedMsg)
+
+	handler, err := creator.Create(
+		runtime.IO{
+			In: testInports(map[string]chan runtime.OrderedMsg{
+				"from": fromCh, "to": toCh, "step": stepCh, "sig": sigCh,
+			}),
+			Out: testOutports(map[string]chan runtime.OrderedMsg{"res": resCh}),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go handler(ctx)
+
+	return func(from, to, step runtime.Msg) []runtime.Msg {
+		t.Helper()
+
+		sigCh <- runtime.OrderedMsg{Msg: emptyStruct()}
+		fromCh <- runtime.OrderedMsg{Msg: from}
+		toCh <- runtime.OrderedMsg{Msg: to}
+		stepCh <- runtime.OrderedMsg{Msg: step}
+
+		var items []runtime.Msg
+		for {
+			select {
+			case msg := <-resCh:
+				item := msg.Msg.Struct()
+				require.Equal(t, int64(len(items)), item.Get("idx").Int())
+				items = append(items, item.Get("data"))
+				if item.Get("last").Bool() {
+					return items
+				}
+			case <-time.After(100 * time.Millisecond):
+				// range is empty if function is ready to receive the next signal
+				if len(items) == 0 && len(sigCh) == 0 && len(stepCh) == 0 {
+					return nil
+				}
+				t.Fatal("timeout waiting for range items")
+				return nil
+			}
+		}
+	}
+}
+
+// runRangeProgram runs program with a single range function and returns what program is terminated with.
+func runRangeProgram(t *testing.T, creator runtime.FuncCreator, from, to, step runtime.Msg) error {
+	t.Helper()
+
+	sigCh, stopCh := make(chan runtime.OrderedMsg), make(chan runtime.OrderedMsg)
+	chans := map[string]chan runtime.OrderedMsg{
+		"from": make(chan runtime.OrderedMsg, 1),
+		"to":   make(chan runtime.OrderedMsg, 1),
+		"step": make(chan runtime.OrderedMsg, 1),
+	}
+	chans["from"] <- runtime.OrderedMsg{Msg: from}
+	chans["to"] <- runtime.OrderedMsg{Msg: to}
+	chans["step"] <- runtime.OrderedMsg{Msg: step}
+
+	inports := make(map[string]runtime.Inport, len(chans)+1)
+	for name, ch := range chans {
+		inports[name] = runtime.NewInport(nil, runtime.NewSingleInport(ch, runtime.PortAddr{Path: "range/in", Port: name}, runtime.ProdInterceptor{}))
+	}
+	inports["sig"] = runtime.NewInport(nil, runtime.NewSingleInport(sigCh, runtime.PortAddr{Path: "range/in", Port: "sig"}, runtime.ProdInterceptor{}))
+
+	prog := runtime.Program{
+		Start: runtime.NewSingleOutport(runtime.PortAddr{Path: "in", Port: "start"}, runtime.ProdInterceptor{}, sigCh),
+		Stop:  runtime.NewSingleInport(stopCh, runtime.PortAddr{Path: "out", Port: "stop"}, runtime.ProdInterceptor{}),
+		FuncCalls: []runtime.FuncCall{{
+			Ref: "range",
+			IO: runtime.IO{
+				In: runtime.NewInports(inports),
+				Out: runtime.NewOutports(map[string]runtime.Outport{
+					"res": runtime.NewOutport(runtime.NewSingleOutport(runtime.PortAddr{Path: "range/out", Port: "res"}, runtime.ProdInterceptor{}, stopCh), nil),
+				}),
+			},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return runtime.Run(ctx, prog, map[string]runtime.FuncCreator{"range": creator})
+}
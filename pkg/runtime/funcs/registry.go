@@ -1,12 +1,14 @@
 // Package funcs implements low-level flows (runtime functions).
-// It has only one exported entity which is function creators registry.
+// It exports function creators registry and the manifest that describes their signatures.
 package funcs
 
 import (
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 func NewRegistry() map[string]runtime.FuncCreator {
+	requests := &httpRequests{}
+
 	return map[string]runtime.FuncCreator{
 		"new":     new{},
 		"new_v2":  newV2{},
@@ -19,6 +21,8 @@ func NewRegistry() map[string]runtime.FuncCreator {
 		"panic": panicker{},
 
 		"switch_router": switchRouter{},
+		"union_switch":  unionSwitchRouter{},
+		"union_wrap":    unionWrapper{},
 		"match":         match{},
 		"select":        selector{},
 		"ternary":       ternary{},
@@ -38,13 +42,17 @@ func NewRegistry() map[string]runtime.FuncCreator {
 		"string_is_greater": strIsGreater{},
 		"string_is_lesser":  strIsLesser{},
 
-		"float_is_greater": floatIsGreater{},
-		"float_is_lesser":  floatIsLesser{},
+		"float_is_greater":          floatIsGreater{},
+		"float_is_greater_or_equal": floatIsGreaterOrEqual{},
+
+		"float_is_lesser":          floatIsLesser{},
+		"float_is_lesser_or_equal": floatIsLesserOrEqual{},
 
 		"array_port_to_stream": arrayPortToStream{},
 		"list_to_stream":       listToStream{},
 		"stream_int_range":     rangeInt{},
 		"stream_int_range_v2":  rangeIntV2{},
+		"stream_float_range":   rangeFloat{},
 		"stream_product":       streamProduct{},
 		"stream_zip":           streamZip{},
 
@@ -65,17 +73,24 @@ func NewRegistry() map[string]runtime.FuncCreator {
 		"float_div":  floatDiv{},
 		"string_add": stringAdd{},
 
-		"int_inc": intInc{},
-		"int_dec": intDec{},
-		"int_mod": intMod{},
+		"int_inc":   intInc{},
+		"int_dec":   intDec{},
+		"int_neg":   intNeg{},
+		"int_mod":   intMod{},
+		"float_inc": floatInc{},
+		"float_dec": floatDec{},
+		"float_neg": floatNeg{},
 
-		"parse_int": parseInt{},
+		"parse_int":   parseInt{},
+		"parse_float": parseFloat{},
 
 		"regexp_submatch": regexpSubmatch{},
 
 		"list_at":   listAt{},
 		"list_len":  listlen{},
 		"list_push": listPush{},
+		"map_len":   mapLen{},
+		"slice":     slice{},
 
 		"time_delay": timeDelay{},
 		"time_after": timeAfter{},
@@ -92,12 +107,33 @@ func NewRegistry() map[string]runtime.FuncCreator {
 		"printf":  printf{},
 		"print":   print{},
 
-		"read_all":     fileReadAll{},
-		"write_all":    writeAll{},
+		"read_all":    fileReadAll{},
+		"read_lines":  fileReadLines{},
+		"write_all":   writeAll{},
+		"append_all":  appendAll{},
+		"write_lines": writeLines{},
+
+		"os_read_dir":   osReadDir{},
+		"os_stat":       osStat{},
+		"os_mkdir_all":  osMkdirAll{},
+		"os_remove":     osRemove{},
+		"os_rename":     osRename{},
+		"os_getenv":     osGetenv{},
+		"os_lookup_env": osLookupEnv{},
+		"os_environ":    osEnviron{},
+		"os_getwd":      osGetwd{},
+		"os_exit":       osExit{},
+
 		"http_get":     httpGet{},
+		"http_do":      httpDo{},
+		"http_serve":   httpServe{requests: requests},
+		"http_respond": httpRespond{requests: requests},
 		"image_encode": imageEncode{},
 		"image_new":    imageNew{},
 
+		"json_marshal":   jsonMarshal{},
+		"json_unmarshal": jsonUnmarshal{},
+
 		"wait_group": waitGroup{},
 
 		"accumulator": accumulator{},
@@ -0,0 +1,97 @@
+package funcs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+type slice struct{}
+
+func (slice) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	dataIn, err := io.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	fromIn, err := io.In.Single("from")
+	if err != nil {
+		return nil, err
+	}
+
+	toIn, err := io.In.Single("to")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := io.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := io.Out.Single("err")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			dataMsg, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			fromMsg, ok := fromIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			toMsg, ok := toIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			res, err := sliceMsg(dataMsg, fromMsg.Int(), toMsg.Int())
+			if err != nil {
+				if !errOut.Send(ctx, errFromErr(err)) {
+					return
+				}
+				continue
+			}
+
+			if !resOut.Send(ctx, res) {
+				return
+			}
+		}
+	}, nil
+}
+
+// sliceMsg works with both strings and lists, strings are sliced by utf-8 characters.
+func sliceMsg(data runtime.Msg, from, to int64) (runtime.Msg, error) {
+	if strMsg, ok := data.(runtime.StringMsg); ok {
+		runes := []rune(strMsg.Str())
+		if err := checkSliceBounds(from, to, len(runes)); err != nil {
+			return nil, err
+		}
+		return runtime.NewStringMsg(string(runes[from:to])), nil
+	}
+
+	list := data.List()
+	if err := checkSliceBounds(from, to, len(list)); err != nil {
+		return nil, err
+	}
+
+	// copy so the new list doesn't share memory with the original one
+	res := make([]runtime.Msg, to-from)
+	copy(res, list[from:to])
+
+	return runtime.NewListMsg(res), nil
+}
+
+func checkSliceBounds(from, to int64, l int) error {
+	if from < 0 || to > int64(l) || from > to {
+		return fmt.Errorf("slice bounds out of range [%d:%d] with length %d", from, to, l)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+func TestSliceMsg(t *testing.T) {
+	list := runtime.NewListMsg([]runtime.Msg{
+		runtime.NewIntMsg(1),
+		runtime.NewIntMsg(2),
+		runtime.NewIntMsg(3),
+	})
+
+	tests := []struct {
+		name     string
+		data     runtime.Msg
+		from, to int64
+		want     runtime.Msg
+		wantErr  string
+	}{
+		{
+			name: "string",
+			data: runtime.NewStringMsg("hello"),
+			from: 1, to: 3,
+			want: runtime.NewStringMsg("el"),
+		},
+		{
+			name: "string is sliced by characters",
+			data: runtime.NewStringMsg("привет"),
+			from: 0, to: 2,
+			want: runtime.NewStringMsg("пр"),
+		},
+		{
+			name: "empty result",
+			data: runtime.NewStringMsg("abc"),
+			from: 2, to: 2,
+			want: runtime.NewStringMsg(""),
+		},
+		{
+			name: "list",
+			data: list,
+			from: 1, to: 3,
+			want: runtime.NewListMsg([]runtime.Msg{runtime.NewIntMsg(2), runtime.NewIntMsg(3)}),
+		},
+		{
+			name: "negative from",
+			data: list,
+			from: -1, to: 2,
+			wantErr: "slice bounds out of range [-1:2] with length 3",
+		},
+		{
+			name: "to out of range",
+			data: runtime.NewStringMsg("abc"),
+			from: 0, to: 4,
+			wantErr: "slice bounds out of range [0:4] with length 3",
+		},
+		{
+			name: "from after to",
+			data: list,
+			from: 2, to: 1,
+			wantErr: "slice bounds out of range [2:1] with length 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sliceMsg(tt.data, tt.from, tt.to)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), "got %v", got)
+		})
+	}
+}
+
+func TestSliceMsgCopiesList(t *testing.T) {
+	items := []runtime.Msg{runtime.NewIntMsg(1), runtime.NewIntMsg(2)}
+
+	got, err := sliceMsg(runtime.NewListMsg(items), 0, 2)
+	require.NoError(t, err)
+
+	items[0] = runtime.NewIntMsg(42)
+	require.Equal(t, int64(1), got.List()[0].Int())
+}
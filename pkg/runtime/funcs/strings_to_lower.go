@@ -4,7 +4,7 @@ import (
 	"context"
 	"strings"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type stringsToLower struct{}
@@ -5,7 +5,7 @@ import (
 	"errors"
 	"sync"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type structBuilder struct{}
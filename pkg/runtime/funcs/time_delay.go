@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type timeDelay struct{}
@@ -37,7 +37,9 @@ func (timeDelay) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context)
 				return
 			}
 
-			time.Sleep(time.Duration(durMsg.Int()))
+			if !sleep(ctx, time.Duration(durMsg.Int())) {
+				return
+			}
 
 			if !resOut.Send(ctx, dataMsg) {
 				return
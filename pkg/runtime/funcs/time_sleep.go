@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type timeAfter struct{}
@@ -27,7 +27,9 @@ func (timeAfter) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context)
 				return
 			}
 
-			time.Sleep(time.Duration(durMsg.Int()))
+			if !sleep(ctx, time.Duration(durMsg.Int())) {
+				return
+			}
 
 			if !sigOut.Send(ctx, emptyStruct()) {
 				return
@@ -0,0 +1,96 @@
+package funcs
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+// unionSwitchRouter routes tagged union by its tag, case inports receive tags.
+// Matched case receives payload of the union, or the union itself if tag has no payload.
+type unionSwitchRouter struct{}
+
+func (unionSwitchRouter) Create(io runtime.IO, _ runtime.Msg) (func(ctx context.Context), error) {
+	dataIn, err := io.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	caseIn, err := io.In.Array("case")
+	if err != nil {
+		return nil, err
+	}
+
+	caseOut, err := io.Out.Array("case")
+	if err != nil {
+		return nil, err
+	}
+
+	elseOut, err := io.Out.Single("else")
+	if err != nil {
+		return nil, err
+	}
+
+	if caseIn.Len() != caseOut.Len() {
+		return nil, errors.New("number of 'case' inports must match number of outports")
+	}
+
+	return func(ctx context.Context) {
+		for {
+			var (
+				wg              sync.WaitGroup
+				dataMsg         runtime.Msg
+				cases           = make([]runtime.Msg, caseIn.Len())
+				dataOk, casesOk bool
+			)
+
+			wg.Add(2)
+
+			go func() {
+				dataMsg, dataOk = dataIn.Receive(ctx)
+				wg.Done()
+			}()
+
+			go func() {
+				casesOk = caseIn.ReceiveAll(ctx, func(idx int, msg runtime.Msg) bool {
+					cases[idx] = msg
+					return true
+				})
+				wg.Done()
+			}()
+
+			wg.Wait()
+
+			if !dataOk || !casesOk {
+				return
+			}
+
+			union := dataMsg.Union()
+
+			matchIdx := -1
+			for i, caseMsg := range cases {
+				if int64(union.Tag()) == caseMsg.Int() {
+					matchIdx = i
+					break
+				}
+			}
+
+			if matchIdx != -1 {
+				var caseMsg runtime.Msg = union
+				if union.Value() != nil {
+					caseMsg = union.Value()
+				}
+				if !caseOut.Send(ctx, uint8(matchIdx), caseMsg) {
+					return
+				}
+				continue
+			}
+
+			if !elseOut.Send(ctx, dataMsg) {
+				return
+			}
+		}
+	}, nil
+}
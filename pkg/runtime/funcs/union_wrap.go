@@ -0,0 +1,37 @@
+package funcs
+
+import (
+	"context"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
+
+// unionWrapper wraps incoming messages into tagged union, tag is taken from config.
+type unionWrapper struct{}
+
+func (unionWrapper) Create(io runtime.IO, cfg runtime.Msg) (func(ctx context.Context), error) {
+	tag := uint8(cfg.Int())
+
+	dataIn, err := io.In.Single("data")
+	if err != nil {
+		return nil, err
+	}
+
+	resOut, err := io.Out.Single("res")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) {
+		for {
+			dataMsg, ok := dataIn.Receive(ctx)
+			if !ok {
+				return
+			}
+
+			if !resOut.Send(ctx, runtime.NewUnionMsg(tag, dataMsg)) {
+				return
+			}
+		}
+	}, nil
+}
@@ -1,6 +1,11 @@
 package funcs
 
-import "github.com/nevalang/neva/internal/runtime"
+import (
+	"context"
+	"time"
+
+	"github.com/nevalang/neva/pkg/runtime"
+)
 
 func errFromErr(err error) runtime.StructMsg {
 	return runtime.NewStructMsg(
@@ -26,3 +31,16 @@ func streamItem(data runtime.Msg, idx int64, last bool) runtime.StructMsg {
 func emptyStruct() runtime.StructMsg {
 	return runtime.NewStructMsg(nil, nil)
 }
+
+// sleep pauses the current goroutine for at least the duration d.
+// It returns false if context is done before that.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"os"
 
-	"github.com/nevalang/neva/internal/runtime"
+	"github.com/nevalang/neva/pkg/runtime"
 )
 
 type writeAll struct{}
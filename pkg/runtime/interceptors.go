@@ -0,0 +1,169 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+type ProdInterceptor struct{}
+
+func (ProdInterceptor) Prepare() error { return nil }
+
+func (ProdInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg { return msg.Msg }
+
+func (ProdInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg { return msg.Msg }
+
+// MultiInterceptor calls several interceptors in order they were passed.
+// Each next interceptor gets the message returned by the previous one.
+type MultiInterceptor []Interceptor
+
+func (m MultiInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg {
+	for _, interceptor := range m {
+		msg.Msg = interceptor.Sent(sender, msg)
+	}
+	return msg.Msg
+}
+
+func (m MultiInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg {
+	for _, interceptor := range m {
+		msg.Msg = interceptor.Received(receiver, msg)
+	}
+	return msg.Msg
+}
+
+func (m MultiInterceptor) SendBlocked(sender PortSlotAddr, d time.Duration) {
+	for _, interceptor := range m {
+		if observer, ok := interceptor.(BlockingObserver); ok {
+			observer.SendBlocked(sender, d)
+		}
+	}
+}
+
+func (m MultiInterceptor) ReceiveBlocked(receiver PortSlotAddr, d time.Duration) {
+	for _, interceptor := range m {
+		if observer, ok := interceptor.(BlockingObserver); ok {
+			observer.ReceiveBlocked(receiver, d)
+		}
+	}
+}
+
+// NewMultiInterceptor returns interceptor that calls given interceptors.
+// It returns ProdInterceptor if there's nothing to call.
+func NewMultiInterceptor(interceptors ...Interceptor) Interceptor {
+	switch len(interceptors) {
+	case 0:
+		return ProdInterceptor{}
+	case 1:
+		return interceptors[0]
+	}
+	return MultiInterceptor(interceptors)
+}
+
+// TraceFormat defines how DebugInterceptor writes events to the trace file.
+type TraceFormat string
+
+const (
+	TraceFormatText TraceFormat = "text" // sent | path:port | msg
+	TraceFormatJSON TraceFormat = "json" // one JSON object per line, see TraceEvent
+)
+
+// TraceEvent is a line of the trace file in JSON format.
+type TraceEvent struct {
+	Time      time.Time       `json:"time"`
+	Index     uint64          `json:"index"`     // Chronological index of the message.
+	Direction string          `json:"direction"` // Either "sent" or "recv".
+	Path      string          `json:"path"`
+	Port      string          `json:"port"`
+	Slot      *uint8          `json:"slot,omitempty"` // Index of the array port slot.
+	Type      string          `json:"type"`           // Go type of the message.
+	Msg       json.RawMessage `json:"msg"`
+}
+
+type DebugInterceptor struct {
+	file   *os.File
+	format TraceFormat
+}
+
+func (d *DebugInterceptor) Open(filepath string) (func() error, error) {
+	file, err := os.OpenFile(filepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	d.file = file
+	return file.Close, nil
+}
+
+func (d *DebugInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg {
+	d.write("sent", sender, msg)
+	return msg.Msg
+}
+
+func (d *DebugInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg {
+	d.write("recv", receiver, msg)
+	return msg.Msg
+}
+
+func (d *DebugInterceptor) write(direction string, slotAddr PortSlotAddr, msg OrderedMsg) {
+	if d.format != TraceFormatJSON {
+		fmt.Fprintf(
+			d.file,
+			"%v | %v | %v\n",
+			direction, d.formatPortSlotAddr(slotAddr), d.formatMsg(msg.Msg),
+		)
+		return
+	}
+
+	serialized, err := json.Marshal(msg.Msg)
+	if err != nil {
+		serialized, _ = json.Marshal(fmt.Sprint(msg.Msg)) // e.g. internal messages
+	}
+
+	line, err := json.Marshal(TraceEvent{
+		Time:      time.Now(),
+		Index:     msg.Index(),
+		Direction: direction,
+		Path:      d.trimPath(slotAddr.Path),
+		Port:      slotAddr.Port,
+		Slot:      slotAddr.Index,
+		Type:      fmt.Sprintf("%T", msg.Msg),
+		Msg:       serialized,
+	})
+	if err != nil {
+		return
+	}
+
+	// single write per line so concurrent events are not mixed
+	d.file.Write(append(line, '\n')) //nolint:errcheck
+}
+
+func (d DebugInterceptor) formatMsg(msg Msg) string {
+	if strMsg, ok := msg.(StringMsg); ok {
+		return fmt.Sprintf("%q", strMsg.Str())
+	}
+	return fmt.Sprint(msg)
+}
+
+func (d DebugInterceptor) formatPortSlotAddr(slotAddr PortSlotAddr) string {
+	s := fmt.Sprintf("%v:%v", d.trimPath(slotAddr.Path), slotAddr.Port)
+	if slotAddr.Index != nil {
+		s = fmt.Sprintf("%v[%v]", s, *slotAddr.Index)
+	}
+	return s
+}
+
+// trimPath removes trailing "in" or "out" part of the port path.
+func (d DebugInterceptor) trimPath(path string) string {
+	parts := strings.Split(path, "/")
+	lastPart := parts[len(parts)-1]
+	if lastPart == "in" || lastPart == "out" {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, "/")
+}
+
+func NewDebugInterceptor(format TraceFormat) *DebugInterceptor {
+	return &DebugInterceptor{format: format}
+}
@@ -14,6 +14,11 @@ type OrderedMsg struct {
 	index uint64
 }
 
+// Index returns chronological index of the message. Messages sent earlier have lower indexes.
+func (o OrderedMsg) Index() uint64 {
+	return o.index
+}
+
 func (o OrderedMsg) String() string {
 	return fmt.Sprint(o.Msg)
 }
@@ -239,6 +244,9 @@ type StructMsg struct {
 
 func (msg StructMsg) Struct() StructMsg { return msg }
 
+// Names returns names of the fields in the same order as they are stored.
+func (msg StructMsg) Names() []string { return msg.names }
+
 // Get returns the value of a field by name.
 // It panics if the field is not found.
 // It uses binary search to find the field, assuming the names are sorted.
@@ -326,8 +334,27 @@ func (msg UnionMsg) Union() UnionMsg { return msg }
 func (msg UnionMsg) Tag() uint8      { return msg.tag }
 func (msg UnionMsg) Value() Msg      { return msg.value }
 func (msg UnionMsg) String() string {
+	if msg.value == nil {
+		return fmt.Sprintf("Union(%d)", msg.tag)
+	}
 	return fmt.Sprintf("Union(%d, %v)", msg.tag, msg.value)
 }
+func (msg UnionMsg) MarshalJSON() ([]byte, error) {
+	if msg.value == nil {
+		return json.Marshal(map[string]any{"tag": msg.tag})
+	}
+	return json.Marshal(map[string]any{"tag": msg.tag, "value": msg.value})
+}
+func (msg UnionMsg) Equal(other Msg) bool {
+	otherUnion, ok := other.(UnionMsg)
+	if !ok || msg.tag != otherUnion.tag {
+		return false
+	}
+	if msg.value == nil || otherUnion.value == nil {
+		return msg.value == nil && otherUnion.value == nil
+	}
+	return msg.value.Equal(otherUnion.value)
+}
 
 func NewUnionMsg(tag uint8, value Msg) UnionMsg {
 	return UnionMsg{
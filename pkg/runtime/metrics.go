@@ -0,0 +1,215 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MetricsFormat defines how MetricsInterceptor dumps counters.
+type MetricsFormat string
+
+const (
+	MetricsFormatJSON       MetricsFormat = "json"
+	MetricsFormatPrometheus MetricsFormat = "prometheus" // text exposition format
+)
+
+// MetricsInterceptor counts messages and time spent blocked on channels for every port.
+// Slots of array ports are counted together.
+type MetricsInterceptor struct {
+	mu    sync.RWMutex
+	ports map[PortAddr]*portCounters
+}
+
+type portCounters struct {
+	sent, received                  atomic.Uint64
+	sendBlockedNs, receiveBlockedNs atomic.Int64
+}
+
+// PortMetrics is a snapshot of counters of a single port.
+type PortMetrics struct {
+	Path             string `json:"path"`
+	Port             string `json:"port"`
+	Sent             uint64 `json:"sent"`
+	Received         uint64 `json:"received"`
+	SendBlockedNs    int64  `json:"sendBlockedNs"`
+	ReceiveBlockedNs int64  `json:"receiveBlockedNs"`
+}
+
+func (m *MetricsInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg {
+	m.counters(sender.PortAddr).sent.Add(1)
+	return msg.Msg
+}
+
+func (m *MetricsInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg {
+	m.counters(receiver.PortAddr).received.Add(1)
+	return msg.Msg
+}
+
+func (m *MetricsInterceptor) SendBlocked(sender PortSlotAddr, d time.Duration) {
+	m.counters(sender.PortAddr).sendBlockedNs.Add(d.Nanoseconds())
+}
+
+func (m *MetricsInterceptor) ReceiveBlocked(receiver PortSlotAddr, d time.Duration) {
+	m.counters(receiver.PortAddr).receiveBlockedNs.Add(d.Nanoseconds())
+}
+
+func (m *MetricsInterceptor) counters(addr PortAddr) *portCounters {
+	m.mu.RLock()
+	counters, ok := m.ports[addr]
+	m.mu.RUnlock()
+	if ok {
+		return counters
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if counters, ok := m.ports[addr]; ok {
+		return counters
+	}
+	counters = &portCounters{}
+	m.ports[addr] = counters
+	return counters
+}
+
+// Snapshot returns current values of counters ordered by port address.
+func (m *MetricsInterceptor) Snapshot() []PortMetrics {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]PortMetrics, 0, len(m.ports))
+	for addr, counters := range m.ports {
+		result = append(result, PortMetrics{
+			Path:             addr.Path,
+			Port:             addr.Port,
+			Sent:             counters.sent.Load(),
+			Received:         counters.received.Load(),
+			SendBlockedNs:    counters.sendBlockedNs.Load(),
+			ReceiveBlockedNs: counters.receiveBlockedNs.Load(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Port < result[j].Port
+	})
+
+	return result
+}
+
+// Write writes snapshot of counters in given format.
+func (m *MetricsInterceptor) Write(w io.Writer, format MetricsFormat) error {
+	snapshot := m.Snapshot()
+
+	if format != MetricsFormatPrometheus {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(snapshot)
+	}
+
+	metrics := []struct {
+		name, help, typ string
+		value           func(PortMetrics) string
+	}{
+		{
+			"neva_port_sent_total", "Number of messages sent by the port.", "counter",
+			func(p PortMetrics) string { return fmt.Sprint(p.Sent) },
+		},
+		{
+			"neva_port_received_total", "Number of messages received by the port.", "counter",
+			func(p PortMetrics) string { return fmt.Sprint(p.Received) },
+		},
+		{
+			"neva_port_send_blocked_seconds_total", "Time the port was blocked on sending.", "counter",
+			func(p PortMetrics) string { return fmt.Sprint(time.Duration(p.SendBlockedNs).Seconds()) },
+		},
+		{
+			"neva_port_receive_blocked_seconds_total", "Time the port was blocked on receiving.", "counter",
+			func(p PortMetrics) string { return fmt.Sprint(time.Duration(p.ReceiveBlockedNs).Seconds()) },
+		},
+	}
+
+	var sb strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&sb, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.typ)
+		for _, port := range snapshot {
+			fmt.Fprintf(
+				&sb,
+				"%s{path=%s,port=%s} %s\n",
+				metric.name,
+				prometheusLabel(port.Path),
+				prometheusLabel(port.Port),
+				metric.value(port),
+			)
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func prometheusLabel(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// Open makes interceptor dump counters to the file every time process gets SIGUSR1
+// (where supported) and when returned function is called at the end of the program.
+func (m *MetricsInterceptor) Open(filepath string, format MetricsFormat) (func() error, error) {
+	// check that we can write the file before program is started
+	if err := m.dump(filepath, format); err != nil {
+		return nil, err
+	}
+
+	signals := make(chan os.Signal, 1)
+	notifyMetricsDump(signals)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				if err := m.dump(filepath, format); err != nil {
+					fmt.Fprintln(os.Stderr, "can't write metrics file:", err.Error())
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		signal.Stop(signals)
+		close(done)
+		return m.dump(filepath, format)
+	}, nil
+}
+
+func (m *MetricsInterceptor) dump(filepath string, format MetricsFormat) error {
+	file, err := os.OpenFile(filepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if err := m.Write(file, format); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func NewMetricsInterceptor() *MetricsInterceptor {
+	return &MetricsInterceptor{
+		ports: map[PortAddr]*portCounters{},
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package runtime
+
+import "os"
+
+// notifyMetricsDump does nothing because SIGUSR1 is not available on this platform.
+func notifyMetricsDump(chan<- os.Signal) {}
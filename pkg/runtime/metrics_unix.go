@@ -0,0 +1,13 @@
+//go:build unix
+
+package runtime
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func notifyMetricsDump(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGUSR1)
+}
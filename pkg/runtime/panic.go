@@ -0,0 +1,73 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// PanicError is returned by Run and Serve when program is terminated by Panic.
+type PanicError struct {
+	Msg  Msg
+	Port PortAddr // inport that received the message, can be used to get its flowtrace
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", DebugInterceptor{}.formatMsg(e.Msg))
+}
+
+// ExitError is returned by Run when Main sends non-zero exit code from its stop outport
+// or when program is terminated by Exit with non-zero code.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit code %d", e.Code)
+}
+
+// panicState is shared by all functions of the program through the context.
+type panicState struct {
+	once   sync.Once
+	err    error // *PanicError or *ExitError
+	cancel context.CancelFunc
+}
+
+type panicStateKey struct{}
+
+// Panic terminates the program that runs function with the given context.
+// Only the first panic is reported, the next ones are ignored.
+func Panic(ctx context.Context, msg Msg, port PortAddr) {
+	state, ok := ctx.Value(panicStateKey{}).(*panicState)
+	if !ok {
+		panic(fmt.Sprintf("runtime.Panic called outside of the program: %v", msg))
+	}
+	state.once.Do(func() {
+		state.err = &PanicError{Msg: msg, Port: port}
+		state.cancel()
+	})
+}
+
+// Exit terminates the program that runs function with the given context, just like Panic does,
+// but instead of reporting an error it makes Run return the exit code (nil in case of zero code).
+// Whatever happens first, Panic or Exit, decides how the program is terminated.
+func Exit(ctx context.Context, code int) {
+	state, ok := ctx.Value(panicStateKey{}).(*panicState)
+	if !ok {
+		panic(fmt.Sprintf("runtime.Exit called outside of the program: %v", code))
+	}
+	state.once.Do(func() {
+		if code != 0 {
+			state.err = &ExitError{Code: code}
+		}
+		state.cancel()
+	})
+}
+
+// result returns panic (or exit) error if there was one. It must be called after all functions are finished.
+func (p *panicState) result() error {
+	if p.err == nil {
+		return nil
+	}
+	return p.err
+}
@@ -0,0 +1,218 @@
+package runtime
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ProfileInterceptor records message flow in Chrome Trace Event format,
+// that can be opened in chrome://tracing or https://ui.perfetto.dev.
+// Every function call is a track (thread) and every message is a flow
+// from the sender's track to the receiver's one. Sending is shown as a slice
+// that lasts until the message is received, so long slices show back-pressure.
+type ProfileInterceptor struct {
+	mu      sync.Mutex
+	start   time.Time
+	tracks  map[string]int      // node path -> track id
+	flows   map[uint64]*msgFlow // message index -> its flow
+	flowIDs []uint64            // indexes in order of appearance
+}
+
+type msgFlow struct {
+	sender, receiver       PortSlotAddr
+	sentAt, receivedAt     time.Duration
+	isSent, isReceived     bool
+	senderTrack, recvTrack int
+}
+
+// chromeTraceEvent is a single event of the Chrome Trace Event format.
+type chromeTraceEvent struct {
+	Name  string         `json:"name"`
+	Cat   string         `json:"cat,omitempty"`
+	Phase string         `json:"ph"`
+	Ts    float64        `json:"ts"` // microseconds
+	Dur   float64        `json:"dur,omitempty"`
+	Pid   int            `json:"pid"`
+	Tid   int            `json:"tid"`
+	ID    uint64         `json:"id,omitempty"`
+	BP    string         `json:"bp,omitempty"`
+	Args  map[string]any `json:"args,omitempty"`
+}
+
+// Open returns function that must be called after the program is finished.
+// It writes collected events to the file.
+func (p *ProfileInterceptor) Open(filepath string) (func() error, error) {
+	file, err := os.OpenFile(filepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	p.start = time.Now()
+
+	return func() error {
+		if err := json.NewEncoder(file).Encode(map[string]any{
+			"traceEvents":     p.events(),
+			"displayTimeUnit": "ns",
+		}); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}, nil
+}
+
+func (p *ProfileInterceptor) Sent(sender PortSlotAddr, msg OrderedMsg) Msg {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	flow := p.flow(msg.Index())
+	flow.sender = sender
+	flow.sentAt = time.Since(p.start)
+	flow.isSent = true
+	flow.senderTrack = p.track(sender.Path)
+
+	return msg.Msg
+}
+
+func (p *ProfileInterceptor) Received(receiver PortSlotAddr, msg OrderedMsg) Msg {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	flow := p.flow(msg.Index())
+	flow.receiver = receiver
+	flow.receivedAt = time.Since(p.start)
+	flow.isReceived = true
+	flow.recvTrack = p.track(receiver.Path)
+
+	return msg.Msg
+}
+
+func (p *ProfileInterceptor) flow(index uint64) *msgFlow {
+	flow, ok := p.flows[index]
+	if !ok {
+		flow = &msgFlow{}
+		p.flows[index] = flow
+		p.flowIDs = append(p.flowIDs, index)
+	}
+	return flow
+}
+
+func (p *ProfileInterceptor) track(portPath string) int {
+	path := DebugInterceptor{}.trimPath(portPath)
+	if id, ok := p.tracks[path]; ok {
+		return id
+	}
+	id := len(p.tracks) + 1
+	p.tracks[path] = id
+	return id
+}
+
+func (p *ProfileInterceptor) events() []chromeTraceEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	end := time.Since(p.start)
+	events := make([]chromeTraceEvent, 0, len(p.tracks)+len(p.flowIDs)*4)
+
+	paths := make([]string, 0, len(p.tracks))
+	for path := range p.tracks {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		name := path
+		if name == "" {
+			name = "main"
+		}
+		events = append(events, chromeTraceEvent{
+			Name:  "thread_name",
+			Phase: "M",
+			Pid:   1,
+			Tid:   p.tracks[path],
+			Args:  map[string]any{"name": name},
+		})
+	}
+
+	for _, index := range p.flowIDs {
+		flow := p.flows[index]
+		args := map[string]any{"index": index}
+
+		if flow.isSent {
+			// message is blocked by receiver until it's received or program is finished
+			blockedUntil := end
+			if flow.isReceived {
+				blockedUntil = flow.receivedAt
+			}
+			events = append(events, chromeTraceEvent{
+				Name:  "send " + formatProfilePort(flow.sender),
+				Cat:   "send",
+				Phase: "X",
+				Ts:    microseconds(flow.sentAt),
+				Dur:   microseconds(max(blockedUntil-flow.sentAt, time.Microsecond)),
+				Pid:   1,
+				Tid:   flow.senderTrack,
+				Args:  args,
+			})
+		}
+
+		if flow.isReceived {
+			events = append(events, chromeTraceEvent{
+				Name:  "recv " + formatProfilePort(flow.receiver),
+				Cat:   "recv",
+				Phase: "X",
+				Ts:    microseconds(flow.receivedAt),
+				Dur:   1,
+				Pid:   1,
+				Tid:   flow.recvTrack,
+				Args:  args,
+			})
+		}
+
+		if flow.isSent && flow.isReceived {
+			events = append(events,
+				chromeTraceEvent{
+					Name:  "msg",
+					Cat:   "flow",
+					Phase: "s",
+					Ts:    microseconds(flow.sentAt),
+					Pid:   1,
+					Tid:   flow.senderTrack,
+					ID:    index,
+				},
+				chromeTraceEvent{
+					Name:  "msg",
+					Cat:   "flow",
+					Phase: "f",
+					BP:    "e",
+					Ts:    microseconds(flow.receivedAt),
+					Pid:   1,
+					Tid:   flow.recvTrack,
+					ID:    index,
+				},
+			)
+		}
+	}
+
+	return events
+}
+
+func formatProfilePort(addr PortSlotAddr) string {
+	s := DebugInterceptor{}.formatPortSlotAddr(addr)
+	return strings.TrimPrefix(s, ":")
+}
+
+func microseconds(d time.Duration) float64 {
+	return float64(d.Nanoseconds()) / 1000
+}
+
+func NewProfileInterceptor() *ProfileInterceptor {
+	return &ProfileInterceptor{
+		tracks: map[string]int{},
+		flows:  map[uint64]*msgFlow{},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 )
 
 type Program struct {
@@ -12,6 +13,7 @@ type Program struct {
 	Start     *SingleOutport // Start must be inport of the first function
 	Stop      *SingleInport  // Stop must be outport of the (one of the) terminator function(s)
 	FuncCalls []FuncCall
+	ExitCode  bool // Message from stop is int exit code of the program
 }
 
 type FuncCall struct {
@@ -89,25 +91,29 @@ func NewSingleInport(
 	return &SingleInport{ch, addr, interceptor}
 }
 
+func (s SingleInport) Addr() PortAddr {
+	return s.addr
+}
+
 func (s SingleInport) Receive(ctx context.Context) (Msg, bool) {
+	slotAddr := PortSlotAddr{
+		PortAddr: PortAddr{
+			Path: s.addr.Path,
+			Port: s.addr.Port,
+		},
+	}
+	wait := startPortWait(ctx, s.interceptor, slotAddr)
+	defer wait.done()
+
 	var msg Msg
 	select {
 	case <-ctx.Done():
 		return nil, false
 	case v := <-s.ch:
-		msg = v.Msg
+		wait.received(v.Msg)
+		msg = s.interceptor.Received(slotAddr, v)
 	}
 
-	msg = s.interceptor.Received(
-		PortSlotAddr{
-			PortAddr: PortAddr{
-				Path: s.addr.Path,
-				Port: s.addr.Port,
-			},
-		},
-		msg,
-	)
-
 	return msg, true
 }
 
@@ -148,22 +154,23 @@ func NewArrayInport(
 // It returns the received message and a boolean indicating success.
 // It returns false if the context is done or if the channel is closed.
 func (a ArrayInport) Receive(ctx context.Context, idx int) (Msg, bool) {
+	index := uint8(idx)
+	slotAddr := PortSlotAddr{
+		PortAddr: PortAddr{
+			Path: a.addr.Path,
+			Port: a.addr.Port,
+		},
+		Index: &index,
+	}
+	wait := startPortWait(ctx, a.interceptor, slotAddr)
+	defer wait.done()
+
 	select {
 	case <-ctx.Done():
 		return nil, false
 	case v := <-a.chans[idx]:
-		index := uint8(idx)
-		msg := a.interceptor.Received(
-			PortSlotAddr{
-				PortAddr: PortAddr{
-					Path: a.addr.Path,
-					Port: a.addr.Port,
-				},
-				Index: &index,
-			},
-			v.Msg,
-		)
-		return msg, true
+		wait.received(v.Msg)
+		return a.interceptor.Received(slotAddr, v), true
 	}
 }
 
@@ -182,21 +189,22 @@ func (a ArrayInport) ReceiveAll(ctx context.Context, f func(idx int, msg Msg) bo
 	for idx := range a.chans {
 		go func(idx int) {
 			defer wg.Done()
+			index := uint8(idx)
+			slotAddr := PortSlotAddr{
+				PortAddr: PortAddr{
+					Path: a.addr.Path,
+					Port: a.addr.Port,
+				},
+				Index: &index,
+			}
+			wait := startPortWait(ctx, a.interceptor, slotAddr)
+			defer wait.done()
 			select {
 			case <-ctx.Done():
 				success = false
 			case received := <-a.chans[idx]:
-				index := uint8(idx)
-				msg := a.interceptor.Received(
-					PortSlotAddr{
-						PortAddr: PortAddr{
-							Path: a.addr.Path,
-							Port: a.addr.Port,
-						},
-						Index: &index,
-					},
-					received.Msg,
-				)
+				wait.received(received.Msg)
+				msg := a.interceptor.Received(slotAddr, received)
 				resultChan <- f(idx, msg)
 			}
 		}(idx)
@@ -256,7 +264,7 @@ func (a ArrayInport) _select(ctx context.Context) ([]SelectedMsg, bool) {
 						},
 						Index: &index,
 					},
-					orderedMsg.Msg,
+					orderedMsg,
 				)
 				buf = append(buf, SelectedMsg{
 					OrderedMsg: OrderedMsg{
@@ -281,10 +289,13 @@ func (a ArrayInport) _select(ctx context.Context) ([]SelectedMsg, bool) {
 // Select returns oldest available message across all available array inport slots.
 func (a *ArrayInport) Select(ctx context.Context) (SelectedMsg, bool) {
 	if len(a.buf) == 0 {
+		wait := startPortWait(ctx, a.interceptor, PortSlotAddr{PortAddr: a.addr})
+		defer wait.done()
 		batch, ok := a._select(ctx)
 		if !ok {
 			return SelectedMsg{}, false
 		}
+		wait.received(batch[len(batch)-1].Msg)
 		a.buf = batch
 	}
 
@@ -363,29 +374,78 @@ func NewSingleOutport(
 }
 
 func (s SingleOutport) Send(ctx context.Context, msg Msg) bool {
-	msg = s.interceptor.Sent(
-		PortSlotAddr{
-			PortAddr: PortAddr{
-				Path: s.addr.Path,
-				Port: s.addr.Port,
-			},
+	orderedMsg := OrderedMsg{
+		Msg:   msg,
+		index: counter.Add(1),
+	}
+	slotAddr := PortSlotAddr{
+		PortAddr: PortAddr{
+			Path: s.addr.Path,
+			Port: s.addr.Port,
 		},
-		msg,
-	)
+	}
+	orderedMsg.Msg = s.interceptor.Sent(slotAddr, orderedMsg)
+	wait := startPortWait(ctx, s.interceptor, slotAddr)
+	defer wait.done()
 	select {
 	case <-ctx.Done():
 		return false
-	case s.ch <- OrderedMsg{
-		Msg:   msg,
-		index: counter.Add(1),
-	}:
+	case s.ch <- orderedMsg:
+		wait.sent(orderedMsg.Msg)
 		return true
 	}
 }
 
+// Interceptor is called by ports on every message that goes through them.
+// It can observe or replace the message but the index stays the same.
 type Interceptor interface {
-	Sent(PortSlotAddr, Msg) Msg
-	Received(PortSlotAddr, Msg) Msg
+	Sent(PortSlotAddr, OrderedMsg) Msg
+	Received(PortSlotAddr, OrderedMsg) Msg
+}
+
+// BlockingObserver can be implemented by interceptor to know how long ports
+// were blocked on channel operations. Ports don't measure time otherwise.
+type BlockingObserver interface {
+	SendBlocked(PortSlotAddr, time.Duration)
+	ReceiveBlocked(PortSlotAddr, time.Duration)
+}
+
+// portWait is started before port blocks on a channel and must be finished with done,
+// even if the context is canceled before the message is transferred.
+// It reports blocking time to BlockingObserver and lets deadlock detector know the function is waiting.
+type portWait struct {
+	observer BlockingObserver
+	start    time.Time
+	state    *funcState
+	addr     PortSlotAddr
+}
+
+func startPortWait(ctx context.Context, interceptor Interceptor, addr PortSlotAddr) portWait {
+	wait := portWait{addr: addr, state: funcStateFromContext(ctx)}
+	wait.state.wait(addr)
+	if observer, ok := interceptor.(BlockingObserver); ok {
+		wait.observer = observer
+		wait.start = time.Now()
+	}
+	return wait
+}
+
+func (w portWait) done() {
+	w.state.done()
+}
+
+func (w portWait) sent(msg Msg) {
+	w.state.transferred(msg)
+	if w.observer != nil {
+		w.observer.SendBlocked(w.addr, time.Since(w.start))
+	}
+}
+
+func (w portWait) received(msg Msg) {
+	w.state.transferred(msg)
+	if w.observer != nil {
+		w.observer.ReceiveBlocked(w.addr, time.Since(w.start))
+	}
 }
 
 type PortSlotAddr struct {
@@ -404,20 +464,22 @@ func NewArrayOutport(addr PortAddr, interceptor Interceptor, slots []chan<- Orde
 }
 
 func (a ArrayOutport) Send(ctx context.Context, idx uint8, msg Msg) bool {
-	a.interceptor.Sent(
-		PortSlotAddr{
-			PortAddr: PortAddr{
-				Path: a.addr.Path,
-				Port: a.addr.Port,
-			},
-			Index: &idx,
+	orderedMsg := OrderedMsg{Msg: msg, index: counter.Add(1)}
+	slotAddr := PortSlotAddr{
+		PortAddr: PortAddr{
+			Path: a.addr.Path,
+			Port: a.addr.Port,
 		},
-		msg,
-	)
+		Index: &idx,
+	}
+	orderedMsg.Msg = a.interceptor.Sent(slotAddr, orderedMsg)
+	wait := startPortWait(ctx, a.interceptor, slotAddr)
+	defer wait.done()
 	select {
 	case <-ctx.Done():
 		return false
-	case a.slots[idx] <- OrderedMsg{Msg: msg, index: counter.Add(1)}:
+	case a.slots[idx] <- orderedMsg:
+		wait.sent(orderedMsg.Msg)
 		return true
 	}
 }
@@ -435,16 +497,20 @@ func (a ArrayOutport) SendAll(ctx context.Context, msg Msg) bool {
 	wg.Add(len(a.slots))
 	for idx := range a.slots {
 		go func(idx int) {
+			orderedMsg := OrderedMsg{Msg: msg, index: counter.Add(1)}
+			i := uint8(idx)
+			slotAddr := PortSlotAddr{
+				PortAddr: a.addr,
+				Index:    &i,
+			}
+			orderedMsg.Msg = a.interceptor.Sent(slotAddr, orderedMsg)
+			wait := startPortWait(ctx, a.interceptor, slotAddr)
+			defer wait.done()
 			select {
 			case <-ctx.Done():
 				success = false
-			case a.slots[idx] <- OrderedMsg{Msg: msg, index: counter.Add(1)}:
-				i := uint8(idx)
-				slotAddr := PortSlotAddr{
-					PortAddr: a.addr,
-					Index:    &i,
-				}
-				a.interceptor.Sent(slotAddr, msg)
+			case a.slots[idx] <- orderedMsg:
+				wait.sent(orderedMsg.Msg)
 			}
 			wg.Done()
 		}(idx)
@@ -13,25 +13,81 @@ type FuncCreator interface {
 	Create(IO, Msg) (func(context.Context), error)
 }
 
+// FuncSignature describes ports that runtime function expects to find in its IO.
+// Compiler uses it to check components with #extern directive before program is started.
+type FuncSignature struct {
+	In        map[string]PortKind
+	Out       map[string]PortKind
+	Autoports bool // inports are derived from the type argument and thus not listed
+}
+
+type PortKind uint8
+
+const (
+	SinglePort PortKind = iota
+	ArrayPort
+)
+
+func (k PortKind) String() string {
+	if k == ArrayPort {
+		return "array"
+	}
+	return "single"
+}
+
 func Run(ctx context.Context, prog Program, registry map[string]FuncCreator) error {
+	return run(ctx, prog, registry, nil)
+}
+
+// run is Run that also stops the program when it becomes idle after drain is closed.
+// Nil drain is never closed.
+func run(ctx context.Context, prog Program, registry map[string]FuncCreator, drain <-chan struct{}) error {
 	// debugValidation(prog)
 
 	ctx, cancel := context.WithCancel(ctx)
+
+	exitCode := make(chan int, 1)
 	go func() {
-		prog.Stop.Receive(ctx)
+		msg, ok := prog.Stop.Receive(ctx)
+		if ok && prog.ExitCode {
+			exitCode <- int(msg.Int())
+		}
 		cancel() // normal termination
 	}()
 
-	runFuncs, err := deferFuncCalls(prog.FuncCalls, registry)
+	runFuncs, states, err := deferFuncCalls(prog.FuncCalls, registry)
 	if err != nil {
 		return err
 	}
 
+	deadlock := make(chan *DeadlockError, 1)
+	go func() {
+		if err := watchDeadlock(ctx, states, deadlockCheckInterval, drain); err != nil {
+			deadlock <- err
+			cancel()
+		}
+	}()
+
+	if drain != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-drain:
+			}
+			if waitIdle(ctx, states, idleCheckInterval) {
+				cancel() // drained
+			}
+		}()
+	}
+
 	funcsFinished := make(chan struct{})
+	panicked := &panicState{cancel: cancel}
 
 	go func() {
-		// runFuncs blocks until context is cancelled (by the stop port or by panic)
-		runFuncs(context.WithValue(ctx, "cancel", cancel)) //nolint:staticcheck // SA1029
+		// runFuncs blocks until context is cancelled (by the stop port, by panic or after drain)
+		funcsCtx := context.WithValue(ctx, panicStateKey{}, panicked)
+		runFuncs(context.WithValue(funcsCtx, drainKey{}, drain))
 		close(funcsFinished)
 	}()
 
@@ -42,30 +98,105 @@ func Run(ctx context.Context, prog Program, registry map[string]FuncCreator) err
 
 	<-funcsFinished
 
-	return nil
+	if err := panicked.result(); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-deadlock:
+		return err
+	case code := <-exitCode:
+		if code != 0 {
+			return &ExitError{Code: code}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Serve is like Run but instead of sending single start message and terminating after the first stop message,
+// it forwards every message from start to the program and every message from the program to stop.
+// It returns when context is cancelled (by the caller or by panic). Closing start doesn't stop the program.
+func Serve(
+	ctx context.Context,
+	prog Program,
+	registry map[string]FuncCreator,
+	start <-chan Msg,
+	stop chan<- Msg,
+) error {
+	// program that waits for input is not deadlocked, so detector is not used
+	runFuncs, _, err := deferFuncCalls(prog.FuncCalls, registry)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		for {
+			msg, ok := prog.Stop.Receive(ctx)
+			if !ok {
+				return
+			}
+			select {
+			case stop <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case msg, ok := <-start:
+				if !ok {
+					return
+				}
+				if !prog.Start.Send(ctx, msg) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	panicked := &panicState{cancel: cancel}
+
+	// runFuncs blocks until context is cancelled (by the caller or by panic)
+	runFuncs(context.WithValue(ctx, panicStateKey{}, panicked))
+
+	return panicked.result()
 }
 
 func deferFuncCalls(
 	funcCalls []FuncCall,
 	registry map[string]FuncCreator,
-) (func(ctx context.Context), error) {
+) (func(ctx context.Context), []*funcState, error) {
 	handlers, err := createHandlers(funcCalls, registry)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	states := newFuncStates(funcCalls)
+
 	return func(ctx context.Context) {
 		wg := sync.WaitGroup{}
 		wg.Add(len(handlers))
 		for i := range handlers {
 			routine := handlers[i]
+			state := states[i]
 			go func() {
-				routine(ctx)
+				routine(context.WithValue(ctx, funcStateKey{}, state))
+				state.finish()
 				wg.Done()
 			}()
 		}
 		wg.Wait()
-	}, nil
+	}, states, nil
 }
 
 func createHandlers(funcCalls []FuncCall, registry map[string]FuncCreator) ([]func(context.Context), error) {
@@ -0,0 +1,126 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// InterruptedError is returned by RunGracefully when program is stopped by a signal.
+type InterruptedError struct {
+	Signal  os.Signal
+	Drained bool // all functions returned before drain timeout
+}
+
+func (e *InterruptedError) Error() string {
+	if e.Drained {
+		return fmt.Sprintf("interrupted by signal: %v", e.Signal)
+	}
+	return fmt.Sprintf("interrupted by signal: %v: functions didn't finish in time", e.Signal)
+}
+
+// ExitCode returns conventional exit code for the process terminated by the signal.
+func (e *InterruptedError) ExitCode() int {
+	if sig, ok := e.Signal.(syscall.Signal); ok {
+		return 128 + int(sig)
+	}
+	return 1
+}
+
+// idleCheckInterval is how often drained program is checked for being idle.
+const idleCheckInterval = 100 * time.Millisecond
+
+type drainKey struct{}
+
+// Draining returns channel that is closed when program stops accepting new input after interruption.
+// Functions that bring input from outside, such as servers, must stop doing so but finish what they started.
+// Channel is nil and thus never closed if program can't be interrupted.
+func Draining(ctx context.Context) <-chan struct{} {
+	drain, _ := ctx.Value(drainKey{}).(<-chan struct{})
+	return drain
+}
+
+// RunGracefully is like Run but it also handles SIGINT and SIGTERM.
+// On the first signal program stops accepting new input and in-flight messages are delivered.
+// Context is cancelled when every function is blocked on its ports or finished,
+// or when drainTimeout is over. Second signal cancels it immediately.
+func RunGracefully(
+	ctx context.Context,
+	prog Program,
+	registry map[string]FuncCreator,
+	drainTimeout time.Duration,
+) error {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	return runGracefully(ctx, prog, registry, drainTimeout, signals)
+}
+
+func runGracefully(
+	ctx context.Context,
+	prog Program,
+	registry map[string]FuncCreator,
+	drainTimeout time.Duration,
+	signals <-chan os.Signal,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	drain := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- run(ctx, prog, registry, drain)
+	}()
+
+	var sig os.Signal
+	select {
+	case err := <-done:
+		return err
+	case sig = <-signals:
+	}
+
+	close(drain)
+
+	timer := time.NewTimer(drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return &InterruptedError{Signal: sig, Drained: true}
+	case <-timer.C:
+	case <-signals:
+	}
+
+	return &InterruptedError{Signal: sig}
+}
+
+// waitIdle blocks until no message is transferred during the interval
+// while every function is blocked on its ports or finished.
+// It returns false if context is done before that.
+func waitIdle(ctx context.Context, states []*funcState, interval time.Duration) bool {
+	if len(states) == 0 {
+		return true
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastProgress := states[0].progress.Load()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+
+		progress := states[0].progress.Load()
+		if _, ok := blockedFuncs(states); ok && progress == lastProgress {
+			return true
+		}
+		lastProgress = progress
+	}
+}
@@ -0,0 +1,136 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type funcCreator func(IO, Msg) (func(context.Context), error)
+
+func (f funcCreator) Create(io IO, cfg Msg) (func(context.Context), error) { return f(io, cfg) }
+
+func TestRunGracefully_DeliversInFlightMessage(t *testing.T) {
+	startCh, resCh, stopCh := make(chan OrderedMsg), make(chan OrderedMsg), make(chan OrderedMsg)
+
+	// slow receives the start message, works for a while and sends result
+	received := make(chan struct{})
+	slow := funcCreator(func(io IO, _ Msg) (func(context.Context), error) {
+		in, err := io.In.Single("data")
+		if err != nil {
+			return nil, err
+		}
+		out, err := io.Out.Single("res")
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) {
+			for {
+				msg, ok := in.Receive(ctx)
+				if !ok {
+					return
+				}
+				close(received)
+				time.Sleep(300 * time.Millisecond)
+				if !out.Send(ctx, msg) {
+					return
+				}
+			}
+		}, nil
+	})
+
+	prog := Program{
+		Start: NewSingleOutport(PortAddr{Path: "in", Port: "start"}, ProdInterceptor{}, startCh),
+		Stop:  NewSingleInport(stopCh, PortAddr{Path: "out", Port: "stop"}, ProdInterceptor{}),
+		FuncCalls: []FuncCall{{
+			Ref: "slow",
+			IO: IO{
+				In: NewInports(map[string]Inport{
+					"data": NewInport(nil, NewSingleInport(startCh, PortAddr{Path: "slow/in", Port: "data"}, ProdInterceptor{})),
+				}),
+				Out: NewOutports(map[string]Outport{
+					"res": NewOutport(NewSingleOutport(PortAddr{Path: "slow/out", Port: "res"}, ProdInterceptor{}, resCh), nil),
+				}),
+			},
+		}},
+	}
+
+	signals := make(chan os.Signal, 1)
+	result := make(chan error, 1)
+	go func() {
+		result <- runGracefully(context.Background(), prog, map[string]FuncCreator{"slow": slow}, 5*time.Second, signals)
+	}()
+
+	<-received
+	signals <- syscall.SIGTERM
+
+	select {
+	case msg := <-resCh:
+		require.Equal(t, NewStructMsg(nil, nil), msg.Msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight message is not delivered after the signal")
+	}
+
+	var err error
+	select {
+	case err = <-result:
+	case <-time.After(5 * time.Second):
+		t.Fatal("program is not stopped after it became idle")
+	}
+
+	require.Equal(t, &InterruptedError{Signal: syscall.SIGTERM, Drained: true}, err)
+}
+
+func TestRunGracefully_DrainTimeout(t *testing.T) {
+	startCh, stopCh := make(chan OrderedMsg), make(chan OrderedMsg)
+
+	// busy never blocks on ports, so program doesn't become idle
+	started := make(chan struct{})
+	busy := funcCreator(func(io IO, _ Msg) (func(context.Context), error) {
+		in, err := io.In.Single("data")
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) {
+			if _, ok := in.Receive(ctx); !ok {
+				return
+			}
+			close(started)
+			<-ctx.Done()
+		}, nil
+	})
+
+	prog := Program{
+		Start: NewSingleOutport(PortAddr{Path: "in", Port: "start"}, ProdInterceptor{}, startCh),
+		Stop:  NewSingleInport(stopCh, PortAddr{Path: "out", Port: "stop"}, ProdInterceptor{}),
+		FuncCalls: []FuncCall{{
+			Ref: "busy",
+			IO: IO{
+				In: NewInports(map[string]Inport{
+					"data": NewInport(nil, NewSingleInport(startCh, PortAddr{Path: "busy/in", Port: "data"}, ProdInterceptor{})),
+				}),
+				Out: NewOutports(nil),
+			},
+		}},
+	}
+
+	signals := make(chan os.Signal, 1)
+	result := make(chan error, 1)
+	go func() {
+		result <- runGracefully(context.Background(), prog, map[string]FuncCreator{"busy": busy}, 200*time.Millisecond, signals)
+	}()
+
+	<-started
+	signals <- syscall.SIGINT
+
+	select {
+	case err := <-result:
+		require.Equal(t, &InterruptedError{Signal: syscall.SIGINT}, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("program is not stopped after drain timeout")
+	}
+}
@@ -0,0 +1,44 @@
+package runtime
+
+import "encoding/json"
+
+// Type describes static type of the message.
+// Compiler passes it as a configuration message to runtime functions that use #typeinfo directive,
+// so they can create messages of the right shape, e.g. when decoding JSON.
+type Type struct {
+	Kind    TypeKind        `json:"kind"`
+	Elem    *Type           `json:"elem,omitempty"`    // list and dict element
+	Fields  map[string]Type `json:"fields,omitempty"`  // struct fields
+	Members []string        `json:"members,omitempty"` // enum members
+	Tags    []TypeTag       `json:"tags,omitempty"`    // tagged union tags, index is runtime representation
+}
+
+// TypeTag is a tag of the tagged union, tag without type has no payload.
+type TypeTag struct {
+	Name string `json:"name"`
+	Type *Type  `json:"type,omitempty"`
+}
+
+type TypeKind string
+
+const (
+	AnyType    TypeKind = "any"
+	BoolType   TypeKind = "bool"
+	IntType    TypeKind = "int"
+	FloatType  TypeKind = "float"
+	StringType TypeKind = "string"
+	ListType   TypeKind = "list"
+	DictType   TypeKind = "dict"
+	StructType TypeKind = "struct"
+	EnumType   TypeKind = "enum"
+	UnionType  TypeKind = "union"
+)
+
+// ParseType decodes type description from configuration message.
+func ParseType(cfg Msg) (Type, error) {
+	var t Type
+	if err := json.Unmarshal([]byte(cfg.Str()), &t); err != nil {
+		return Type{}, err
+	}
+	return t, nil
+}